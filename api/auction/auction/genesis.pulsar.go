@@ -14,15 +14,345 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*Auction
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(Auction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(Auction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*BidderRegistration
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidderRegistration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BidderRegistration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(BidderRegistration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(BidderRegistration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*LotBid
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LotBid)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LotBid)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(LotBid)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(LotBid)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*AuctionSchedule
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(AuctionSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(AuctionSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*AuctionReward
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(AuctionReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(AuctionReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*IBCBidder
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCBidder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCBidder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(IBCBidder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(IBCBidder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_auctions               protoreflect.FieldDescriptor
+	fd_GenesisState_auction_count          protoreflect.FieldDescriptor
+	fd_GenesisState_paused                 protoreflect.FieldDescriptor
+	fd_GenesisState_halted_since           protoreflect.FieldDescriptor
+	fd_GenesisState_bidder_registrations   protoreflect.FieldDescriptor
+	fd_GenesisState_lot_bids               protoreflect.FieldDescriptor
+	fd_GenesisState_auction_schedules      protoreflect.FieldDescriptor
+	fd_GenesisState_auction_schedule_count protoreflect.FieldDescriptor
+	fd_GenesisState_auction_rewards        protoreflect.FieldDescriptor
+	fd_GenesisState_incentive_epoch        protoreflect.FieldDescriptor
+	fd_GenesisState_ibc_bidders            protoreflect.FieldDescriptor
+	fd_GenesisState_port_id                protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_auction_auction_genesis_proto_init()
 	md_GenesisState = File_auction_auction_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_auctions = md_GenesisState.Fields().ByName("auctions")
+	fd_GenesisState_auction_count = md_GenesisState.Fields().ByName("auction_count")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_halted_since = md_GenesisState.Fields().ByName("halted_since")
+	fd_GenesisState_bidder_registrations = md_GenesisState.Fields().ByName("bidder_registrations")
+	fd_GenesisState_lot_bids = md_GenesisState.Fields().ByName("lot_bids")
+	fd_GenesisState_auction_schedules = md_GenesisState.Fields().ByName("auction_schedules")
+	fd_GenesisState_auction_schedule_count = md_GenesisState.Fields().ByName("auction_schedule_count")
+	fd_GenesisState_auction_rewards = md_GenesisState.Fields().ByName("auction_rewards")
+	fd_GenesisState_incentive_epoch = md_GenesisState.Fields().ByName("incentive_epoch")
+	fd_GenesisState_ibc_bidders = md_GenesisState.Fields().ByName("ibc_bidders")
+	fd_GenesisState_port_id = md_GenesisState.Fields().ByName("port_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +426,78 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Auctions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Auctions})
+		if !f(fd_GenesisState_auctions, value) {
+			return
+		}
+	}
+	if x.AuctionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionCount)
+		if !f(fd_GenesisState_auction_count, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.HaltedSince != int64(0) {
+		value := protoreflect.ValueOfInt64(x.HaltedSince)
+		if !f(fd_GenesisState_halted_since, value) {
+			return
+		}
+	}
+	if len(x.BidderRegistrations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.BidderRegistrations})
+		if !f(fd_GenesisState_bidder_registrations, value) {
+			return
+		}
+	}
+	if len(x.LotBids) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.LotBids})
+		if !f(fd_GenesisState_lot_bids, value) {
+			return
+		}
+	}
+	if len(x.AuctionSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.AuctionSchedules})
+		if !f(fd_GenesisState_auction_schedules, value) {
+			return
+		}
+	}
+	if x.AuctionScheduleCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionScheduleCount)
+		if !f(fd_GenesisState_auction_schedule_count, value) {
+			return
+		}
+	}
+	if len(x.AuctionRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.AuctionRewards})
+		if !f(fd_GenesisState_auction_rewards, value) {
+			return
+		}
+	}
+	if x.IncentiveEpoch != nil {
+		value := protoreflect.ValueOfMessage(x.IncentiveEpoch.ProtoReflect())
+		if !f(fd_GenesisState_incentive_epoch, value) {
+			return
+		}
+	}
+	if len(x.IbcBidders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.IbcBidders})
+		if !f(fd_GenesisState_ibc_bidders, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_GenesisState_port_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +515,30 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "auction.auction.GenesisState.params":
 		return x.Params != nil
+	case "auction.auction.GenesisState.auctions":
+		return len(x.Auctions) != 0
+	case "auction.auction.GenesisState.auction_count":
+		return x.AuctionCount != uint64(0)
+	case "auction.auction.GenesisState.paused":
+		return x.Paused != false
+	case "auction.auction.GenesisState.halted_since":
+		return x.HaltedSince != int64(0)
+	case "auction.auction.GenesisState.bidder_registrations":
+		return len(x.BidderRegistrations) != 0
+	case "auction.auction.GenesisState.lot_bids":
+		return len(x.LotBids) != 0
+	case "auction.auction.GenesisState.auction_schedules":
+		return len(x.AuctionSchedules) != 0
+	case "auction.auction.GenesisState.auction_schedule_count":
+		return x.AuctionScheduleCount != uint64(0)
+	case "auction.auction.GenesisState.auction_rewards":
+		return len(x.AuctionRewards) != 0
+	case "auction.auction.GenesisState.incentive_epoch":
+		return x.IncentiveEpoch != nil
+	case "auction.auction.GenesisState.ibc_bidders":
+		return len(x.IbcBidders) != 0
+	case "auction.auction.GenesisState.port_id":
+		return x.PortId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -131,6 +557,30 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "auction.auction.GenesisState.params":
 		x.Params = nil
+	case "auction.auction.GenesisState.auctions":
+		x.Auctions = nil
+	case "auction.auction.GenesisState.auction_count":
+		x.AuctionCount = uint64(0)
+	case "auction.auction.GenesisState.paused":
+		x.Paused = false
+	case "auction.auction.GenesisState.halted_since":
+		x.HaltedSince = int64(0)
+	case "auction.auction.GenesisState.bidder_registrations":
+		x.BidderRegistrations = nil
+	case "auction.auction.GenesisState.lot_bids":
+		x.LotBids = nil
+	case "auction.auction.GenesisState.auction_schedules":
+		x.AuctionSchedules = nil
+	case "auction.auction.GenesisState.auction_schedule_count":
+		x.AuctionScheduleCount = uint64(0)
+	case "auction.auction.GenesisState.auction_rewards":
+		x.AuctionRewards = nil
+	case "auction.auction.GenesisState.incentive_epoch":
+		x.IncentiveEpoch = nil
+	case "auction.auction.GenesisState.ibc_bidders":
+		x.IbcBidders = nil
+	case "auction.auction.GenesisState.port_id":
+		x.PortId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -150,6 +600,60 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "auction.auction.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "auction.auction.GenesisState.auctions":
+		if len(x.Auctions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.auction_count":
+		value := x.AuctionCount
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "auction.auction.GenesisState.halted_since":
+		value := x.HaltedSince
+		return protoreflect.ValueOfInt64(value)
+	case "auction.auction.GenesisState.bidder_registrations":
+		if len(x.BidderRegistrations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.BidderRegistrations}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.lot_bids":
+		if len(x.LotBids) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.LotBids}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.auction_schedules":
+		if len(x.AuctionSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.AuctionSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.auction_schedule_count":
+		value := x.AuctionScheduleCount
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.GenesisState.auction_rewards":
+		if len(x.AuctionRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.AuctionRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.incentive_epoch":
+		value := x.IncentiveEpoch
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "auction.auction.GenesisState.ibc_bidders":
+		if len(x.IbcBidders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.IbcBidders}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.GenesisState.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -172,6 +676,42 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "auction.auction.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "auction.auction.GenesisState.auctions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Auctions = *clv.list
+	case "auction.auction.GenesisState.auction_count":
+		x.AuctionCount = value.Uint()
+	case "auction.auction.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "auction.auction.GenesisState.halted_since":
+		x.HaltedSince = value.Int()
+	case "auction.auction.GenesisState.bidder_registrations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.BidderRegistrations = *clv.list
+	case "auction.auction.GenesisState.lot_bids":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.LotBids = *clv.list
+	case "auction.auction.GenesisState.auction_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.AuctionSchedules = *clv.list
+	case "auction.auction.GenesisState.auction_schedule_count":
+		x.AuctionScheduleCount = value.Uint()
+	case "auction.auction.GenesisState.auction_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.AuctionRewards = *clv.list
+	case "auction.auction.GenesisState.incentive_epoch":
+		x.IncentiveEpoch = value.Message().Interface().(*IncentiveEpoch)
+	case "auction.auction.GenesisState.ibc_bidders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.IbcBidders = *clv.list
+	case "auction.auction.GenesisState.port_id":
+		x.PortId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -197,6 +737,57 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "auction.auction.GenesisState.auctions":
+		if x.Auctions == nil {
+			x.Auctions = []*Auction{}
+		}
+		value := &_GenesisState_2_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.bidder_registrations":
+		if x.BidderRegistrations == nil {
+			x.BidderRegistrations = []*BidderRegistration{}
+		}
+		value := &_GenesisState_6_list{list: &x.BidderRegistrations}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.lot_bids":
+		if x.LotBids == nil {
+			x.LotBids = []*LotBid{}
+		}
+		value := &_GenesisState_7_list{list: &x.LotBids}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.auction_schedules":
+		if x.AuctionSchedules == nil {
+			x.AuctionSchedules = []*AuctionSchedule{}
+		}
+		value := &_GenesisState_8_list{list: &x.AuctionSchedules}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.auction_rewards":
+		if x.AuctionRewards == nil {
+			x.AuctionRewards = []*AuctionReward{}
+		}
+		value := &_GenesisState_10_list{list: &x.AuctionRewards}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.incentive_epoch":
+		if x.IncentiveEpoch == nil {
+			x.IncentiveEpoch = new(IncentiveEpoch)
+		}
+		return protoreflect.ValueOfMessage(x.IncentiveEpoch.ProtoReflect())
+	case "auction.auction.GenesisState.ibc_bidders":
+		if x.IbcBidders == nil {
+			x.IbcBidders = []*IBCBidder{}
+		}
+		value := &_GenesisState_12_list{list: &x.IbcBidders}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.GenesisState.auction_count":
+		panic(fmt.Errorf("field auction_count of message auction.auction.GenesisState is not mutable"))
+	case "auction.auction.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message auction.auction.GenesisState is not mutable"))
+	case "auction.auction.GenesisState.halted_since":
+		panic(fmt.Errorf("field halted_since of message auction.auction.GenesisState is not mutable"))
+	case "auction.auction.GenesisState.auction_schedule_count":
+		panic(fmt.Errorf("field auction_schedule_count of message auction.auction.GenesisState is not mutable"))
+	case "auction.auction.GenesisState.port_id":
+		panic(fmt.Errorf("field port_id of message auction.auction.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -213,6 +804,37 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "auction.auction.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "auction.auction.GenesisState.auctions":
+		list := []*Auction{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "auction.auction.GenesisState.auction_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "auction.auction.GenesisState.halted_since":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "auction.auction.GenesisState.bidder_registrations":
+		list := []*BidderRegistration{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "auction.auction.GenesisState.lot_bids":
+		list := []*LotBid{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "auction.auction.GenesisState.auction_schedules":
+		list := []*AuctionSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "auction.auction.GenesisState.auction_schedule_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.GenesisState.auction_rewards":
+		list := []*AuctionReward{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "auction.auction.GenesisState.incentive_epoch":
+		m := new(IncentiveEpoch)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "auction.auction.GenesisState.ibc_bidders":
+		list := []*IBCBidder{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "auction.auction.GenesisState.port_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.GenesisState"))
@@ -286,6 +908,62 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Auctions) > 0 {
+			for _, e := range x.Auctions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AuctionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionCount))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if x.HaltedSince != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaltedSince))
+		}
+		if len(x.BidderRegistrations) > 0 {
+			for _, e := range x.BidderRegistrations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LotBids) > 0 {
+			for _, e := range x.LotBids {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuctionSchedules) > 0 {
+			for _, e := range x.AuctionSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AuctionScheduleCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionScheduleCount))
+		}
+		if len(x.AuctionRewards) > 0 {
+			for _, e := range x.AuctionRewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IncentiveEpoch != nil {
+			l = options.Size(x.IncentiveEpoch)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.IbcBidders) > 0 {
+			for _, e := range x.IbcBidders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +993,148 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.IbcBidders) > 0 {
+			for iNdEx := len(x.IbcBidders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IbcBidders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.IncentiveEpoch != nil {
+			encoded, err := options.Marshal(x.IncentiveEpoch)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.AuctionRewards) > 0 {
+			for iNdEx := len(x.AuctionRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuctionRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.AuctionScheduleCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionScheduleCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.AuctionSchedules) > 0 {
+			for iNdEx := len(x.AuctionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuctionSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.LotBids) > 0 {
+			for iNdEx := len(x.LotBids) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LotBids[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.BidderRegistrations) > 0 {
+			for iNdEx := len(x.BidderRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BidderRegistrations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.HaltedSince != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaltedSince))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AuctionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Auctions) > 0 {
+			for iNdEx := len(x.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Auctions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,17 +1234,366 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Auctions = append(x.Auctions, &Auction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auctions[len(x.Auctions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionCount", wireType)
+				}
+				x.AuctionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltedSince", wireType)
+				}
+				x.HaltedSince = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HaltedSince |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidderRegistrations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidderRegistrations = append(x.BidderRegistrations, &BidderRegistration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BidderRegistrations[len(x.BidderRegistrations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LotBids", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LotBids = append(x.LotBids, &LotBid{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LotBids[len(x.LotBids)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionSchedules = append(x.AuctionSchedules, &AuctionSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionSchedules[len(x.AuctionSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionScheduleCount", wireType)
+				}
+				x.AuctionScheduleCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionScheduleCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionRewards = append(x.AuctionRewards, &AuctionReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionRewards[len(x.AuctionRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IncentiveEpoch == nil {
+					x.IncentiveEpoch = &IncentiveEpoch{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncentiveEpoch); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcBidders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcBidders = append(x.IbcBidders, &IBCBidder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcBidders[len(x.IbcBidders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -470,6 +1639,34 @@ type GenesisState struct {
 
 	// params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// auctions defines all the auctions stored by the module.
+	Auctions []*Auction `protobuf:"bytes,2,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// auction_count is the number of auctions created so far and is used to
+	// derive the next auction ID.
+	AuctionCount uint64 `protobuf:"varint,3,opt,name=auction_count,json=auctionCount,proto3" json:"auction_count,omitempty"`
+	// paused reports whether the module authority paused the whole module.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// halted_since is the height at which bidding was halted module wide, either
+	// by the authority or by the circuit breaker. Zero when bidding is not halted.
+	HaltedSince int64 `protobuf:"varint,5,opt,name=halted_since,json=haltedSince,proto3" json:"halted_since,omitempty"`
+	// bidder_registrations defines the deposits locked by registered bidders.
+	BidderRegistrations []*BidderRegistration `protobuf:"bytes,6,rep,name=bidder_registrations,json=bidderRegistrations,proto3" json:"bidder_registrations,omitempty"`
+	// lot_bids defines the bids placed on single lots of bundle auctions, in
+	// the order they were placed.
+	LotBids []*LotBid `protobuf:"bytes,7,rep,name=lot_bids,json=lotBids,proto3" json:"lot_bids,omitempty"`
+	// auction_schedules defines the recurring auction schedules.
+	AuctionSchedules []*AuctionSchedule `protobuf:"bytes,8,rep,name=auction_schedules,json=auctionSchedules,proto3" json:"auction_schedules,omitempty"`
+	// auction_schedule_count is the number of schedules created so far and is
+	// used to derive the next schedule ID.
+	AuctionScheduleCount uint64 `protobuf:"varint,9,opt,name=auction_schedule_count,json=auctionScheduleCount,proto3" json:"auction_schedule_count,omitempty"`
+	// auction_rewards defines the incentive rebates accrued and not claimed yet.
+	AuctionRewards []*AuctionReward `protobuf:"bytes,10,rep,name=auction_rewards,json=auctionRewards,proto3" json:"auction_rewards,omitempty"`
+	// incentive_epoch tracks the rebates accrued in the current incentive epoch.
+	IncentiveEpoch *IncentiveEpoch `protobuf:"bytes,11,opt,name=incentive_epoch,json=incentiveEpoch,proto3" json:"incentive_epoch,omitempty"`
+	// ibc_bidders defines the origins of the accounts bidding over ICS-20.
+	IbcBidders []*IBCBidder `protobuf:"bytes,12,rep,name=ibc_bidders,json=ibcBidders,proto3" json:"ibc_bidders,omitempty"`
+	// port_id is the port the auction IBC application binds to.
+	PortId string `protobuf:"bytes,13,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +1696,90 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetAuctions() []*Auction {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctionCount() uint64 {
+	if x != nil {
+		return x.AuctionCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisState) GetHaltedSince() int64 {
+	if x != nil {
+		return x.HaltedSince
+	}
+	return 0
+}
+
+func (x *GenesisState) GetBidderRegistrations() []*BidderRegistration {
+	if x != nil {
+		return x.BidderRegistrations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLotBids() []*LotBid {
+	if x != nil {
+		return x.LotBids
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctionSchedules() []*AuctionSchedule {
+	if x != nil {
+		return x.AuctionSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctionScheduleCount() uint64 {
+	if x != nil {
+		return x.AuctionScheduleCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAuctionRewards() []*AuctionReward {
+	if x != nil {
+		return x.AuctionRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIncentiveEpoch() *IncentiveEpoch {
+	if x != nil {
+		return x.IncentiveEpoch
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIbcBidders() []*IBCBidder {
+	if x != nil {
+		return x.IbcBidders
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
 var File_auction_auction_genesis_proto protoreflect.FileDescriptor
 
 var file_auction_auction_genesis_proto_rawDesc = []byte{
@@ -507,24 +1788,73 @@ var file_auction_auction_genesis_proto_rawDesc = []byte{
 	0x0f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02,
-	0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0xca, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0xe2, 0x02, 0x1b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x62, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x06, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x6c, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a,
+	0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x6f, 0x74, 0x42, 0x69, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x42, 0x69, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x11,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0f,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x53, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x5f, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x42, 0x43,
+	0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x42, 0x9d, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x41,
+	0x58, 0xaa, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x1b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -541,16 +1871,30 @@ func file_auction_auction_genesis_proto_rawDescGZIP() []byte {
 
 var file_auction_auction_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_auction_auction_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: auction.auction.GenesisState
-	(*Params)(nil),       // 1: auction.auction.Params
+	(*GenesisState)(nil),       // 0: auction.auction.GenesisState
+	(*Params)(nil),             // 1: auction.auction.Params
+	(*Auction)(nil),            // 2: auction.auction.Auction
+	(*BidderRegistration)(nil), // 3: auction.auction.BidderRegistration
+	(*LotBid)(nil),             // 4: auction.auction.LotBid
+	(*AuctionSchedule)(nil),    // 5: auction.auction.AuctionSchedule
+	(*AuctionReward)(nil),      // 6: auction.auction.AuctionReward
+	(*IncentiveEpoch)(nil),     // 7: auction.auction.IncentiveEpoch
+	(*IBCBidder)(nil),          // 8: auction.auction.IBCBidder
 }
 var file_auction_auction_genesis_proto_depIdxs = []int32{
 	1, // 0: auction.auction.GenesisState.params:type_name -> auction.auction.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: auction.auction.GenesisState.auctions:type_name -> auction.auction.Auction
+	3, // 2: auction.auction.GenesisState.bidder_registrations:type_name -> auction.auction.BidderRegistration
+	4, // 3: auction.auction.GenesisState.lot_bids:type_name -> auction.auction.LotBid
+	5, // 4: auction.auction.GenesisState.auction_schedules:type_name -> auction.auction.AuctionSchedule
+	6, // 5: auction.auction.GenesisState.auction_rewards:type_name -> auction.auction.AuctionReward
+	7, // 6: auction.auction.GenesisState.incentive_epoch:type_name -> auction.auction.IncentiveEpoch
+	8, // 7: auction.auction.GenesisState.ibc_bidders:type_name -> auction.auction.IBCBidder
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_auction_auction_genesis_proto_init() }
@@ -558,7 +1902,9 @@ func file_auction_auction_genesis_proto_init() {
 	if File_auction_auction_genesis_proto != nil {
 		return
 	}
+	file_auction_auction_ibc_proto_init()
 	file_auction_auction_params_proto_init()
+	file_auction_auction_tx_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_auction_auction_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
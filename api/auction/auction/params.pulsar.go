@@ -3,7 +3,9 @@ package auction
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,13 +16,135 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_default_duration          protoreflect.FieldDescriptor
+	fd_Params_reject_creator_grantees   protoreflect.FieldDescriptor
+	fd_Params_settlement_window         protoreflect.FieldDescriptor
+	fd_Params_buyback_interval          protoreflect.FieldDescriptor
+	fd_Params_buyback_denom             protoreflect.FieldDescriptor
+	fd_Params_buyback_to_community_pool protoreflect.FieldDescriptor
+	fd_Params_incentive_rate            protoreflect.FieldDescriptor
+	fd_Params_incentive_epoch_length    protoreflect.FieldDescriptor
+	fd_Params_incentive_epoch_cap       protoreflect.FieldDescriptor
+	fd_Params_buyback_min_prices        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_auction_auction_params_proto_init()
 	md_Params = File_auction_auction_params_proto.Messages().ByName("Params")
+	fd_Params_default_duration = md_Params.Fields().ByName("default_duration")
+	fd_Params_reject_creator_grantees = md_Params.Fields().ByName("reject_creator_grantees")
+	fd_Params_settlement_window = md_Params.Fields().ByName("settlement_window")
+	fd_Params_buyback_interval = md_Params.Fields().ByName("buyback_interval")
+	fd_Params_buyback_denom = md_Params.Fields().ByName("buyback_denom")
+	fd_Params_buyback_to_community_pool = md_Params.Fields().ByName("buyback_to_community_pool")
+	fd_Params_incentive_rate = md_Params.Fields().ByName("incentive_rate")
+	fd_Params_incentive_epoch_length = md_Params.Fields().ByName("incentive_epoch_length")
+	fd_Params_incentive_epoch_cap = md_Params.Fields().ByName("incentive_epoch_cap")
+	fd_Params_buyback_min_prices = md_Params.Fields().ByName("buyback_min_prices")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +212,66 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DefaultDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DefaultDuration)
+		if !f(fd_Params_default_duration, value) {
+			return
+		}
+	}
+	if x.RejectCreatorGrantees != false {
+		value := protoreflect.ValueOfBool(x.RejectCreatorGrantees)
+		if !f(fd_Params_reject_creator_grantees, value) {
+			return
+		}
+	}
+	if x.SettlementWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SettlementWindow)
+		if !f(fd_Params_settlement_window, value) {
+			return
+		}
+	}
+	if x.BuybackInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BuybackInterval)
+		if !f(fd_Params_buyback_interval, value) {
+			return
+		}
+	}
+	if x.BuybackDenom != "" {
+		value := protoreflect.ValueOfString(x.BuybackDenom)
+		if !f(fd_Params_buyback_denom, value) {
+			return
+		}
+	}
+	if x.BuybackToCommunityPool != false {
+		value := protoreflect.ValueOfBool(x.BuybackToCommunityPool)
+		if !f(fd_Params_buyback_to_community_pool, value) {
+			return
+		}
+	}
+	if x.IncentiveRate != "" {
+		value := protoreflect.ValueOfString(x.IncentiveRate)
+		if !f(fd_Params_incentive_rate, value) {
+			return
+		}
+	}
+	if x.IncentiveEpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IncentiveEpochLength)
+		if !f(fd_Params_incentive_epoch_length, value) {
+			return
+		}
+	}
+	if len(x.IncentiveEpochCap) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.IncentiveEpochCap})
+		if !f(fd_Params_incentive_epoch_cap, value) {
+			return
+		}
+	}
+	if len(x.BuybackMinPrices) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.BuybackMinPrices})
+		if !f(fd_Params_buyback_min_prices, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +287,26 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "auction.auction.Params.default_duration":
+		return x.DefaultDuration != uint64(0)
+	case "auction.auction.Params.reject_creator_grantees":
+		return x.RejectCreatorGrantees != false
+	case "auction.auction.Params.settlement_window":
+		return x.SettlementWindow != uint64(0)
+	case "auction.auction.Params.buyback_interval":
+		return x.BuybackInterval != uint64(0)
+	case "auction.auction.Params.buyback_denom":
+		return x.BuybackDenom != ""
+	case "auction.auction.Params.buyback_to_community_pool":
+		return x.BuybackToCommunityPool != false
+	case "auction.auction.Params.incentive_rate":
+		return x.IncentiveRate != ""
+	case "auction.auction.Params.incentive_epoch_length":
+		return x.IncentiveEpochLength != uint64(0)
+	case "auction.auction.Params.incentive_epoch_cap":
+		return len(x.IncentiveEpochCap) != 0
+	case "auction.auction.Params.buyback_min_prices":
+		return len(x.BuybackMinPrices) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -119,6 +323,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "auction.auction.Params.default_duration":
+		x.DefaultDuration = uint64(0)
+	case "auction.auction.Params.reject_creator_grantees":
+		x.RejectCreatorGrantees = false
+	case "auction.auction.Params.settlement_window":
+		x.SettlementWindow = uint64(0)
+	case "auction.auction.Params.buyback_interval":
+		x.BuybackInterval = uint64(0)
+	case "auction.auction.Params.buyback_denom":
+		x.BuybackDenom = ""
+	case "auction.auction.Params.buyback_to_community_pool":
+		x.BuybackToCommunityPool = false
+	case "auction.auction.Params.incentive_rate":
+		x.IncentiveRate = ""
+	case "auction.auction.Params.incentive_epoch_length":
+		x.IncentiveEpochLength = uint64(0)
+	case "auction.auction.Params.incentive_epoch_cap":
+		x.IncentiveEpochCap = nil
+	case "auction.auction.Params.buyback_min_prices":
+		x.BuybackMinPrices = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -135,6 +359,42 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "auction.auction.Params.default_duration":
+		value := x.DefaultDuration
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.Params.reject_creator_grantees":
+		value := x.RejectCreatorGrantees
+		return protoreflect.ValueOfBool(value)
+	case "auction.auction.Params.settlement_window":
+		value := x.SettlementWindow
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.Params.buyback_interval":
+		value := x.BuybackInterval
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.Params.buyback_denom":
+		value := x.BuybackDenom
+		return protoreflect.ValueOfString(value)
+	case "auction.auction.Params.buyback_to_community_pool":
+		value := x.BuybackToCommunityPool
+		return protoreflect.ValueOfBool(value)
+	case "auction.auction.Params.incentive_rate":
+		value := x.IncentiveRate
+		return protoreflect.ValueOfString(value)
+	case "auction.auction.Params.incentive_epoch_length":
+		value := x.IncentiveEpochLength
+		return protoreflect.ValueOfUint64(value)
+	case "auction.auction.Params.incentive_epoch_cap":
+		if len(x.IncentiveEpochCap) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.IncentiveEpochCap}
+		return protoreflect.ValueOfList(listValue)
+	case "auction.auction.Params.buyback_min_prices":
+		if len(x.BuybackMinPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.BuybackMinPrices}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -155,6 +415,30 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "auction.auction.Params.default_duration":
+		x.DefaultDuration = value.Uint()
+	case "auction.auction.Params.reject_creator_grantees":
+		x.RejectCreatorGrantees = value.Bool()
+	case "auction.auction.Params.settlement_window":
+		x.SettlementWindow = value.Uint()
+	case "auction.auction.Params.buyback_interval":
+		x.BuybackInterval = value.Uint()
+	case "auction.auction.Params.buyback_denom":
+		x.BuybackDenom = value.Interface().(string)
+	case "auction.auction.Params.buyback_to_community_pool":
+		x.BuybackToCommunityPool = value.Bool()
+	case "auction.auction.Params.incentive_rate":
+		x.IncentiveRate = value.Interface().(string)
+	case "auction.auction.Params.incentive_epoch_length":
+		x.IncentiveEpochLength = value.Uint()
+	case "auction.auction.Params.incentive_epoch_cap":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.IncentiveEpochCap = *clv.list
+	case "auction.auction.Params.buyback_min_prices":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.BuybackMinPrices = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -175,6 +459,34 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "auction.auction.Params.incentive_epoch_cap":
+		if x.IncentiveEpochCap == nil {
+			x.IncentiveEpochCap = []*v1beta1.Coin{}
+		}
+		value := &_Params_9_list{list: &x.IncentiveEpochCap}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.Params.buyback_min_prices":
+		if x.BuybackMinPrices == nil {
+			x.BuybackMinPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_10_list{list: &x.BuybackMinPrices}
+		return protoreflect.ValueOfList(value)
+	case "auction.auction.Params.default_duration":
+		panic(fmt.Errorf("field default_duration of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.reject_creator_grantees":
+		panic(fmt.Errorf("field reject_creator_grantees of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.settlement_window":
+		panic(fmt.Errorf("field settlement_window of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.buyback_interval":
+		panic(fmt.Errorf("field buyback_interval of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.buyback_denom":
+		panic(fmt.Errorf("field buyback_denom of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.buyback_to_community_pool":
+		panic(fmt.Errorf("field buyback_to_community_pool of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.incentive_rate":
+		panic(fmt.Errorf("field incentive_rate of message auction.auction.Params is not mutable"))
+	case "auction.auction.Params.incentive_epoch_length":
+		panic(fmt.Errorf("field incentive_epoch_length of message auction.auction.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -188,6 +500,28 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "auction.auction.Params.default_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.Params.reject_creator_grantees":
+		return protoreflect.ValueOfBool(false)
+	case "auction.auction.Params.settlement_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.Params.buyback_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.Params.buyback_denom":
+		return protoreflect.ValueOfString("")
+	case "auction.auction.Params.buyback_to_community_pool":
+		return protoreflect.ValueOfBool(false)
+	case "auction.auction.Params.incentive_rate":
+		return protoreflect.ValueOfString("")
+	case "auction.auction.Params.incentive_epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "auction.auction.Params.incentive_epoch_cap":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "auction.auction.Params.buyback_min_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: auction.auction.Params"))
@@ -257,6 +591,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.DefaultDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultDuration))
+		}
+		if x.RejectCreatorGrantees {
+			n += 2
+		}
+		if x.SettlementWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementWindow))
+		}
+		if x.BuybackInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.BuybackInterval))
+		}
+		l = len(x.BuybackDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BuybackToCommunityPool {
+			n += 2
+		}
+		l = len(x.IncentiveRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncentiveEpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.IncentiveEpochLength))
+		}
+		if len(x.IncentiveEpochCap) > 0 {
+			for _, e := range x.IncentiveEpochCap {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BuybackMinPrices) > 0 {
+			for _, e := range x.BuybackMinPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +658,92 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BuybackMinPrices) > 0 {
+			for iNdEx := len(x.BuybackMinPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BuybackMinPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.IncentiveEpochCap) > 0 {
+			for iNdEx := len(x.IncentiveEpochCap) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IncentiveEpochCap[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.IncentiveEpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IncentiveEpochLength))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.IncentiveRate) > 0 {
+			i -= len(x.IncentiveRate)
+			copy(dAtA[i:], x.IncentiveRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IncentiveRate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.BuybackToCommunityPool {
+			i--
+			if x.BuybackToCommunityPool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.BuybackDenom) > 0 {
+			i -= len(x.BuybackDenom)
+			copy(dAtA[i:], x.BuybackDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BuybackDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BuybackInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BuybackInterval))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SettlementWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementWindow))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RejectCreatorGrantees {
+			i--
+			if x.RejectCreatorGrantees {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DefaultDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultDuration))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +793,254 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultDuration", wireType)
+				}
+				x.DefaultDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectCreatorGrantees", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RejectCreatorGrantees = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementWindow", wireType)
+				}
+				x.SettlementWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackInterval", wireType)
+				}
+				x.BuybackInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BuybackInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuybackDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackToCommunityPool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BuybackToCommunityPool = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentiveRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IncentiveRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpochLength", wireType)
+				}
+				x.IncentiveEpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IncentiveEpochLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpochCap", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IncentiveEpochCap = append(x.IncentiveEpochCap, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncentiveEpochCap[len(x.IncentiveEpochCap)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackMinPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuybackMinPrices = append(x.BuybackMinPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuybackMinPrices[len(x.BuybackMinPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +1094,39 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// default_duration is the number of blocks an auction stays open when
+	// MsgCreateAuction does not set a duration.
+	DefaultDuration uint64 `protobuf:"varint,1,opt,name=default_duration,json=defaultDuration,proto3" json:"default_duration,omitempty"`
+	// reject_creator_grantees rejects bids from addresses holding an authz grant
+	// from the auction creator.
+	RejectCreatorGrantees bool `protobuf:"varint,2,opt,name=reject_creator_grantees,json=rejectCreatorGrantees,proto3" json:"reject_creator_grantees,omitempty"`
+	// settlement_window is the number of blocks the winner of a deferred-payment
+	// auction has to complete the purchase before its deposit is slashed.
+	SettlementWindow uint64 `protobuf:"varint,3,opt,name=settlement_window,json=settlementWindow,proto3" json:"settlement_window,omitempty"`
+	// buyback_interval is the number of blocks between two buyback rounds. Every
+	// round auctions the fees collected in the denoms of buyback_min_prices for
+	// buyback_denom. Zero disables buybacks.
+	BuybackInterval uint64 `protobuf:"varint,4,opt,name=buyback_interval,json=buybackInterval,proto3" json:"buyback_interval,omitempty"`
+	// buyback_denom is the denom buyback auctions are paid in, and the only
+	// denom of the collected fees that is not auctioned.
+	BuybackDenom string `protobuf:"bytes,5,opt,name=buyback_denom,json=buybackDenom,proto3" json:"buyback_denom,omitempty"`
+	// buyback_to_community_pool sends the proceeds of buyback auctions to the
+	// community pool instead of burning them.
+	BuybackToCommunityPool bool `protobuf:"varint,6,opt,name=buyback_to_community_pool,json=buybackToCommunityPool,proto3" json:"buyback_to_community_pool,omitempty"`
+	// incentive_rate is the share of the amount paid in a settled auction that
+	// the incentive pool rebates to the seller and to each winner. Zero disables
+	// incentives.
+	IncentiveRate string `protobuf:"bytes,7,opt,name=incentive_rate,json=incentiveRate,proto3" json:"incentive_rate,omitempty"`
+	// incentive_epoch_length is the number of blocks of an incentive epoch.
+	IncentiveEpochLength uint64 `protobuf:"varint,8,opt,name=incentive_epoch_length,json=incentiveEpochLength,proto3" json:"incentive_epoch_length,omitempty"`
+	// incentive_epoch_cap caps the rebates accrued per epoch, per denom. Auctions
+	// paid in a denom without a cap earn no rebate.
+	IncentiveEpochCap []*v1beta1.Coin `protobuf:"bytes,9,rep,name=incentive_epoch_cap,json=incentiveEpochCap,proto3" json:"incentive_epoch_cap,omitempty"`
+	// buyback_min_prices sets, per denom of the collected fees, the lowest price
+	// in buyback_denom a buyback auction accepts for one unit of that denom.
+	// Fees in a denom without a minimum price are left with the fee collector.
+	BuybackMinPrices []*v1beta1.DecCoin `protobuf:"bytes,10,rep,name=buyback_min_prices,json=buybackMinPrices,proto3" json:"buyback_min_prices,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +1149,76 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_auction_auction_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetDefaultDuration() uint64 {
+	if x != nil {
+		return x.DefaultDuration
+	}
+	return 0
+}
+
+func (x *Params) GetRejectCreatorGrantees() bool {
+	if x != nil {
+		return x.RejectCreatorGrantees
+	}
+	return false
+}
+
+func (x *Params) GetSettlementWindow() uint64 {
+	if x != nil {
+		return x.SettlementWindow
+	}
+	return 0
+}
+
+func (x *Params) GetBuybackInterval() uint64 {
+	if x != nil {
+		return x.BuybackInterval
+	}
+	return 0
+}
+
+func (x *Params) GetBuybackDenom() string {
+	if x != nil {
+		return x.BuybackDenom
+	}
+	return ""
+}
+
+func (x *Params) GetBuybackToCommunityPool() bool {
+	if x != nil {
+		return x.BuybackToCommunityPool
+	}
+	return false
+}
+
+func (x *Params) GetIncentiveRate() string {
+	if x != nil {
+		return x.IncentiveRate
+	}
+	return ""
+}
+
+func (x *Params) GetIncentiveEpochLength() uint64 {
+	if x != nil {
+		return x.IncentiveEpochLength
+	}
+	return 0
+}
+
+func (x *Params) GetIncentiveEpochCap() []*v1beta1.Coin {
+	if x != nil {
+		return x.IncentiveEpochCap
+	}
+	return nil
+}
+
+func (x *Params) GetBuybackMinPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.BuybackMinPrices
+	}
+	return nil
+}
+
 var File_auction_auction_params_proto protoreflect.FileDescriptor
 
 var file_auction_auction_params_proto_rawDesc = []byte{
@@ -417,21 +1226,69 @@ var file_auction_auction_params_proto_rawDesc = []byte{
 	0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
 	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa,
-	0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0xca, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x1b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29,
+	0x0a, 0x10, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x39,
+	0x0a, 0x19, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x16, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5d, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x80,
+	0x01, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x61,
+	0x70, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4d,
+	0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x18, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x13,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x1b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xa2,
+	0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x1b, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x3a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -448,14 +1305,18 @@ func file_auction_auction_params_proto_rawDescGZIP() []byte {
 
 var file_auction_auction_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_auction_auction_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: auction.auction.Params
+	(*Params)(nil),          // 0: auction.auction.Params
+	(*v1beta1.Coin)(nil),    // 1: cosmos.base.v1beta1.Coin
+	(*v1beta1.DecCoin)(nil), // 2: cosmos.base.v1beta1.DecCoin
 }
 var file_auction_auction_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: auction.auction.Params.incentive_epoch_cap:type_name -> cosmos.base.v1beta1.Coin
+	2, // 1: auction.auction.Params.buyback_min_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_auction_auction_params_proto_init() }
@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/auction.auction.Query/Params"
+	Query_Params_FullMethodName             = "/auction.auction.Query/Params"
+	Query_SuspiciousAuctions_FullMethodName = "/auction.auction.Query/SuspiciousAuctions"
+	Query_AuctionSchedule_FullMethodName    = "/auction.auction.Query/AuctionSchedule"
+	Query_AuctionSchedules_FullMethodName   = "/auction.auction.Query/AuctionSchedules"
+	Query_AuctionRewards_FullMethodName     = "/auction.auction.Query/AuctionRewards"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +32,15 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SuspiciousAuctions flags the live auctions of a creator whose bidders
+	// overlap with the creator's recent counterparties.
+	SuspiciousAuctions(ctx context.Context, in *QuerySuspiciousAuctionsRequest, opts ...grpc.CallOption) (*QuerySuspiciousAuctionsResponse, error)
+	// AuctionSchedule queries a recurring auction schedule by ID.
+	AuctionSchedule(ctx context.Context, in *QueryAuctionScheduleRequest, opts ...grpc.CallOption) (*QueryAuctionScheduleResponse, error)
+	// AuctionSchedules lists the recurring auction schedules.
+	AuctionSchedules(ctx context.Context, in *QueryAuctionSchedulesRequest, opts ...grpc.CallOption) (*QueryAuctionSchedulesResponse, error)
+	// AuctionRewards queries the incentive rebates accrued by an address.
+	AuctionRewards(ctx context.Context, in *QueryAuctionRewardsRequest, opts ...grpc.CallOption) (*QueryAuctionRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +60,57 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SuspiciousAuctions(ctx context.Context, in *QuerySuspiciousAuctionsRequest, opts ...grpc.CallOption) (*QuerySuspiciousAuctionsResponse, error) {
+	out := new(QuerySuspiciousAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_SuspiciousAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionSchedule(ctx context.Context, in *QueryAuctionScheduleRequest, opts ...grpc.CallOption) (*QueryAuctionScheduleResponse, error) {
+	out := new(QueryAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionSchedules(ctx context.Context, in *QueryAuctionSchedulesRequest, opts ...grpc.CallOption) (*QueryAuctionSchedulesResponse, error) {
+	out := new(QueryAuctionSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionRewards(ctx context.Context, in *QueryAuctionRewardsRequest, opts ...grpc.CallOption) (*QueryAuctionRewardsResponse, error) {
+	out := new(QueryAuctionRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SuspiciousAuctions flags the live auctions of a creator whose bidders
+	// overlap with the creator's recent counterparties.
+	SuspiciousAuctions(context.Context, *QuerySuspiciousAuctionsRequest) (*QuerySuspiciousAuctionsResponse, error)
+	// AuctionSchedule queries a recurring auction schedule by ID.
+	AuctionSchedule(context.Context, *QueryAuctionScheduleRequest) (*QueryAuctionScheduleResponse, error)
+	// AuctionSchedules lists the recurring auction schedules.
+	AuctionSchedules(context.Context, *QueryAuctionSchedulesRequest) (*QueryAuctionSchedulesResponse, error)
+	// AuctionRewards queries the incentive rebates accrued by an address.
+	AuctionRewards(context.Context, *QueryAuctionRewardsRequest) (*QueryAuctionRewardsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +121,18 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) SuspiciousAuctions(context.Context, *QuerySuspiciousAuctionsRequest) (*QuerySuspiciousAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspiciousAuctions not implemented")
+}
+func (UnimplementedQueryServer) AuctionSchedule(context.Context, *QueryAuctionScheduleRequest) (*QueryAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionSchedule not implemented")
+}
+func (UnimplementedQueryServer) AuctionSchedules(context.Context, *QueryAuctionSchedulesRequest) (*QueryAuctionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionSchedules not implemented")
+}
+func (UnimplementedQueryServer) AuctionRewards(context.Context, *QueryAuctionRewardsRequest) (*QueryAuctionRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionRewards not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +164,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SuspiciousAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuspiciousAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuspiciousAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SuspiciousAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuspiciousAuctions(ctx, req.(*QuerySuspiciousAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionSchedule(ctx, req.(*QueryAuctionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionSchedules(ctx, req.(*QueryAuctionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionRewards(ctx, req.(*QueryAuctionRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +247,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SuspiciousAuctions",
+			Handler:    _Query_SuspiciousAuctions_Handler,
+		},
+		{
+			MethodName: "AuctionSchedule",
+			Handler:    _Query_AuctionSchedule_Handler,
+		},
+		{
+			MethodName: "AuctionSchedules",
+			Handler:    _Query_AuctionSchedules_Handler,
+		},
+		{
+			MethodName: "AuctionRewards",
+			Handler:    _Query_AuctionRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auction/auction/query.proto",
@@ -19,9 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName  = "/auction.auction.Msg/UpdateParams"
-	Msg_CreateAuction_FullMethodName = "/auction.auction.Msg/CreateAuction"
-	Msg_PlaceBid_FullMethodName      = "/auction.auction.Msg/PlaceBid"
+	Msg_UpdateParams_FullMethodName            = "/auction.auction.Msg/UpdateParams"
+	Msg_CreateAuction_FullMethodName           = "/auction.auction.Msg/CreateAuction"
+	Msg_PlaceBid_FullMethodName                = "/auction.auction.Msg/PlaceBid"
+	Msg_CancelAuction_FullMethodName           = "/auction.auction.Msg/CancelAuction"
+	Msg_PauseAuction_FullMethodName            = "/auction.auction.Msg/PauseAuction"
+	Msg_ResumeAuction_FullMethodName           = "/auction.auction.Msg/ResumeAuction"
+	Msg_AdminCancelAuction_FullMethodName      = "/auction.auction.Msg/AdminCancelAuction"
+	Msg_RegisterBidder_FullMethodName          = "/auction.auction.Msg/RegisterBidder"
+	Msg_CompletePurchase_FullMethodName        = "/auction.auction.Msg/CompletePurchase"
+	Msg_CreateAuctionSchedule_FullMethodName   = "/auction.auction.Msg/CreateAuctionSchedule"
+	Msg_CancelAuctionSchedule_FullMethodName   = "/auction.auction.Msg/CancelAuctionSchedule"
+	Msg_FundIncentivePool_FullMethodName       = "/auction.auction.Msg/FundIncentivePool"
+	Msg_ClaimAuctionRewards_FullMethodName     = "/auction.auction.Msg/ClaimAuctionRewards"
+	Msg_CreateTreasuryAuction_FullMethodName   = "/auction.auction.Msg/CreateTreasuryAuction"
+	Msg_SendCreateRemoteAuction_FullMethodName = "/auction.auction.Msg/SendCreateRemoteAuction"
+	Msg_SendPlaceRemoteBid_FullMethodName      = "/auction.auction.Msg/SendPlaceRemoteBid"
+	Msg_GrantBidFeeAllowance_FullMethodName    = "/auction.auction.Msg/GrantBidFeeAllowance"
+	Msg_ClaimNFTLot_FullMethodName             = "/auction.auction.Msg/ClaimNFTLot"
+	Msg_RevealReserve_FullMethodName           = "/auction.auction.Msg/RevealReserve"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +51,52 @@ type MsgClient interface {
 	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
 	// PlaceBid allows users to submit a bid.
 	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// CancelAuction allows the creator to cancel an auction without bids.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a (governance) operation for halting bidding on an
+	// auction, or on the whole module.
+	PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a (governance) operation for resuming bidding on a
+	// paused auction, or on the whole module.
+	ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error)
+	// AdminCancelAuction defines a (governance) operation for voiding an auction
+	// and refunding its escrowed bid.
+	AdminCancelAuction(ctx context.Context, in *MsgAdminCancelAuction, opts ...grpc.CallOption) (*MsgAdminCancelAuctionResponse, error)
+	// RegisterBidder locks the participation deposit required to bid on an
+	// auction.
+	RegisterBidder(ctx context.Context, in *MsgRegisterBidder, opts ...grpc.CallOption) (*MsgRegisterBidderResponse, error)
+	// CompletePurchase allows the winner of a deferred-payment auction to pay
+	// its bid.
+	CompletePurchase(ctx context.Context, in *MsgCompletePurchase, opts ...grpc.CallOption) (*MsgCompletePurchaseResponse, error)
+	// CreateAuctionSchedule creates auctions from a template at a fixed
+	// interval, funding their lots from a pre-deposited balance.
+	CreateAuctionSchedule(ctx context.Context, in *MsgCreateAuctionSchedule, opts ...grpc.CallOption) (*MsgCreateAuctionScheduleResponse, error)
+	// CancelAuctionSchedule stops a schedule and returns its remaining balance.
+	CancelAuctionSchedule(ctx context.Context, in *MsgCancelAuctionSchedule, opts ...grpc.CallOption) (*MsgCancelAuctionScheduleResponse, error)
+	// FundIncentivePool defines a (governance) operation for moving funds from
+	// the community pool to the incentive pool.
+	FundIncentivePool(ctx context.Context, in *MsgFundIncentivePool, opts ...grpc.CallOption) (*MsgFundIncentivePoolResponse, error)
+	// ClaimAuctionRewards pays the incentive rebates accrued by the claimant.
+	ClaimAuctionRewards(ctx context.Context, in *MsgClaimAuctionRewards, opts ...grpc.CallOption) (*MsgClaimAuctionRewardsResponse, error)
+	// CreateTreasuryAuction defines a (governance) operation for selling a lot
+	// of the community pool, the proceeds are returned to the community pool.
+	CreateTreasuryAuction(ctx context.Context, in *MsgCreateTreasuryAuction, opts ...grpc.CallOption) (*MsgCreateTreasuryAuctionResponse, error)
+	// SendCreateRemoteAuction lists an auction on the chain at the other end of
+	// an auction channel.
+	SendCreateRemoteAuction(ctx context.Context, in *MsgSendCreateRemoteAuction, opts ...grpc.CallOption) (*MsgSendCreateRemoteAuctionResponse, error)
+	// SendPlaceRemoteBid escrows a bid and places it on an auction of the chain
+	// at the other end of an auction channel.
+	SendPlaceRemoteBid(ctx context.Context, in *MsgSendPlaceRemoteBid, opts ...grpc.CallOption) (*MsgSendPlaceRemoteBidResponse, error)
+	// GrantBidFeeAllowance lets the creator of an auction sponsoring bid fees
+	// grant allowances to bidders before their first bid.
+	GrantBidFeeAllowance(ctx context.Context, in *MsgGrantBidFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBidFeeAllowanceResponse, error)
+	// ClaimNFTLot releases the NFT lot of a won auction to its winner after
+	// the delivery over ICS-721 failed.
+	ClaimNFTLot(ctx context.Context, in *MsgClaimNFTLot, opts ...grpc.CallOption) (*MsgClaimNFTLotResponse, error)
+	// RevealReserve reveals the hidden reserve price of an ENGLISH auction. The
+	// auction only sells if the reserve is revealed before it ends and the
+	// highest bid meets it.
+	RevealReserve(ctx context.Context, in *MsgRevealReserve, opts ...grpc.CallOption) (*MsgRevealReserveResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +134,150 @@ func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error) {
+	out := new(MsgPauseAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error) {
+	out := new(MsgResumeAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AdminCancelAuction(ctx context.Context, in *MsgAdminCancelAuction, opts ...grpc.CallOption) (*MsgAdminCancelAuctionResponse, error) {
+	out := new(MsgAdminCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_AdminCancelAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBidder(ctx context.Context, in *MsgRegisterBidder, opts ...grpc.CallOption) (*MsgRegisterBidderResponse, error) {
+	out := new(MsgRegisterBidderResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterBidder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CompletePurchase(ctx context.Context, in *MsgCompletePurchase, opts ...grpc.CallOption) (*MsgCompletePurchaseResponse, error) {
+	out := new(MsgCompletePurchaseResponse)
+	err := c.cc.Invoke(ctx, Msg_CompletePurchase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuctionSchedule(ctx context.Context, in *MsgCreateAuctionSchedule, opts ...grpc.CallOption) (*MsgCreateAuctionScheduleResponse, error) {
+	out := new(MsgCreateAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateAuctionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAuctionSchedule(ctx context.Context, in *MsgCancelAuctionSchedule, opts ...grpc.CallOption) (*MsgCancelAuctionScheduleResponse, error) {
+	out := new(MsgCancelAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAuctionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundIncentivePool(ctx context.Context, in *MsgFundIncentivePool, opts ...grpc.CallOption) (*MsgFundIncentivePoolResponse, error) {
+	out := new(MsgFundIncentivePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_FundIncentivePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAuctionRewards(ctx context.Context, in *MsgClaimAuctionRewards, opts ...grpc.CallOption) (*MsgClaimAuctionRewardsResponse, error) {
+	out := new(MsgClaimAuctionRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimAuctionRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTreasuryAuction(ctx context.Context, in *MsgCreateTreasuryAuction, opts ...grpc.CallOption) (*MsgCreateTreasuryAuctionResponse, error) {
+	out := new(MsgCreateTreasuryAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateTreasuryAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendCreateRemoteAuction(ctx context.Context, in *MsgSendCreateRemoteAuction, opts ...grpc.CallOption) (*MsgSendCreateRemoteAuctionResponse, error) {
+	out := new(MsgSendCreateRemoteAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_SendCreateRemoteAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendPlaceRemoteBid(ctx context.Context, in *MsgSendPlaceRemoteBid, opts ...grpc.CallOption) (*MsgSendPlaceRemoteBidResponse, error) {
+	out := new(MsgSendPlaceRemoteBidResponse)
+	err := c.cc.Invoke(ctx, Msg_SendPlaceRemoteBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantBidFeeAllowance(ctx context.Context, in *MsgGrantBidFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBidFeeAllowanceResponse, error) {
+	out := new(MsgGrantBidFeeAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantBidFeeAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimNFTLot(ctx context.Context, in *MsgClaimNFTLot, opts ...grpc.CallOption) (*MsgClaimNFTLotResponse, error) {
+	out := new(MsgClaimNFTLotResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimNFTLot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealReserve(ctx context.Context, in *MsgRevealReserve, opts ...grpc.CallOption) (*MsgRevealReserveResponse, error) {
+	out := new(MsgRevealReserveResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealReserve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +289,52 @@ type MsgServer interface {
 	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
 	// PlaceBid allows users to submit a bid.
 	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// CancelAuction allows the creator to cancel an auction without bids.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a (governance) operation for halting bidding on an
+	// auction, or on the whole module.
+	PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a (governance) operation for resuming bidding on a
+	// paused auction, or on the whole module.
+	ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error)
+	// AdminCancelAuction defines a (governance) operation for voiding an auction
+	// and refunding its escrowed bid.
+	AdminCancelAuction(context.Context, *MsgAdminCancelAuction) (*MsgAdminCancelAuctionResponse, error)
+	// RegisterBidder locks the participation deposit required to bid on an
+	// auction.
+	RegisterBidder(context.Context, *MsgRegisterBidder) (*MsgRegisterBidderResponse, error)
+	// CompletePurchase allows the winner of a deferred-payment auction to pay
+	// its bid.
+	CompletePurchase(context.Context, *MsgCompletePurchase) (*MsgCompletePurchaseResponse, error)
+	// CreateAuctionSchedule creates auctions from a template at a fixed
+	// interval, funding their lots from a pre-deposited balance.
+	CreateAuctionSchedule(context.Context, *MsgCreateAuctionSchedule) (*MsgCreateAuctionScheduleResponse, error)
+	// CancelAuctionSchedule stops a schedule and returns its remaining balance.
+	CancelAuctionSchedule(context.Context, *MsgCancelAuctionSchedule) (*MsgCancelAuctionScheduleResponse, error)
+	// FundIncentivePool defines a (governance) operation for moving funds from
+	// the community pool to the incentive pool.
+	FundIncentivePool(context.Context, *MsgFundIncentivePool) (*MsgFundIncentivePoolResponse, error)
+	// ClaimAuctionRewards pays the incentive rebates accrued by the claimant.
+	ClaimAuctionRewards(context.Context, *MsgClaimAuctionRewards) (*MsgClaimAuctionRewardsResponse, error)
+	// CreateTreasuryAuction defines a (governance) operation for selling a lot
+	// of the community pool, the proceeds are returned to the community pool.
+	CreateTreasuryAuction(context.Context, *MsgCreateTreasuryAuction) (*MsgCreateTreasuryAuctionResponse, error)
+	// SendCreateRemoteAuction lists an auction on the chain at the other end of
+	// an auction channel.
+	SendCreateRemoteAuction(context.Context, *MsgSendCreateRemoteAuction) (*MsgSendCreateRemoteAuctionResponse, error)
+	// SendPlaceRemoteBid escrows a bid and places it on an auction of the chain
+	// at the other end of an auction channel.
+	SendPlaceRemoteBid(context.Context, *MsgSendPlaceRemoteBid) (*MsgSendPlaceRemoteBidResponse, error)
+	// GrantBidFeeAllowance lets the creator of an auction sponsoring bid fees
+	// grant allowances to bidders before their first bid.
+	GrantBidFeeAllowance(context.Context, *MsgGrantBidFeeAllowance) (*MsgGrantBidFeeAllowanceResponse, error)
+	// ClaimNFTLot releases the NFT lot of a won auction to its winner after
+	// the delivery over ICS-721 failed.
+	ClaimNFTLot(context.Context, *MsgClaimNFTLot) (*MsgClaimNFTLotResponse, error)
+	// RevealReserve reveals the hidden reserve price of an ENGLISH auction. The
+	// auction only sells if the reserve is revealed before it ends and the
+	// highest bid meets it.
+	RevealReserve(context.Context, *MsgRevealReserve) (*MsgRevealReserveResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +351,54 @@ func (UnimplementedMsgServer) CreateAuction(context.Context, *MsgCreateAuction)
 func (UnimplementedMsgServer) PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
+func (UnimplementedMsgServer) CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (UnimplementedMsgServer) PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAuction not implemented")
+}
+func (UnimplementedMsgServer) ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAuction not implemented")
+}
+func (UnimplementedMsgServer) AdminCancelAuction(context.Context, *MsgAdminCancelAuction) (*MsgAdminCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCancelAuction not implemented")
+}
+func (UnimplementedMsgServer) RegisterBidder(context.Context, *MsgRegisterBidder) (*MsgRegisterBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBidder not implemented")
+}
+func (UnimplementedMsgServer) CompletePurchase(context.Context, *MsgCompletePurchase) (*MsgCompletePurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePurchase not implemented")
+}
+func (UnimplementedMsgServer) CreateAuctionSchedule(context.Context, *MsgCreateAuctionSchedule) (*MsgCreateAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuctionSchedule not implemented")
+}
+func (UnimplementedMsgServer) CancelAuctionSchedule(context.Context, *MsgCancelAuctionSchedule) (*MsgCancelAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuctionSchedule not implemented")
+}
+func (UnimplementedMsgServer) FundIncentivePool(context.Context, *MsgFundIncentivePool) (*MsgFundIncentivePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundIncentivePool not implemented")
+}
+func (UnimplementedMsgServer) ClaimAuctionRewards(context.Context, *MsgClaimAuctionRewards) (*MsgClaimAuctionRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAuctionRewards not implemented")
+}
+func (UnimplementedMsgServer) CreateTreasuryAuction(context.Context, *MsgCreateTreasuryAuction) (*MsgCreateTreasuryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreasuryAuction not implemented")
+}
+func (UnimplementedMsgServer) SendCreateRemoteAuction(context.Context, *MsgSendCreateRemoteAuction) (*MsgSendCreateRemoteAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCreateRemoteAuction not implemented")
+}
+func (UnimplementedMsgServer) SendPlaceRemoteBid(context.Context, *MsgSendPlaceRemoteBid) (*MsgSendPlaceRemoteBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPlaceRemoteBid not implemented")
+}
+func (UnimplementedMsgServer) GrantBidFeeAllowance(context.Context, *MsgGrantBidFeeAllowance) (*MsgGrantBidFeeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBidFeeAllowance not implemented")
+}
+func (UnimplementedMsgServer) ClaimNFTLot(context.Context, *MsgClaimNFTLot) (*MsgClaimNFTLotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNFTLot not implemented")
+}
+func (UnimplementedMsgServer) RevealReserve(context.Context, *MsgRevealReserve) (*MsgRevealReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealReserve not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +466,294 @@ func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseAuction(ctx, req.(*MsgPauseAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeAuction(ctx, req.(*MsgResumeAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdminCancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdminCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdminCancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AdminCancelAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdminCancelAuction(ctx, req.(*MsgAdminCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterBidder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBidder(ctx, req.(*MsgRegisterBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CompletePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompletePurchase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompletePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CompletePurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompletePurchase(ctx, req.(*MsgCompletePurchase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuctionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateAuctionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuctionSchedule(ctx, req.(*MsgCreateAuctionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuctionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAuctionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuctionSchedule(ctx, req.(*MsgCancelAuctionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundIncentivePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundIncentivePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundIncentivePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundIncentivePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundIncentivePool(ctx, req.(*MsgFundIncentivePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAuctionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAuctionRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAuctionRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimAuctionRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAuctionRewards(ctx, req.(*MsgClaimAuctionRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTreasuryAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTreasuryAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTreasuryAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateTreasuryAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTreasuryAuction(ctx, req.(*MsgCreateTreasuryAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendCreateRemoteAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendCreateRemoteAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendCreateRemoteAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendCreateRemoteAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendCreateRemoteAuction(ctx, req.(*MsgSendCreateRemoteAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendPlaceRemoteBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendPlaceRemoteBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendPlaceRemoteBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendPlaceRemoteBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendPlaceRemoteBid(ctx, req.(*MsgSendPlaceRemoteBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantBidFeeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBidFeeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBidFeeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantBidFeeAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBidFeeAllowance(ctx, req.(*MsgGrantBidFeeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimNFTLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimNFTLot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimNFTLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimNFTLot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimNFTLot(ctx, req.(*MsgClaimNFTLot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealReserve(ctx, req.(*MsgRevealReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +773,70 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceBid",
 			Handler:    _Msg_PlaceBid_Handler,
 		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "PauseAuction",
+			Handler:    _Msg_PauseAuction_Handler,
+		},
+		{
+			MethodName: "ResumeAuction",
+			Handler:    _Msg_ResumeAuction_Handler,
+		},
+		{
+			MethodName: "AdminCancelAuction",
+			Handler:    _Msg_AdminCancelAuction_Handler,
+		},
+		{
+			MethodName: "RegisterBidder",
+			Handler:    _Msg_RegisterBidder_Handler,
+		},
+		{
+			MethodName: "CompletePurchase",
+			Handler:    _Msg_CompletePurchase_Handler,
+		},
+		{
+			MethodName: "CreateAuctionSchedule",
+			Handler:    _Msg_CreateAuctionSchedule_Handler,
+		},
+		{
+			MethodName: "CancelAuctionSchedule",
+			Handler:    _Msg_CancelAuctionSchedule_Handler,
+		},
+		{
+			MethodName: "FundIncentivePool",
+			Handler:    _Msg_FundIncentivePool_Handler,
+		},
+		{
+			MethodName: "ClaimAuctionRewards",
+			Handler:    _Msg_ClaimAuctionRewards_Handler,
+		},
+		{
+			MethodName: "CreateTreasuryAuction",
+			Handler:    _Msg_CreateTreasuryAuction_Handler,
+		},
+		{
+			MethodName: "SendCreateRemoteAuction",
+			Handler:    _Msg_SendCreateRemoteAuction_Handler,
+		},
+		{
+			MethodName: "SendPlaceRemoteBid",
+			Handler:    _Msg_SendPlaceRemoteBid_Handler,
+		},
+		{
+			MethodName: "GrantBidFeeAllowance",
+			Handler:    _Msg_GrantBidFeeAllowance_Handler,
+		},
+		{
+			MethodName: "ClaimNFTLot",
+			Handler:    _Msg_ClaimNFTLot_Handler,
+		},
+		{
+			MethodName: "RevealReserve",
+			Handler:    _Msg_RevealReserve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auction/auction/tx.proto",
@@ -74,6 +74,7 @@ import (
 	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 
 	auctionmodulekeeper "auction/x/auction/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
@@ -364,6 +365,26 @@ func (app *App) GetCapabilityScopedKeeper(moduleName string) capabilitykeeper.Sc
 	return sk
 }
 
+// GetBaseApp returns the base app of the application.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetScopedIBCKeeper returns the IBC scoped keeper.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the tx config of the application.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // SimulationManager implements the SimulationApp interface.
 func (app *App) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -0,0 +1,158 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"auction/app"
+	"auction/testutil/sample"
+	auctionkeeper "auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+type groupAuctionFixture struct {
+	ctx           sdk.Context
+	groupKeeper   groupkeeper.Keeper
+	bankKeeper    bankkeeper.Keeper
+	auctionKeeper auctionkeeper.Keeper
+	member        string
+	policy        string
+}
+
+// setupGroupAuction starts an app with a group of a single member whose
+// policy passes any proposal the member votes for.
+func setupGroupAuction(t *testing.T) groupAuctionFixture {
+	var f groupAuctionFixture
+	a, err := simtestutil.Setup(
+		depinject.Configs(app.AppConfig(), depinject.Supply(log.NewNopLogger())),
+		&f.groupKeeper, &f.bankKeeper, &f.auctionKeeper,
+	)
+	require.NoError(t, err)
+	f.ctx = a.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+	f.member = sample.AccAddress()
+	policy := group.NewThresholdDecisionPolicy("1", time.Hour, 0)
+	msg, err := group.NewMsgCreateGroupWithPolicy(f.member, []group.MemberRequest{{Address: f.member, Weight: "1"}}, "", "", true, policy)
+	require.NoError(t, err)
+	res, err := f.groupKeeper.CreateGroupWithPolicy(f.ctx, msg)
+	require.NoError(t, err)
+	f.policy = res.GroupPolicyAddress
+
+	return f
+}
+
+// propose submits the messages as a proposal of the group policy and executes
+// it right away.
+func (f groupAuctionFixture) propose(t *testing.T, msgs ...sdk.Msg) error {
+	msg, err := group.NewMsgSubmitProposal(f.policy, []string{f.member}, msgs, "", group.Exec_EXEC_TRY, "", "")
+	require.NoError(t, err)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := f.groupKeeper.SubmitProposal(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	// executed proposals are pruned, their result is only left in events
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&group.EventExec{}) {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if exec, ok := typed.(*group.EventExec); ok && exec.ProposalId == res.ProposalId {
+			require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec.Result, exec.Logs)
+			return nil
+		}
+	}
+	require.Fail(t, "proposal was not executed")
+	return nil
+}
+
+func TestGroupPolicyCreatesAuction(t *testing.T) {
+	f := setupGroupAuction(t)
+	ms := auctionkeeper.NewMsgServerImpl(f.auctionKeeper)
+	bidder := sample.AccAddress()
+	require.NoError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, sdk.MustAccAddressFromBech32(bidder), sdk.NewCoins(sdk.NewInt64Coin("token", 100))))
+
+	// the policy address has to be the creator, the signer of the message
+	err := f.propose(t, types.NewMsgCreateAuction(f.member, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.ErrorContains(t, err, "msg does not have group policy authorization")
+
+	require.NoError(t, f.propose(t, types.NewMsgCreateAuction(f.policy, "item", sdk.NewInt64Coin("token", 10), 10)))
+	auction, found := f.auctionKeeper.GetAuction(f.ctx, "auction-0")
+	require.True(t, found)
+	require.Equal(t, f.policy, auction.Creator)
+
+	// the proceeds settle to the group policy
+	_, err = ms.PlaceBid(f.ctx, types.NewMsgPlaceBid(bidder, auction.Id, sdk.NewInt64Coin("token", 40)))
+	require.NoError(t, err)
+	require.NoError(t, f.auctionKeeper.SettleAuction(f.ctx, auction.Id))
+	require.Equal(t, sdk.NewInt64Coin("token", 40), f.bankKeeper.GetBalance(f.ctx, sdk.MustAccAddressFromBech32(f.policy), "token"))
+}
+
+func TestGroupPolicyCancelsAuction(t *testing.T) {
+	f := setupGroupAuction(t)
+	require.NoError(t, f.propose(t, types.NewMsgCreateAuction(f.policy, "item", sdk.NewInt64Coin("token", 10), 10)))
+
+	err := f.propose(t, types.NewMsgCancelAuction(f.member, "auction-0"))
+	require.ErrorContains(t, err, "msg does not have group policy authorization")
+
+	require.NoError(t, f.propose(t, types.NewMsgCancelAuction(f.policy, "auction-0")))
+	auction, found := f.auctionKeeper.GetAuction(f.ctx, "auction-0")
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_CANCELLED, auction.Status)
+}
+
+func TestGroupPolicyReceivesDeferredPayment(t *testing.T) {
+	f := setupGroupAuction(t)
+	ms := auctionkeeper.NewMsgServerImpl(f.auctionKeeper)
+	bidder := sample.AccAddress()
+	require.NoError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, sdk.MustAccAddressFromBech32(bidder), sdk.NewCoins(sdk.NewInt64Coin("token", 100))))
+
+	deposit := sdk.NewInt64Coin("token", 5)
+	msg := types.NewMsgCreateAuction(f.policy, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	msg.DeferredPayment = true
+	require.NoError(t, f.propose(t, msg))
+
+	_, err := ms.RegisterBidder(f.ctx, &types.MsgRegisterBidder{Bidder: bidder, AuctionId: "auction-0"})
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(f.ctx, types.NewMsgPlaceBid(bidder, "auction-0", sdk.NewInt64Coin("token", 40)))
+	require.NoError(t, err)
+	require.NoError(t, f.auctionKeeper.SettleAuction(f.ctx, "auction-0"))
+	_, err = ms.CompletePurchase(f.ctx, &types.MsgCompletePurchase{Buyer: bidder, AuctionId: "auction-0"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("token", 40), f.bankKeeper.GetBalance(f.ctx, sdk.MustAccAddressFromBech32(f.policy), "token"))
+}
+
+func TestGroupPolicyRevealsReserve(t *testing.T) {
+	f := setupGroupAuction(t)
+	ms := auctionkeeper.NewMsgServerImpl(f.auctionKeeper)
+	bidder := sample.AccAddress()
+	require.NoError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, sdk.MustAccAddressFromBech32(bidder), sdk.NewCoins(sdk.NewInt64Coin("token", 100))))
+
+	reserve := sdk.NewInt64Coin("token", 30)
+	msg := types.NewMsgCreateAuction(f.policy, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.ReserveCommitment = types.ReserveCommitment(reserve, "salt")
+	require.NoError(t, f.propose(t, msg))
+	_, err := ms.PlaceBid(f.ctx, types.NewMsgPlaceBid(bidder, "auction-0", sdk.NewInt64Coin("token", 40)))
+	require.NoError(t, err)
+
+	err = f.propose(t, types.NewMsgRevealReserve(f.member, "auction-0", reserve, "salt"))
+	require.ErrorContains(t, err, "msg does not have group policy authorization")
+
+	require.NoError(t, f.propose(t, types.NewMsgRevealReserve(f.policy, "auction-0", reserve, "salt")))
+	require.NoError(t, f.auctionKeeper.SettleAuction(f.ctx, "auction-0"))
+	require.Equal(t, sdk.NewInt64Coin("token", 40), f.bankKeeper.GetBalance(f.ctx, sdk.MustAccAddressFromBech32(f.policy), "token"))
+}
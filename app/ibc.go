@@ -35,6 +35,9 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	auctionmodule "auction/x/auction/module"
+	auctionmoduletypes "auction/x/auction/types"
 	// this line is used by starport scaffolding # ibc/app/import
 )
 
@@ -74,6 +77,9 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	scopedIBCTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	// the auction keeper gets its scoped keeper through GetCapabilityScopedKeeper,
+	// scope it before the capability keeper initializes its memory store
+	app.GetCapabilityScopedKeeper(auctionmoduletypes.ModuleName)
 
 	// Create IBC keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -142,8 +148,16 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// refunds of bids placed over IBC are sent back with the transfer keeper
+	app.AuctionKeeper.SetTransferKeeper(app.TransferKeeper)
+
+	// Create IBC modules with ibcfee middleware, the auction middleware places
+	// the bids carried in the memo of incoming transfers. It sits below ibcfee
+	// so that the acknowledgements of failed bids are still incentivized.
+	transferIBCModule := ibcfee.NewIBCMiddleware(
+		auctionmodule.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.AuctionKeeper),
+		app.IBCFeeKeeper,
+	)
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
@@ -158,7 +172,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(auctionmoduletypes.ModuleName, auctionmodule.NewIBCModule(app.AuctionKeeper))
 
 	// this line is used by starport scaffolding # ibc/app/module
 
@@ -0,0 +1,356 @@
+package app_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"auction/app"
+	auctionkeeper "auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		a, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		if err != nil {
+			panic(err)
+		}
+		return a, a.DefaultGenesis()
+	}
+}
+
+// remoteAuctionFixture runs two chains of the app connected by an auction
+// channel. Accounts of chain A list auctions and bid on chain B.
+type remoteAuctionFixture struct {
+	coord  *ibctesting.Coordinator
+	path   *ibctesting.Path
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func setupRemoteAuction(t *testing.T) remoteAuctionFixture {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+
+	return remoteAuctionFixture{coord: coord, path: path, chainA: chainA, chainB: chainB}
+}
+
+func getApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+// useSender makes the chain sign its next transactions with one of its
+// sender accounts.
+func useSender(chain *ibctesting.TestChain, i int) sdk.AccAddress {
+	chain.SenderPrivKey = chain.SenderAccounts[i].SenderPrivKey
+	chain.SenderAccount = chain.SenderAccounts[i].SenderAccount
+	return chain.SenderAccount.GetAddress()
+}
+
+func (f remoteAuctionFixture) timeout() uint64 {
+	return uint64(f.coord.CurrentTime.Add(time.Hour).UnixNano())
+}
+
+func (f remoteAuctionFixture) balance(chain *ibctesting.TestChain, addr sdk.AccAddress, denom string) math.Int {
+	return getApp(chain).BankKeeper.GetBalance(chain.GetContext(), addr, denom).Amount
+}
+
+// send delivers the message on chain A and returns the packet it sent.
+func (f remoteAuctionFixture) send(t *testing.T, msg sdk.Msg) channeltypes.Packet {
+	res, err := f.chainA.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+// relay relays the packet and its acknowledgement, it returns the events of
+// the reception.
+func (f remoteAuctionFixture) relay(t *testing.T, packet channeltypes.Packet) []abci.Event {
+	res, _, err := f.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+	return res.Events
+}
+
+// placeRemoteBid bids from the sender of chain A on the auction of chain B
+// and relays the bid, it returns the events of the reception on chain B.
+func (f remoteAuctionFixture) placeRemoteBid(t *testing.T, sender int, auctionID string, amount int64) []abci.Event {
+	bidder := useSender(f.chainA, sender)
+	msg := types.NewMsgSendPlaceRemoteBid(bidder.String(), types.PortID, f.path.EndpointA.ChannelID, f.timeout(), auctionID, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	return f.relay(t, f.send(t, msg))
+}
+
+// requireVouchersBacked checks that the vouchers of chain B are all escrowed
+// in remote bids or held by remote participants.
+func (f remoteAuctionFixture) requireVouchersBacked(t *testing.T) {
+	msg, broken := auctionkeeper.VoucherInvariant(getApp(f.chainB).AuctionKeeper)(f.chainB.GetContext())
+	require.False(t, broken, msg)
+}
+
+func (f remoteAuctionFixture) createRemoteAuction(t *testing.T) string {
+	creator := useSender(f.chainA, 0)
+	msg := types.NewMsgSendCreateRemoteAuction(creator.String(), types.PortID, f.path.EndpointA.ChannelID, f.timeout(), "painting", sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), 3600)
+	f.relay(t, f.send(t, msg))
+
+	auctions := getApp(f.chainB).AuctionKeeper.GetAllAuction(f.chainB.GetContext())
+	require.Len(t, auctions, 1)
+	return auctions[0].Id
+}
+
+func TestRemoteAuctionSettlement(t *testing.T) {
+	f := setupRemoteAuction(t)
+	keeperB := getApp(f.chainB).AuctionKeeper
+	denom := sdk.DefaultBondDenom
+	voucher := types.VoucherDenom(types.PortID, f.path.EndpointB.ChannelID, denom)
+	escrow := auctionkeeper.RemoteEscrowAddress(types.PortID, f.path.EndpointA.ChannelID)
+	creator := f.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	first := f.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	second := f.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+	auctionID := f.createRemoteAuction(t)
+	auction, _ := keeperB.GetAuction(f.chainB.GetContext(), auctionID)
+	require.Equal(t, auctionkeeper.IBCBidderAddress(f.path.EndpointB.ChannelID, creator.String()).String(), auction.Creator)
+	require.Equal(t, sdk.NewInt64Coin(voucher, 100), *auction.StartingBid)
+
+	// the bid is escrowed on chain A and placed with vouchers on chain B
+	creatorBalance := f.balance(f.chainA, creator, denom)
+	firstBalance := f.balance(f.chainA, first, denom)
+	secondBalance := f.balance(f.chainA, second, denom)
+	f.placeRemoteBid(t, 1, auctionID, 150)
+	f.requireVouchersBacked(t)
+	require.Equal(t, firstBalance.SubRaw(150).String(), f.balance(f.chainA, first, denom).String())
+	require.Equal(t, "150", f.balance(f.chainA, escrow, denom).String())
+	auction, _ = keeperB.GetAuction(f.chainB.GetContext(), auctionID)
+	firstBidder := auctionkeeper.IBCBidderAddress(f.path.EndpointB.ChannelID, first.String())
+	require.Equal(t, firstBidder.String(), auction.Bids[0].Bidder)
+
+	// outbidding refunds the first bidder with an auction result
+	events := f.placeRemoteBid(t, 2, auctionID, 200)
+	f.requireVouchersBacked(t)
+	result, err := ibctesting.ParsePacketFromEvents(events)
+	require.NoError(t, err)
+	f.relay(t, result)
+	require.Equal(t, firstBalance.String(), f.balance(f.chainA, first, denom).String())
+	require.Equal(t, secondBalance.SubRaw(200).String(), f.balance(f.chainA, second, denom).String())
+	require.Equal(t, "200", f.balance(f.chainA, escrow, denom).String())
+	require.True(t, f.balance(f.chainB, firstBidder, voucher).IsZero())
+
+	// the settlement tells the winner and pays the creator from the escrow
+	ctx := f.chainB.GetContext()
+	require.NoError(t, keeperB.SettleAuction(ctx, auctionID))
+	var results []channeltypes.Packet
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := ibctesting.ParsePacketFromEvents([]abci.Event{event})
+		require.NoError(t, err)
+		results = append(results, packet)
+	}
+	require.Len(t, results, 2)
+	f.coord.CommitBlock(f.chainB)
+
+	outcomes := map[string]types.RemoteAuctionOutcome{}
+	for _, packet := range results {
+		var data types.AuctionPacketData
+		require.NoError(t, data.Unmarshal(packet.GetData()))
+		result := data.GetAuctionResultPacket()
+		require.NotNil(t, result)
+		outcomes[result.Receiver] = result.Outcome
+		f.relay(t, packet)
+	}
+	require.Equal(t, map[string]types.RemoteAuctionOutcome{
+		creator.String(): types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_SOLD,
+		second.String():  types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_WON,
+	}, outcomes)
+	require.Equal(t, creatorBalance.AddRaw(200).String(), f.balance(f.chainA, creator, denom).String())
+	require.Equal(t, secondBalance.SubRaw(200).String(), f.balance(f.chainA, second, denom).String())
+	require.True(t, f.balance(f.chainA, escrow, denom).IsZero())
+	require.True(t, getApp(f.chainB).BankKeeper.GetSupply(f.chainB.GetContext(), voucher).IsZero())
+	f.requireVouchersBacked(t)
+
+	// vouchers outside of escrow and remote participants break the invariant
+	ctx = f.chainB.GetContext()
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(voucher, 1))
+	require.NoError(t, getApp(f.chainB).BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, getApp(f.chainB).BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, f.chainB.SenderAccount.GetAddress(), vouchers))
+	_, broken := auctionkeeper.VoucherInvariant(keeperB)(ctx)
+	require.True(t, broken)
+}
+
+func TestRemoteBidRollback(t *testing.T) {
+	f := setupRemoteAuction(t)
+	denom := sdk.DefaultBondDenom
+	escrow := auctionkeeper.RemoteEscrowAddress(types.PortID, f.path.EndpointA.ChannelID)
+	bidder := f.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	auctionID := f.createRemoteAuction(t)
+	balance := f.balance(f.chainA, bidder, denom)
+
+	// a bid below the starting bid is rejected, the error acknowledgement
+	// refunds the escrow and chain B keeps no vouchers
+	events := f.placeRemoteBid(t, 1, auctionID, 50)
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	require.NoError(t, err)
+	require.Contains(t, string(ack), "error")
+	require.Equal(t, balance.String(), f.balance(f.chainA, bidder, denom).String())
+	require.True(t, f.balance(f.chainA, escrow, denom).IsZero())
+	voucher := types.VoucherDenom(types.PortID, f.path.EndpointB.ChannelID, denom)
+	require.True(t, getApp(f.chainB).BankKeeper.GetSupply(f.chainB.GetContext(), voucher).IsZero())
+
+	// a bid that times out is refunded as well
+	useSender(f.chainA, 1)
+	timeout := uint64(f.coord.CurrentTime.Add(time.Minute).UnixNano())
+	msg := types.NewMsgSendPlaceRemoteBid(bidder.String(), types.PortID, f.path.EndpointA.ChannelID, timeout, auctionID, sdk.NewInt64Coin(denom, 150))
+	packet := f.send(t, msg)
+	require.Equal(t, balance.SubRaw(150).String(), f.balance(f.chainA, bidder, denom).String())
+	f.coord.IncrementTimeBy(time.Hour)
+	f.coord.CommitBlock(f.chainB)
+	require.NoError(t, f.path.EndpointA.UpdateClient())
+	require.NoError(t, f.path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, balance.String(), f.balance(f.chainA, bidder, denom).String())
+	require.True(t, f.balance(f.chainA, escrow, denom).IsZero())
+}
+
+func TestRemoteBidOnCoinLot(t *testing.T) {
+	f := setupRemoteAuction(t)
+	denom := sdk.DefaultBondDenom
+	escrow := auctionkeeper.RemoteEscrowAddress(types.PortID, f.path.EndpointA.ChannelID)
+	bidder := f.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := f.balance(f.chainA, bidder, denom)
+	voucher := types.VoucherDenom(types.PortID, f.path.EndpointB.ChannelID, denom)
+
+	// a coin lot could not be returned with the auction result, bids from
+	// the auction channel are rejected and refunded on chain A
+	seller := useSender(f.chainB, 0)
+	lot := sdk.NewInt64Coin(denom, 1000)
+	msg := types.NewMsgCreateAuction(seller.String(), "tokens", sdk.NewInt64Coin(voucher, 100), 3600)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = 1
+	msg.Lot = &lot
+	_, err := f.chainB.SendMsgs(msg)
+	require.NoError(t, err)
+	f.coord.CommitBlock(f.chainA)
+	auctions := getApp(f.chainB).AuctionKeeper.GetAllAuction(f.chainB.GetContext())
+	require.Len(t, auctions, 1)
+
+	events := f.placeRemoteBid(t, 1, auctions[0].Id, 150)
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	require.NoError(t, err)
+	require.Contains(t, string(ack), fmt.Sprintf("ABCI code: %d", types.ErrInvalidIBCBid.ABCICode()))
+	require.Equal(t, balance.String(), f.balance(f.chainA, bidder, denom).String())
+	require.True(t, f.balance(f.chainA, escrow, denom).IsZero())
+	require.True(t, getApp(f.chainB).BankKeeper.GetSupply(f.chainB.GetContext(), voucher).IsZero())
+	auction, _ := getApp(f.chainB).AuctionKeeper.GetAuction(f.chainB.GetContext(), auctions[0].Id)
+	require.Empty(t, auction.Bids)
+}
+
+func TestRemoteBidOnLocalAuction(t *testing.T) {
+	f := setupRemoteAuction(t)
+	denom := sdk.DefaultBondDenom
+	bidder := f.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := f.balance(f.chainA, bidder, denom)
+	voucher := types.VoucherDenom(types.PortID, f.path.EndpointB.ChannelID, denom)
+
+	// a seller of chain B paid in vouchers could never redeem them, only
+	// auctions created over the channel take its bids
+	seller := useSender(f.chainB, 0)
+	_, err := f.chainB.SendMsgs(types.NewMsgCreateAuction(seller.String(), "painting", sdk.NewInt64Coin(voucher, 100), 3600))
+	require.NoError(t, err)
+	f.coord.CommitBlock(f.chainA)
+	auctions := getApp(f.chainB).AuctionKeeper.GetAllAuction(f.chainB.GetContext())
+	require.Len(t, auctions, 1)
+
+	events := f.placeRemoteBid(t, 1, auctions[0].Id, 150)
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	require.NoError(t, err)
+	require.Contains(t, string(ack), fmt.Sprintf("ABCI code: %d", types.ErrInvalidIBCBid.ABCICode()))
+	require.Equal(t, balance.String(), f.balance(f.chainA, bidder, denom).String())
+	require.True(t, getApp(f.chainB).BankKeeper.GetSupply(f.chainB.GetContext(), voucher).IsZero())
+	f.requireVouchersBacked(t)
+}
+
+func TestAuctionChannelVersion(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = "ics20-1"
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.SetupConnections(path)
+	require.Error(t, path.EndpointA.ChanOpenInit())
+}
+
+func TestTransferBidOverFeeChannel(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: transfertypes.Version}))
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = feeVersion
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+	f := remoteAuctionFixture{coord: coord, path: path, chainA: chainA, chainB: chainB}
+
+	denom := sdk.DefaultBondDenom
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(ibctesting.TransferPort, path.EndpointB.ChannelID, denom)).IBCDenom()
+	seller := useSender(chainB, 0)
+	_, err := chainB.SendMsgs(types.NewMsgCreateAuction(seller.String(), "painting", sdk.NewInt64Coin(voucher, 100), 3600))
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+	auctions := getApp(chainB).AuctionKeeper.GetAllAuction(chainB.GetContext())
+	require.Len(t, auctions, 1)
+	auctionID := auctions[0].Id
+
+	bidder := useSender(chainA, 1)
+	balance := f.balance(chainA, bidder, denom)
+	memo := fmt.Sprintf(`{"auction":{"place_bid":{"auction_id":%q}}}`, auctionID)
+	transfer := func(amount int64) ibcfeetypes.IncentivizedAcknowledgement {
+		msg := transfertypes.NewMsgTransfer(ibctesting.TransferPort, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, amount), bidder.String(), seller.String(), clienttypes.ZeroHeight(), f.timeout(), memo)
+		bz, err := ibctesting.ParseAckFromEvents(f.relay(t, f.send(t, msg)))
+		require.NoError(t, err)
+		var ack ibcfeetypes.IncentivizedAcknowledgement
+		require.NoError(t, ibcfeetypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+		return ack
+	}
+
+	// a failed bid still answers with an incentivized acknowledgement, which
+	// chain A unwraps to refund the transfer
+	ack := transfer(50)
+	require.False(t, ack.UnderlyingAppSuccess)
+	require.Contains(t, string(ack.AppAcknowledgement), "error")
+	require.Equal(t, balance.String(), f.balance(chainA, bidder, denom).String())
+
+	ack = transfer(150)
+	require.True(t, ack.UnderlyingAppSuccess)
+	require.Equal(t, balance.SubRaw(150).String(), f.balance(chainA, bidder, denom).String())
+	auction, _ := getApp(chainB).AuctionKeeper.GetAuction(chainB.GetContext(), auctionID)
+	require.Len(t, auction.Bids, 1)
+	require.Equal(t, sdk.NewInt64Coin(voucher, 150), *auction.Bids[0].BidAmount)
+}
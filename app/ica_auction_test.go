@@ -0,0 +1,129 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"auction/x/auction/types"
+)
+
+// interchainAccount is an account on chain B controlled by a sender of
+// chain A.
+type interchainAccount struct {
+	path    *ibctesting.Path
+	sender  int
+	owner   string
+	address string
+}
+
+// registerInterchainAccount opens an interchain account on chain B for the
+// sender of chain A over the connection of the path.
+func registerInterchainAccount(t *testing.T, connection *ibctesting.Path, sender int) interchainAccount {
+	chainA, chainB := connection.EndpointA.Chain, connection.EndpointB.Chain
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ClientID, path.EndpointB.ClientID = connection.EndpointA.ClientID, connection.EndpointB.ClientID
+	path.EndpointA.ConnectionID, path.EndpointB.ConnectionID = connection.EndpointA.ConnectionID, connection.EndpointB.ConnectionID
+
+	owner := useSender(chainA, sender).String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+	version := icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID)
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.Version = version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+
+	res, err := chainA.SendMsgs(icacontrollertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, version))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	address, found := getApp(chainB).ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+	require.True(t, found)
+
+	return interchainAccount{path: path, sender: sender, owner: owner, address: address}
+}
+
+// execute runs the messages from the interchain account and returns whether
+// chain B executed them.
+func (ica interchainAccount) execute(t *testing.T, msgs ...proto.Message) bool {
+	chainA, chainB := ica.path.EndpointA.Chain, ica.path.EndpointB.Chain
+	data, err := icatypes.SerializeCosmosTx(getApp(chainB).AppCodec(), msgs, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+
+	useSender(chainA, ica.sender)
+	res, err := chainA.SendMsgs(icacontrollertypes.NewMsgSendTx(ica.owner, ica.path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	_, ackBytes, err := ica.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack.Success()
+}
+
+func TestInterchainAccountAuction(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA, chainB := coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2))
+	connection := ibctesting.NewPath(chainA, chainB)
+	coord.SetupConnections(connection)
+
+	creator := registerInterchainAccount(t, connection, 0)
+	bidder := registerInterchainAccount(t, connection, 1)
+	denom := sdk.DefaultBondDenom
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	local := useSender(chainB, 0)
+	for _, ica := range []interchainAccount{creator, bidder} {
+		_, err := chainB.SendMsgs(banktypes.NewMsgSend(local, sdk.MustAccAddressFromBech32(ica.address), funds))
+		require.NoError(t, err)
+	}
+	balance := func(address string) string {
+		return getApp(chainB).BankKeeper.GetBalance(chainB.GetContext(), sdk.MustAccAddressFromBech32(address), denom).String()
+	}
+
+	// the default host allowlist takes any message, auction messages included
+	allowed := getApp(chainB).ICAHostKeeper.GetParams(chainB.GetContext()).AllowMessages
+	require.True(t, icahosttypes.ContainsMsgType(allowed, &types.MsgPlaceBid{}))
+	require.True(t, icahosttypes.ContainsMsgType(allowed, &types.MsgCreateAuction{}))
+
+	require.True(t, creator.execute(t, types.NewMsgCreateAuction(creator.address, "painting", sdk.NewInt64Coin(denom, 100), 100)))
+	auctions := getApp(chainB).AuctionKeeper.GetAllAuction(chainB.GetContext())
+	require.Len(t, auctions, 1)
+	auctionID := auctions[0].Id
+	require.Equal(t, creator.address, auctions[0].Creator)
+
+	require.True(t, bidder.execute(t, types.NewMsgPlaceBid(bidder.address, auctionID, sdk.NewInt64Coin(denom, 150))))
+	require.Equal(t, "850"+denom, balance(bidder.address))
+
+	// the refund of the outbid interchain account stays on the account
+	_, err := chainB.SendMsgs(types.NewMsgPlaceBid(local.String(), auctionID, sdk.NewInt64Coin(denom, 200)))
+	require.NoError(t, err)
+	require.Equal(t, "1000"+denom, balance(bidder.address))
+
+	// and its owner can bid it again
+	require.True(t, bidder.execute(t, types.NewMsgPlaceBid(bidder.address, auctionID, sdk.NewInt64Coin(denom, 250))))
+	require.Equal(t, "750"+denom, balance(bidder.address))
+
+	ctx := chainB.GetContext()
+	require.NoError(t, getApp(chainB).AuctionKeeper.SettleAuction(ctx, auctionID))
+	coord.CommitBlock(chainB)
+	require.Equal(t, "1250"+denom, balance(creator.address))
+}
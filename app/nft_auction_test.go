@@ -0,0 +1,162 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/nft"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	auctionkeeper "auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// nftAuctionFixture runs two chains of the app connected by an ICS-721
+// channel of their auction ports. Accounts of chain A sell NFTs and have them
+// delivered to chain B.
+type nftAuctionFixture struct {
+	coord  *ibctesting.Coordinator
+	path   *ibctesting.Path
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func setupNFTAuction(t *testing.T) nftAuctionFixture {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.NFTTransferVersion
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+
+	return nftAuctionFixture{coord: coord, path: path, chainA: chainA, chainB: chainB}
+}
+
+func (f nftAuctionFixture) owner(classID, nftID string) sdk.AccAddress {
+	return getApp(f.chainA).NFTKeeper.GetOwner(f.chainA.GetContext(), classID, nftID)
+}
+
+// sellNFT mints an NFT to the first sender of chain A, sells it to the second
+// one bidding for delivery to the receiver on chain B and settles the
+// auction. It returns the auction and the packet delivering the NFT.
+func (f nftAuctionFixture) sellNFT(t *testing.T, receiver string) (string, channeltypes.Packet) {
+	seller := useSender(f.chainA, 0)
+	nftKeeper := getApp(f.chainA).NFTKeeper
+	ctx := f.chainA.GetContext()
+	require.NoError(t, nftKeeper.SaveClass(ctx, nft.Class{Id: "art", Uri: "ipfs://art"}))
+	require.NoError(t, nftKeeper.Mint(ctx, nft.NFT{ClassId: "art", Id: "mona-lisa", Uri: "ipfs://art/mona-lisa"}, seller))
+
+	msg := types.NewMsgCreateAuction(seller.String(), "painting", sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), 3600)
+	msg.NftLot = &types.NFTLot{ClassId: "art", Id: "mona-lisa"}
+	_, err := f.chainA.SendMsgs(msg)
+	require.NoError(t, err)
+	auctions := getApp(f.chainA).AuctionKeeper.GetAllAuction(f.chainA.GetContext())
+	require.Len(t, auctions, 1)
+	auctionID := auctions[0].Id
+
+	bidder := useSender(f.chainA, 1)
+	bid := types.NewMsgPlaceBid(bidder.String(), auctionID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+	bid.NftDestination = &types.NFTDestination{ChannelId: f.path.EndpointA.ChannelID, Receiver: receiver}
+	_, err = f.chainA.SendMsgs(bid)
+	require.NoError(t, err)
+
+	ctx = f.chainA.GetContext()
+	require.NoError(t, getApp(f.chainA).AuctionKeeper.SettleAuction(ctx, auctionID))
+	var packets []channeltypes.Packet
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := ibctesting.ParsePacketFromEvents([]abci.Event{event})
+		require.NoError(t, err)
+		packets = append(packets, packet)
+	}
+	require.Len(t, packets, 1)
+	f.coord.CommitBlock(f.chainA)
+	require.NoError(t, f.path.EndpointB.UpdateClient())
+
+	return auctionID, packets[0]
+}
+
+func (f nftAuctionFixture) nftLot(auctionID string) *types.NFTLot {
+	auction, _ := getApp(f.chainA).AuctionKeeper.GetAuction(f.chainA.GetContext(), auctionID)
+	return auction.NftLot
+}
+
+// relay relays the packet and its acknowledgement, it returns the events of
+// the reception.
+func (f nftAuctionFixture) relay(t *testing.T, packet channeltypes.Packet) []abci.Event {
+	res, _, err := f.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+	return res.Events
+}
+
+func TestNFTLotRejectedByCounterparty(t *testing.T) {
+	f := setupNFTAuction(t)
+	escrow := auctionkeeper.NFTEscrowAddress(types.PortID, f.path.EndpointA.ChannelID)
+	winner := f.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	receiver := f.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()
+
+	auctionID, packet := f.sellNFT(t, receiver)
+	require.Equal(t, escrow, f.owner("art", "mona-lisa"))
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT, f.nftLot(auctionID).Status)
+	var data types.NonFungibleTokenPacketData
+	require.NoError(t, json.Unmarshal(packet.GetData(), &data))
+	require.Equal(t, "ipfs://art/mona-lisa", data.TokenUris[0])
+
+	// chain B does not take NFTs that did not leave it, the error
+	// acknowledgement makes the lot claimable on chain A
+	events := f.relay(t, packet)
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	require.NoError(t, err)
+	require.Contains(t, string(ack), "error")
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE, f.nftLot(auctionID).Status)
+
+	useSender(f.chainA, 0)
+	_, err = f.chainA.SendMsgs(types.NewMsgClaimNFTLot(f.chainA.SenderAccount.GetAddress().String(), auctionID))
+	require.Error(t, err)
+	useSender(f.chainA, 1)
+	_, err = f.chainA.SendMsgs(types.NewMsgClaimNFTLot(winner.String(), auctionID))
+	require.NoError(t, err)
+	require.Equal(t, winner, f.owner("art", "mona-lisa"))
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED, f.nftLot(auctionID).Status)
+}
+
+func TestNFTLotDelivered(t *testing.T) {
+	f := setupNFTAuction(t)
+	escrow := auctionkeeper.NFTEscrowAddress(types.PortID, f.path.EndpointA.ChannelID)
+	receiver := f.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()
+
+	// the counterparty takes the NFT, it stays escrowed on chain A
+	auctionID, packet := f.sellNFT(t, receiver)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, f.path.EndpointB.WriteAcknowledgement(ack, packet))
+	require.NoError(t, f.path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+	require.Equal(t, escrow, f.owner("art", "mona-lisa"))
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED, f.nftLot(auctionID).Status)
+
+	// sending it back over the channel releases it on chain A
+	owner := f.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+	data := types.NonFungibleTokenPacketData{
+		ClassId:  types.NFTClassTrace(types.PortID, f.path.EndpointB.ChannelID, "art"),
+		TokenIds: []string{"mona-lisa"},
+		Sender:   receiver,
+		Receiver: owner.String(),
+	}
+	timeout := uint64(f.coord.CurrentTime.Add(time.Hour).UnixNano())
+	sequence, err := f.path.EndpointB.SendPacket(clienttypes.ZeroHeight(), timeout, data.GetBytes())
+	require.NoError(t, err)
+	back := channeltypes.NewPacket(data.GetBytes(), sequence, types.PortID, f.path.EndpointB.ChannelID, types.PortID, f.path.EndpointA.ChannelID, clienttypes.ZeroHeight(), timeout)
+	require.NoError(t, f.path.EndpointA.RecvPacket(back))
+	require.Equal(t, owner, f.owner("art", "mona-lisa"))
+}
@@ -3,14 +3,88 @@ package cmd
 import (
 	"auction/x/auction/types"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagDuration = "duration"
+	FlagDeposit  = "deposit"
+
+	FlagDeferredPayment = "deferred-payment"
+	FlagAuctionType     = "type"
+	FlagQuantity        = "quantity"
+	FlagLot             = "lot"
+	FlagPricingRule     = "pricing"
+	FlagBundleLot       = "bundle-lot"
+	FlagLotID           = "lot-id"
+	FlagStartHeight     = "start-height"
+	FlagStartTime       = "start-time"
+	FlagRelist          = "relist"
+	FlagRelistDecrement = "relist-decrement"
+	FlagInterval        = "interval"
+	FlagCount           = "count"
+	FlagEndHeight       = "end-height"
+	FlagBalance         = "balance"
+	FlagMaxBid          = "max-bid"
+	FlagAuctionIDs      = "auction-ids"
+	FlagCreators        = "creators"
+	FlagExpiration      = "expiration"
+	FlagPacketTimeout   = "packet-timeout"
+
+	FlagFeeAllowance          = "fee-allowance"
+	FlagFeeAllowancePerBidder = "fee-allowance-per-bidder"
+
+	FlagNFTClassID  = "nft-class-id"
+	FlagNFTID       = "nft-id"
+	FlagNFTChannel  = "nft-channel"
+	FlagNFTReceiver = "nft-receiver"
+)
+
+// parseAuctionType parses an auction type given in its short form, e.g.
+// "multi-unit".
+func parseAuctionType(s string) (types.AuctionType, error) {
+	name := "AUCTION_TYPE_" + strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	auctionType, ok := types.AuctionType_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown auction type %s", s)
+	}
+	return types.AuctionType(auctionType), nil
+}
+
+// parsePricingRule parses a pricing rule given in its short form, e.g.
+// "pay-as-bid".
+func parsePricingRule(s string) (types.PricingRule, error) {
+	name := "PRICING_RULE_" + strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	pricingRule, ok := types.PricingRule_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown pricing rule %s", s)
+	}
+	return types.PricingRule(pricingRule), nil
+}
+
+// parseBundleLot parses a lot of a bundle auction given as an item, optionally
+// followed by "=" and its coin, e.g. "bullion=3gold".
+func parseBundleLot(s string) (*types.AuctionLot, error) {
+	item, coinStr, found := strings.Cut(s, "=")
+	lot := &types.AuctionLot{Item: item}
+	if found {
+		coin, err := sdk.ParseCoinNormalized(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		lot.Coin = &coin
+	}
+	return lot, nil
+}
+
 func CmdCreateAuction() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-auction [item] [starting-bid]",
@@ -40,7 +114,140 @@ func CmdCreateAuction() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateAuction(fromAddress, item, startingBid)
+			duration, err := cmd.Flags().GetUint64(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(fromAddress, item, startingBid, duration)
+
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			if depositStr != "" {
+				deposit, err := sdk.ParseCoinNormalized(depositStr)
+				if err != nil {
+					return err
+				}
+				msg.Deposit = &deposit
+			}
+
+			msg.DeferredPayment, err = cmd.Flags().GetBool(FlagDeferredPayment)
+			if err != nil {
+				return err
+			}
+
+			auctionTypeStr, err := cmd.Flags().GetString(FlagAuctionType)
+			if err != nil {
+				return err
+			}
+			msg.AuctionType, err = parseAuctionType(auctionTypeStr)
+			if err != nil {
+				return err
+			}
+
+			msg.Quantity, err = cmd.Flags().GetUint64(FlagQuantity)
+			if err != nil {
+				return err
+			}
+
+			lotStr, err := cmd.Flags().GetString(FlagLot)
+			if err != nil {
+				return err
+			}
+			if lotStr != "" {
+				lot, err := sdk.ParseCoinNormalized(lotStr)
+				if err != nil {
+					return err
+				}
+				msg.Lot = &lot
+			}
+
+			pricingRuleStr, err := cmd.Flags().GetString(FlagPricingRule)
+			if err != nil {
+				return err
+			}
+			msg.PricingRule, err = parsePricingRule(pricingRuleStr)
+			if err != nil {
+				return err
+			}
+
+			bundleLots, err := cmd.Flags().GetStringArray(FlagBundleLot)
+			if err != nil {
+				return err
+			}
+			for _, bundleLot := range bundleLots {
+				lot, err := parseBundleLot(bundleLot)
+				if err != nil {
+					return err
+				}
+				msg.Lots = append(msg.Lots, lot)
+			}
+
+			msg.StartHeight, err = cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				startTime, err := time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+				msg.StartTime = &startTime
+			}
+
+			maxRelists, err := cmd.Flags().GetUint32(FlagRelist)
+			if err != nil {
+				return err
+			}
+			if maxRelists > 0 {
+				msg.RelistPolicy = &types.RelistPolicy{MaxRelists: maxRelists}
+				decrementStr, err := cmd.Flags().GetString(FlagRelistDecrement)
+				if err != nil {
+					return err
+				}
+				if decrementStr != "" {
+					decrement, err := sdk.ParseCoinNormalized(decrementStr)
+					if err != nil {
+						return err
+					}
+					msg.RelistPolicy.Decrement = &decrement
+				}
+			}
+
+			feeAllowanceStr, err := cmd.Flags().GetString(FlagFeeAllowance)
+			if err != nil {
+				return err
+			}
+			if msg.FeeAllowance, err = sdk.ParseCoinsNormalized(feeAllowanceStr); err != nil {
+				return err
+			}
+			perBidderStr, err := cmd.Flags().GetString(FlagFeeAllowancePerBidder)
+			if err != nil {
+				return err
+			}
+			if msg.FeeAllowancePerBidder, err = sdk.ParseCoinsNormalized(perBidderStr); err != nil {
+				return err
+			}
+
+			nftClassID, err := cmd.Flags().GetString(FlagNFTClassID)
+			if err != nil {
+				return err
+			}
+			nftID, err := cmd.Flags().GetString(FlagNFTID)
+			if err != nil {
+				return err
+			}
+			if nftClassID != "" || nftID != "" {
+				msg.NftLot = &types.NFTLot{ClassId: nftClassID, Id: nftID}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -49,6 +256,22 @@ func CmdCreateAuction() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagDuration, 0, "Number of blocks the auction stays open (defaults to the module param)")
+	cmd.Flags().String(FlagDeposit, "", "Participation deposit bidders must lock before bidding")
+	cmd.Flags().Bool(FlagDeferredPayment, false, "Let the winner pay after the auction closes instead of escrowing bids (requires --deposit)")
+	cmd.Flags().String(FlagAuctionType, "english", "Auction type: english, multi-unit, reverse or bundle")
+	cmd.Flags().Uint64(FlagQuantity, 0, "Number of identical units offered by a multi-unit auction")
+	cmd.Flags().String(FlagLot, "", "Coin lot of a multi-unit auction, escrowed and split evenly between the units")
+	cmd.Flags().String(FlagPricingRule, "uniform", "What the winners of a multi-unit auction pay: uniform (the clearing price) or pay-as-bid")
+	cmd.Flags().StringArray(FlagBundleLot, nil, "Lot of a bundle auction as item or item=coin, repeat for every lot")
+	cmd.Flags().Int64(FlagStartHeight, 0, "Height at which bidding opens, immediately when unset")
+	cmd.Flags().String(FlagStartTime, "", "Block time at which bidding opens, in RFC3339 format")
+	cmd.Flags().Uint32(FlagRelist, 0, "Number of times the auction is relisted when it closes without bids")
+	cmd.Flags().String(FlagRelistDecrement, "", "Amount subtracted from the starting bid at every relist")
+	cmd.Flags().String(FlagFeeAllowance, "", "Amount escrowed to pay the tx fees of bids on the auction, what is left returns at close")
+	cmd.Flags().String(FlagFeeAllowancePerBidder, "", "Maximum fees paid for a single bidder out of the fee allowance, required with --fee-allowance")
+	cmd.Flags().String(FlagNFTClassID, "", "Class of the NFT sold by an english auction, escrowed until settlement")
+	cmd.Flags().String(FlagNFTID, "", "ID of the NFT sold by an english auction, escrowed until settlement")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -79,6 +302,530 @@ func CmdPlaceBid() *cobra.Command {
 			}
 
 			msg := types.NewMsgPlaceBid(fromAddress, auctionID, bidAmount)
+
+			msg.Quantity, err = cmd.Flags().GetUint64(FlagQuantity)
+			if err != nil {
+				return err
+			}
+
+			msg.LotId, err = cmd.Flags().GetString(FlagLotID)
+			if err != nil {
+				return err
+			}
+
+			nftChannel, err := cmd.Flags().GetString(FlagNFTChannel)
+			if err != nil {
+				return err
+			}
+			nftReceiver, err := cmd.Flags().GetString(FlagNFTReceiver)
+			if err != nil {
+				return err
+			}
+			if nftChannel != "" || nftReceiver != "" {
+				msg.NftDestination = &types.NFTDestination{ChannelId: nftChannel, Receiver: nftReceiver}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagQuantity, 0, "Number of units bid for on a multi-unit auction, the bid amount is then the price per unit")
+	cmd.Flags().String(FlagLotID, "", "Lot of a bundle auction to bid on, the whole bundle when empty")
+	cmd.Flags().String(FlagNFTChannel, "", "ICS-721 channel of the auction port the NFT lot is sent over if the bid wins, on this chain when unset")
+	cmd.Flags().String(FlagNFTReceiver, "", "Receiver of the NFT lot at the other end of --nft-channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [auction-id]",
+		Short: "Cancel an auction without bids",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgCancelAuction(fromAddress, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRegisterBidder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-bidder [auction-id]",
+		Short: "Lock the participation deposit of an auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgRegisterBidder(fromAddress, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCompletePurchase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complete-purchase [auction-id]",
+		Short: "Pay the winning bid of a deferred-payment auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgCompletePurchase(fromAddress, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimNFTLot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-nft-lot [auction-id]",
+		Short: "Claim the NFT lot of a won auction whose delivery over ICS-721 failed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgClaimNFTLot(fromAddress, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCreateAuctionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction-schedule [item] [starting-bid]",
+		Short: "Create auctions of the same item at a fixed interval",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			startingBid, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			template := types.AuctionTemplate{Item: args[0], StartingBid: &startingBid}
+
+			template.Duration, err = cmd.Flags().GetUint64(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			auctionTypeStr, err := cmd.Flags().GetString(FlagAuctionType)
+			if err != nil {
+				return err
+			}
+			template.AuctionType, err = parseAuctionType(auctionTypeStr)
+			if err != nil {
+				return err
+			}
+
+			template.Quantity, err = cmd.Flags().GetUint64(FlagQuantity)
+			if err != nil {
+				return err
+			}
+
+			lotStr, err := cmd.Flags().GetString(FlagLot)
+			if err != nil {
+				return err
+			}
+			if lotStr != "" {
+				lot, err := sdk.ParseCoinNormalized(lotStr)
+				if err != nil {
+					return err
+				}
+				template.Lot = &lot
+			}
+
+			pricingRuleStr, err := cmd.Flags().GetString(FlagPricingRule)
+			if err != nil {
+				return err
+			}
+			template.PricingRule, err = parsePricingRule(pricingRuleStr)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetUint64(FlagInterval)
+			if err != nil {
+				return err
+			}
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			var balance *sdk.Coin
+			balanceStr, err := cmd.Flags().GetString(FlagBalance)
+			if err != nil {
+				return err
+			}
+			if balanceStr != "" {
+				coin, err := sdk.ParseCoinNormalized(balanceStr)
+				if err != nil {
+					return err
+				}
+				balance = &coin
+			}
+
+			msg := types.NewMsgCreateAuctionSchedule(fromAddress, template, interval, count, balance)
+			msg.EndHeight, err = cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagDuration, 0, "Number of blocks every auction stays open (defaults to the module param)")
+	cmd.Flags().String(FlagAuctionType, "english", "Auction type: english, multi-unit or reverse")
+	cmd.Flags().Uint64(FlagQuantity, 0, "Number of identical units offered by every multi-unit auction")
+	cmd.Flags().String(FlagLot, "", "Coin lot of every multi-unit auction, funded from the schedule balance")
+	cmd.Flags().String(FlagPricingRule, "uniform", "What the winners of a multi-unit auction pay: uniform (the clearing price) or pay-as-bid")
+	cmd.Flags().Uint64(FlagInterval, 0, "Number of blocks between two auctions")
+	cmd.Flags().Uint64(FlagCount, 0, "Number of auctions to create, until --end-height when unset")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Height after which no more auctions are created")
+	cmd.Flags().String(FlagBalance, "", "Coins deposited to fund the lots or budgets of the auctions")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelAuctionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction-schedule [schedule-id]",
+		Short: "Stop a schedule and return its remaining balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgCancelAuctionSchedule(fromAddress, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimAuctionRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-auction-rewards",
+		Short: "Claim the incentive rebates accrued by the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgClaimAuctionRewards(fromAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGrantBidFeeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-bid-fee-allowance [auction-id] [bidder]...",
+		Short: "Grant bidders a fee allowance of your auction before their first bid",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			msg := types.NewMsgGrantBidFeeAllowance(fromAddress, args[0], args[1:])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGrantBidAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-bid-authorization [grantee] [spend-limit]",
+		Short: "Allow the grantee to bid on behalf of the sender up to a spend limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress()
+			if fromAddress.Empty() {
+				return fmt.Errorf("address cannot be empty")
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewBidAuthorization(spendLimit)
+			maxBidStr, err := cmd.Flags().GetString(FlagMaxBid)
+			if err != nil {
+				return err
+			}
+			if maxBidStr != "" {
+				authorization.MaxBid, err = sdk.ParseCoinsNormalized(maxBidStr)
+				if err != nil {
+					return err
+				}
+			}
+			authorization.AllowedAuctionIds, err = cmd.Flags().GetStringSlice(FlagAuctionIDs)
+			if err != nil {
+				return err
+			}
+			authorization.AllowedCreators, err = cmd.Flags().GetStringSlice(FlagCreators)
+			if err != nil {
+				return err
+			}
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if expirationStr != "" {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				authorization.Expiration = &expiration
+			}
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(fromAddress, grantee, authorization, authorization.Expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxBid, "", "Maximum amount escrowed by a single bid, no maximum when unset")
+	cmd.Flags().StringSlice(FlagAuctionIDs, nil, "Auctions the grantee can bid on, any auction when unset")
+	cmd.Flags().StringSlice(FlagCreators, nil, "Creators of the auctions the grantee can bid on, any creator when unset")
+	cmd.Flags().String(FlagExpiration, "", "Time after which bids are no longer accepted, in RFC3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// packetTimeout returns the timeout timestamp of a packet sent now with the
+// relative timeout of the flag.
+func packetTimeout(cmd *cobra.Command) (uint64, error) {
+	timeout, err := cmd.Flags().GetDuration(FlagPacketTimeout)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(time.Now().Add(timeout).UnixNano()), nil
+}
+
+func CmdSendCreateRemoteAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-create-remote-auction [src-port] [src-channel] [item] [starting-bid]",
+		Short: "Create an auction on the chain at the other end of an auction channel",
+		Long:  "Create an auction on the chain at the other end of an auction channel. The starting bid is in a denom of this chain, the proceeds are paid here from the escrow of the bids.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			startingBid, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetUint64(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := packetTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendCreateRemoteAuction(fromAddress, args[0], args[1], timeout, args[2], startingBid, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagDuration, 0, "Number of blocks the auction stays open (defaults to the module param of the other chain)")
+	cmd.Flags().Duration(FlagPacketTimeout, types.IBCRefundTimeout, "Time after which the packet times out if it was not relayed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSendPlaceRemoteBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-place-remote-bid [src-port] [src-channel] [auction-id] [bid-amount]",
+		Short: "Place a bid on an auction of the chain at the other end of an auction channel",
+		Long:  "Place a bid on an auction of the chain at the other end of an auction channel. The bid amount is escrowed on this chain until the outcome of the bid comes back.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return fmt.Errorf("GetClientTxContext Error")
+			}
+
+			fromAddress := clientCtx.GetFromAddress().String()
+			if fromAddress == "" {
+				return fmt.Errorf("address cannot be empty")
+			}
+
+			bidAmount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			timeout, err := packetTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendPlaceRemoteBid(fromAddress, args[0], args[1], timeout, args[2], bidAmount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -87,6 +834,7 @@ func CmdPlaceBid() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(FlagPacketTimeout, types.IBCRefundTimeout, "Time after which the packet times out if it was not relayed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -50,6 +50,17 @@ func initRootCmd(
 		keys.Commands(),
 		CmdCreateAuction(),
 		CmdPlaceBid(),
+		CmdCancelAuction(),
+		CmdRegisterBidder(),
+		CmdCompletePurchase(),
+		CmdClaimNFTLot(),
+		CmdCreateAuctionSchedule(),
+		CmdCancelAuctionSchedule(),
+		CmdClaimAuctionRewards(),
+		CmdGrantBidFeeAllowance(),
+		CmdGrantBidAuthorization(),
+		CmdSendCreateRemoteAuction(),
+		CmdSendPlaceRemoteBid(),
 	)
 }
 
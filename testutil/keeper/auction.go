@@ -2,41 +2,119 @@ package keeper
 
 import (
 	"context"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/nft"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	"auction/x/auction/keeper"
 	"auction/x/auction/types"
 )
 
-type MockBankKeeper struct{}
+// MockBankKeeper accepts every transfer and records the ones between accounts
+// so that tests can assert on escrow movements. Balances are only tracked once
+// a test sets them, every transfer then moves them.
+type MockBankKeeper struct {
+	Transfers []Transfer
+	Balances  map[string]sdk.Coins
+	Burned    sdk.Coins
+}
+
+// Transfer is a coin transfer recorded by MockBankKeeper.
+type Transfer struct {
+	From   string
+	To     string
+	Amount sdk.Coins
+}
 
-func (m MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
 	return sdk.Coins{}
 }
 
-func (m MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	m.Transfers = append(m.Transfers, Transfer{From: fromAddr.String(), To: toAddr.String(), Amount: amt})
+	m.move(fromAddr, toAddr, amt)
 	return nil
 }
 
-func (m MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	fromAddr, toAddr := authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)
+	m.Transfers = append(m.Transfers, Transfer{From: fromAddr.String(), To: toAddr.String(), Amount: amt})
+	m.move(fromAddr, toAddr, amt)
 	return nil
 }
 
-func (m MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.Balances[addr.String()]
+}
+
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	m.Burned = m.Burned.Add(amt...)
+	m.move(authtypes.NewModuleAddress(moduleName), nil, amt)
+	return nil
+}
+
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	if m.Balances != nil {
+		moduleAddr := authtypes.NewModuleAddress(moduleName).String()
+		m.Balances[moduleAddr] = m.Balances[moduleAddr].Add(amt...)
+	}
+	return nil
+}
+
+// IterateTotalSupply iterates over the sum of the tracked balances.
+func (m *MockBankKeeper) IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool) {
+	supply := sdk.NewCoins()
+	for _, balance := range m.Balances {
+		supply = supply.Add(balance...)
+	}
+	for _, coin := range supply {
+		if cb(coin) {
+			return
+		}
+	}
+}
+
+// move updates the tracked balances, it panics when a tracked balance would
+// become negative.
+func (m *MockBankKeeper) move(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if m.Balances == nil {
+		return
+	}
+	m.Balances[fromAddr.String()] = m.Balances[fromAddr.String()].Sub(amt...)
+	if toAddr != nil {
+		m.Balances[toAddr.String()] = m.Balances[toAddr.String()].Add(amt...)
+	}
+}
+
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return nil
+}
+
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	return nil
 }
 
@@ -46,7 +124,270 @@ func (m MockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.Accou
 	return authtypes.NewBaseAccount(sdk.AccAddress{}, nil, 0, 0)
 }
 
+// MockAuthzKeeper stores a single authorization per granter and grantee.
+type MockAuthzKeeper struct {
+	Grants map[string]map[string]authz.Authorization
+}
+
+func (m *MockAuthzKeeper) GetAuthorizations(ctx context.Context, grantee, granter sdk.AccAddress) ([]authz.Authorization, error) {
+	if authorization, ok := m.Grants[granter.String()][grantee.String()]; ok {
+		return []authz.Authorization{authorization}, nil
+	}
+	return nil, nil
+}
+
+func (m *MockAuthzKeeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
+	grantees := make([]string, 0, len(m.Grants[req.Granter]))
+	for grantee := range m.Grants[req.Granter] {
+		grantees = append(grantees, grantee)
+	}
+	sort.Strings(grantees)
+
+	res := &authz.QueryGranterGrantsResponse{}
+	for _, grantee := range grantees {
+		// grants are handed out as copies, like the authz keeper does
+		stored := m.Grants[req.Granter][grantee]
+		bz, err := proto.Marshal(stored)
+		if err != nil {
+			return nil, err
+		}
+		authorization := reflect.New(reflect.TypeOf(stored).Elem()).Interface().(authz.Authorization)
+		if err := proto.Unmarshal(bz, authorization); err != nil {
+			return nil, err
+		}
+		any, err := codectypes.NewAnyWithValue(authorization)
+		if err != nil {
+			return nil, err
+		}
+		res.Grants = append(res.Grants, &authz.GrantAuthorization{Granter: req.Granter, Grantee: grantee, Authorization: any})
+	}
+	return res, nil
+}
+
+func (m *MockAuthzKeeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	if m.Grants == nil {
+		m.Grants = make(map[string]map[string]authz.Authorization)
+	}
+	if m.Grants[granter.String()] == nil {
+		m.Grants[granter.String()] = make(map[string]authz.Authorization)
+	}
+	m.Grants[granter.String()][grantee.String()] = authorization
+	return nil
+}
+
+func (m *MockAuthzKeeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	delete(m.Grants[granter.String()], grantee.String())
+	return nil
+}
+
+// MockFeegrantKeeper stores the fee allowances per granter and grantee.
+type MockFeegrantKeeper struct {
+	Allowances map[string]map[string]feegrant.FeeAllowanceI
+}
+
+func (m *MockFeegrantKeeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if _, ok := m.Allowances[granter.String()][grantee.String()]; ok {
+		return sdkerrors.ErrInvalidRequest.Wrap("fee allowance already exists")
+	}
+	if m.Allowances == nil {
+		m.Allowances = make(map[string]map[string]feegrant.FeeAllowanceI)
+	}
+	if m.Allowances[granter.String()] == nil {
+		m.Allowances[granter.String()] = make(map[string]feegrant.FeeAllowanceI)
+	}
+	m.Allowances[granter.String()][grantee.String()] = feeAllowance
+	return nil
+}
+
+func (m *MockFeegrantKeeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	if _, ok := m.Allowances[granter.String()][grantee.String()]; !ok {
+		return sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+	m.Allowances[granter.String()][grantee.String()] = feeAllowance
+	return nil
+}
+
+func (m *MockFeegrantKeeper) GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, ok := m.Allowances[granter.String()][grantee.String()]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+	return allowance, nil
+}
+
+// UseGrantedFees pays the fee of the given messages through the allowance,
+// the way the ante handler does.
+func (m *MockFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	allowance, err := m.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		delete(m.Allowances[granter.String()], grantee.String())
+	}
+	return err
+}
+
+// MockDistributionKeeper tracks the community pool and moves its funds through
+// the mock bank keeper.
+type MockDistributionKeeper struct {
+	Bank          *MockBankKeeper
+	CommunityPool sdk.Coins
+}
+
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	m.CommunityPool = m.CommunityPool.Add(amount...)
+	m.Bank.move(sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+	return nil
+}
+
+func (m *MockDistributionKeeper) DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	pool, isNegative := m.CommunityPool.SafeSub(amount...)
+	if isNegative {
+		return distrtypes.ErrBadDistribution
+	}
+	m.CommunityPool = pool
+	m.Bank.move(authtypes.NewModuleAddress(distrtypes.ModuleName), receiveAddr, amount)
+	return nil
+}
+
+// MockTransferKeeper records the ICS-20 transfers sent and moves their funds
+// to the transfer module through the mock bank keeper. Every transfer fails
+// with Err when it is set.
+type MockTransferKeeper struct {
+	Bank *MockBankKeeper
+	Sent []*transfertypes.MsgTransfer
+	Err  error
+}
+
+func (m *MockTransferKeeper) Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	m.Sent = append(m.Sent, msg)
+	m.Bank.move(sdk.MustAccAddressFromBech32(msg.Sender), authtypes.NewModuleAddress(transfertypes.ModuleName), sdk.NewCoins(msg.Token))
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.Sent))}, nil
+}
+
+// MockNFTKeeper holds NFTs by owner. Classes and NFTs exist once a test mints
+// them.
+type MockNFTKeeper struct {
+	Owners map[string]sdk.AccAddress
+}
+
+// Mint creates an NFT owned by owner.
+func (m *MockNFTKeeper) Mint(classID, nftID string, owner sdk.AccAddress) {
+	if m.Owners == nil {
+		m.Owners = map[string]sdk.AccAddress{}
+	}
+	m.Owners[classID+"/"+nftID] = owner
+}
+
+func (m *MockNFTKeeper) GetClass(ctx context.Context, classID string) (nft.Class, bool) {
+	for key := range m.Owners {
+		if strings.HasPrefix(key, classID+"/") {
+			return nft.Class{Id: classID, Uri: "ipfs://" + classID}, true
+		}
+	}
+	return nft.Class{}, false
+}
+
+func (m *MockNFTKeeper) GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool) {
+	if _, found := m.Owners[classID+"/"+nftID]; !found {
+		return nft.NFT{}, false
+	}
+	return nft.NFT{ClassId: classID, Id: nftID, Uri: "ipfs://" + classID + "/" + nftID}, true
+}
+
+func (m *MockNFTKeeper) GetOwner(ctx context.Context, classID string, nftID string) sdk.AccAddress {
+	return m.Owners[classID+"/"+nftID]
+}
+
+func (m *MockNFTKeeper) Transfer(ctx context.Context, classID string, nftID string, receiver sdk.AccAddress) error {
+	if _, found := m.Owners[classID+"/"+nftID]; !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "nft %s/%s does not exist", classID, nftID)
+	}
+	m.Owners[classID+"/"+nftID] = receiver
+	return nil
+}
+
+// MockCircuitKeeper disables the messages of the Disabled list.
+type MockCircuitKeeper struct {
+	Disabled []string
+}
+
+func (m *MockCircuitKeeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
+	for _, disabled := range m.Disabled {
+		if disabled == msgURL {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func AuctionKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx, _ := AuctionKeeperWithBank(t)
+	return k, ctx
+}
+
+// AuctionKeeperWithBank returns an auction keeper along with the mock bank
+// keeper recording its transfers.
+func AuctionKeeperWithBank(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper) {
+	return newAuctionKeeper(t, nil, nil, nil, nil, nil)
+}
+
+// AuctionKeeperWithAuthz returns an auction keeper along with the mock bank
+// keeper and the mock authz keeper it uses.
+func AuctionKeeperWithAuthz(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper, *MockAuthzKeeper) {
+	authzKeeper := &MockAuthzKeeper{}
+	k, ctx, bankKeeper := newAuctionKeeper(t, authzKeeper, nil, nil, nil, nil)
+	return k, ctx, bankKeeper, authzKeeper
+}
+
+// AuctionKeeperWithFeegrant returns an auction keeper along with the mock
+// bank keeper and the mock feegrant keeper it uses.
+func AuctionKeeperWithFeegrant(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper, *MockFeegrantKeeper) {
+	feegrantKeeper := &MockFeegrantKeeper{}
+	k, ctx, bankKeeper := newAuctionKeeper(t, nil, feegrantKeeper, nil, nil, nil)
+	return k, ctx, bankKeeper, feegrantKeeper
+}
+
+// AuctionKeeperWithDistribution returns an auction keeper along with the mock
+// bank keeper and the mock distribution keeper it uses.
+func AuctionKeeperWithDistribution(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper, *MockDistributionKeeper) {
+	distrKeeper := &MockDistributionKeeper{}
+	k, ctx, bankKeeper := newAuctionKeeper(t, nil, nil, distrKeeper, nil, nil)
+	distrKeeper.Bank = bankKeeper
+	return k, ctx, bankKeeper, distrKeeper
+}
+
+// AuctionKeeperWithTransfer returns an auction keeper along with the mock bank
+// keeper and the mock transfer keeper it uses.
+func AuctionKeeperWithTransfer(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper, *MockTransferKeeper) {
+	k, ctx, bankKeeper := newAuctionKeeper(t, nil, nil, nil, nil, nil)
+	transferKeeper := &MockTransferKeeper{Bank: bankKeeper}
+	k.SetTransferKeeper(transferKeeper)
+	return k, ctx, bankKeeper, transferKeeper
+}
+
+// AuctionKeeperWithCircuit returns an auction keeper along with the mock
+// circuit keeper it uses.
+func AuctionKeeperWithCircuit(t testing.TB) (keeper.Keeper, sdk.Context, *MockCircuitKeeper) {
+	circuitKeeper := &MockCircuitKeeper{}
+	k, ctx, _ := newAuctionKeeper(t, nil, nil, nil, circuitKeeper, nil)
+	return k, ctx, circuitKeeper
+}
+
+// AuctionKeeperWithNFT returns an auction keeper along with the mock bank
+// keeper and the mock NFT keeper it uses.
+func AuctionKeeperWithNFT(t testing.TB) (keeper.Keeper, sdk.Context, *MockBankKeeper, *MockNFTKeeper) {
+	nftKeeper := &MockNFTKeeper{}
+	k, ctx, bankKeeper := newAuctionKeeper(t, nil, nil, nil, nil, nftKeeper)
+	return k, ctx, bankKeeper, nftKeeper
+}
+
+func newAuctionKeeper(t testing.TB, authzKeeper types.AuthzKeeper, feegrantKeeper types.FeegrantKeeper, distrKeeper types.DistributionKeeper, circuitKeeper types.CircuitKeeper, nftKeeper types.NFTKeeper) (keeper.Keeper, sdk.Context, *MockBankKeeper) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -58,7 +399,7 @@ func AuctionKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
-	bankKeeper := MockBankKeeper{}
+	bankKeeper := &MockBankKeeper{}
 	accountKeeper := MockAccountKeeper{}
 	storageAddress := sdk.MustAccAddressFromBech32("cosmos1nt2864p8390qm6tctx33e3zt8gh6aehpqv089g")
 
@@ -67,8 +408,15 @@ func AuctionKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		nil,
+		nil,
 		bankKeeper,
 		accountKeeper,
+		circuitKeeper,
+		authzKeeper,
+		distrKeeper,
+		feegrantKeeper,
+		nftKeeper,
 		storageAddress,
 	)
 
@@ -79,5 +427,5 @@ func AuctionKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, ctx, bankKeeper
 }
@@ -12,16 +12,90 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// // BeginBlocker will persist the current header and validator set as a historical entry
-// // and prune the oldest entry based on the HistoricalEntries parameter
-// func (k *Keeper) BeginBlocker(ctx sdk.Context) {
-// 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-// 	// Add your implementation here
-// }
+// BeginBlocker opens the upcoming auctions whose start height or start time
+// was reached and creates the due auctions of recurring schedules. Neither
+// happens while bidding is halted module wide, they are delayed to the first
+// block after bidding resumes instead.
+func (k *Keeper) BeginBlocker(ctx sdk.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	if k.IsBiddingHalted(ctx) {
+		return
+	}
+
+	for _, auctionID := range k.GetStartingAuctionIDs(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.OpenAuction(cacheCtx, auctionID); err != nil {
+			k.Logger().Error(fmt.Sprintf("Failed to open auction %s: %v", auctionID, err))
+			continue
+		}
+		write()
+	}
+
+	for _, scheduleID := range k.GetDueAuctionScheduleIDs(ctx, ctx.BlockHeight()) {
+		schedule, found := k.GetAuctionSchedule(ctx, scheduleID)
+		if !found {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.RunAuctionSchedule(cacheCtx, schedule); err != nil {
+			k.Logger().Error(fmt.Sprintf("Failed to run auction schedule %s: %v", scheduleID, err))
+			k.FailAuctionSchedule(ctx, schedule)
+			continue
+		}
+		write()
+	}
+}
 
 func (k *Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// Payment deadlines keep running while bidding is halted, as purchases
+	// can still be completed
+	for _, auctionID := range k.GetExpiredPaymentAuctionIDs(ctx, ctx.BlockHeight()) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ExpirePayment(cacheCtx, auctionID); err != nil {
+			k.Logger().Error(fmt.Sprintf("Failed to expire payment of auction %s: %v", auctionID, err))
+			continue
+		}
+		write()
+	}
+
+	// Auctions do not end while bidding is halted module wide, their end
+	// heights are shifted by the halted duration once bidding resumes.
+	haltedSince := k.GetHaltedSince(ctx)
+	if k.IsBiddingHalted(ctx) {
+		if haltedSince == 0 {
+			k.SetHaltedSince(ctx, ctx.BlockHeight())
+		}
+		return
+	}
+	if haltedSince != 0 {
+		k.ShiftOpenAuctions(ctx, ctx.BlockHeight()-haltedSince)
+		k.SetHaltedSince(ctx, 0)
+	}
+
+	// Settle every auction that reached its end height
+	for _, auctionID := range k.GetEndedAuctionIDs(ctx, ctx.BlockHeight()) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SettleAuction(cacheCtx, auctionID); err != nil {
+			k.Logger().Error(fmt.Sprintf("Failed to settle auction %s: %v", auctionID, err))
+			continue
+		}
+		write()
+	}
+
+	// Buyback rounds run after settlement so that the proceeds of buyback
+	// auctions ending in this block are disposed of right away
+	if !k.IsModulePaused(ctx) && k.IsBuybackDue(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.RunBuyback(cacheCtx); err != nil {
+			k.Logger().Error(fmt.Sprintf("Failed to run buyback: %v", err))
+		} else {
+			write()
+		}
+	}
+
 	if ctx.BlockHeight()%100 == 0 {
 		k.Logger().Info("Checking maximum bids for auctions")
 
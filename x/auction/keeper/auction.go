@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// SetAuction sets an auction in the store.
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+
+	store.Set([]byte(auction.Id), k.cdc.MustMarshal(&auction))
+}
+
+// GetAuction returns an auction from its ID.
+func (k Keeper) GetAuction(ctx sdk.Context, auctionID string) (auction types.Auction, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+
+	bz := store.Get([]byte(auctionID))
+	if bz == nil {
+		return auction, false
+	}
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+// GetAllAuction returns all auctions.
+func (k Keeper) GetAllAuction(ctx sdk.Context) (list []types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Key()) == "count" {
+			continue
+		}
+		var auction types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		list = append(list, auction)
+	}
+
+	return
+}
+
+// InsertAuctionEndQueue indexes an open auction by its end height.
+func (k Keeper) InsertAuctionEndQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionEndQueueKey))
+
+	store.Set(types.AuctionEndQueueEntryKey(auction.EndHeight, auction.Id), []byte{})
+}
+
+// RemoveAuctionEndQueue removes an auction from the end height index.
+func (k Keeper) RemoveAuctionEndQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionEndQueueKey))
+
+	store.Delete(types.AuctionEndQueueEntryKey(auction.EndHeight, auction.Id))
+}
+
+// GetEndedAuctionIDs returns the IDs of the queued auctions whose end height
+// is lower than or equal to the given height.
+func (k Keeper) GetEndedAuctionIDs(ctx sdk.Context, height int64) (auctionIDs []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionEndQueueKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(types.AuctionEndQueueKeyPrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the 8 byte end height followed by the auction ID
+		auctionIDs = append(auctionIDs, string(iterator.Key()[8:]))
+	}
+
+	return
+}
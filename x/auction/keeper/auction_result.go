@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"auction/x/auction/types"
+)
+
+// TransmitAuctionResultPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitAuctionResultPacket(
+	ctx sdk.Context,
+	packetData types.AuctionResultPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// sendAuctionResult burns the vouchers of a denom the account of a remote
+// participant holds and sends the outcome of the auction back to the
+// participant, releasing what the vouchers stand for on its chain. A refund
+// without vouchers left is not worth a packet, the other outcomes are always
+// sent.
+//
+// Like the returns over ICS-20, a failed send must not fail the refund or the
+// settlement that triggered it, the vouchers then stay on the account.
+func (k Keeper) sendAuctionResult(ctx sdk.Context, bidder types.IBCBidder, auctionID, voucherDenom string, outcome types.RemoteAuctionOutcome) {
+	denom, ok := types.ParseVoucherDenom(bidder.PortId, bidder.ChannelId, voucherDenom)
+	if !ok {
+		return
+	}
+	address, err := sdk.AccAddressFromBech32(bidder.Address)
+	if err != nil {
+		return
+	}
+	amount := k.bankKeeper.GetAllBalances(ctx, address).AmountOf(voucherDenom)
+	if amount.IsZero() && outcome == types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_REFUNDED {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if amount.IsPositive() {
+		vouchers := sdk.NewCoins(sdk.NewCoin(voucherDenom, amount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, address, types.ModuleName, vouchers); err != nil {
+			k.Logger().Error("failed to burn vouchers of remote participant", "participant", bidder.Address, "amount", vouchers, "error", err)
+			return
+		}
+		if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, vouchers); err != nil {
+			k.Logger().Error("failed to burn vouchers of remote participant", "participant", bidder.Address, "amount", vouchers, "error", err)
+			return
+		}
+	}
+	data := types.AuctionResultPacketData{
+		AuctionId: auctionID,
+		Receiver:  bidder.Sender,
+		Amount:    sdk.Coin{Denom: denom, Amount: amount},
+		Outcome:   outcome,
+	}
+	timeout := uint64(ctx.BlockTime().Add(types.IBCRefundTimeout).UnixNano())
+	if _, err := k.TransmitAuctionResultPacket(cacheCtx, data, bidder.PortId, bidder.ChannelId, clienttypes.ZeroHeight(), timeout); err != nil {
+		k.Logger().Error("failed to send auction result", "participant", bidder.Address, "auction_id", auctionID, "error", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"return_to_ibc_bidder",
+			sdk.NewAttribute("bidder", bidder.Address),
+			sdk.NewAttribute("channel_id", bidder.ChannelId),
+			sdk.NewAttribute("receiver", bidder.Sender),
+			sdk.NewAttribute("amount", data.Amount.String()),
+			sdk.NewAttribute("outcome", outcome.String()),
+		),
+	)
+}
+
+// OnRecvAuctionResultPacket releases the amount of an auction result from the
+// escrow of the channel to its receiver.
+func (k Keeper) OnRecvAuctionResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AuctionResultPacketData) (packetAck types.AuctionResultPacketAck, err error) {
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	receiver := sdk.MustAccAddressFromBech32(data.Receiver)
+	if data.Amount.IsPositive() {
+		escrow := RemoteEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+		if err := k.bankKeeper.SendCoins(ctx, escrow, receiver, sdk.NewCoins(data.Amount)); err != nil {
+			return packetAck, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_auction_result",
+			sdk.NewAttribute("auction_id", data.AuctionId),
+			sdk.NewAttribute("receiver", data.Receiver),
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("amount", data.Amount.String()),
+			sdk.NewAttribute("outcome", data.Outcome.String()),
+		),
+	)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementAuctionResultPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. The vouchers of a rejected
+// result are minted back to the account of the participant.
+func (k Keeper) OnAcknowledgementAuctionResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AuctionResultPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundAuctionResult(ctx, packet, data)
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.AuctionResultPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutAuctionResultPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutAuctionResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AuctionResultPacketData) error {
+	return k.refundAuctionResult(ctx, packet, data)
+}
+
+// refundAuctionResult mints the vouchers burned for an auction result that
+// did not arrive back to the account of the participant, they go out with its
+// next result.
+func (k Keeper) refundAuctionResult(ctx sdk.Context, packet channeltypes.Packet, data types.AuctionResultPacketData) error {
+	if !data.Amount.IsPositive() {
+		return nil
+	}
+	address := IBCBidderAddress(packet.SourceChannel, data.Receiver)
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.VoucherDenom(packet.SourcePort, packet.SourceChannel, data.Amount.Denom), data.Amount.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, vouchers)
+}
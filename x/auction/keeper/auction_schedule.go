@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// SetAuctionSchedule sets an auction schedule in the store.
+func (k Keeper) SetAuctionSchedule(ctx sdk.Context, schedule types.AuctionSchedule) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleKey))
+
+	store.Set([]byte(schedule.Id), k.cdc.MustMarshal(&schedule))
+}
+
+// GetAuctionSchedule returns an auction schedule from its ID.
+func (k Keeper) GetAuctionSchedule(ctx sdk.Context, scheduleID string) (schedule types.AuctionSchedule, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleKey))
+
+	bz := store.Get([]byte(scheduleID))
+	if bz == nil {
+		return schedule, false
+	}
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// GetAllAuctionSchedule returns all auction schedules.
+func (k Keeper) GetAllAuctionSchedule(ctx sdk.Context) (list []types.AuctionSchedule) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.AuctionSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		list = append(list, schedule)
+	}
+
+	return
+}
+
+// GetAuctionScheduleCount gets the number of schedules created so far.
+func (k Keeper) GetAuctionScheduleCount(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(types.AuctionScheduleCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAuctionScheduleCount sets the number of schedules created so far.
+func (k Keeper) SetAuctionScheduleCount(ctx sdk.Context, count uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	store.Set(types.AuctionScheduleCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// InsertAuctionScheduleQueue indexes an active schedule by the height of its
+// next auction.
+func (k Keeper) InsertAuctionScheduleQueue(ctx sdk.Context, schedule types.AuctionSchedule) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleQueueKey))
+
+	store.Set(types.AuctionEndQueueEntryKey(schedule.NextHeight, schedule.Id), []byte{})
+}
+
+// RemoveAuctionScheduleQueue removes a schedule from the next auction index.
+func (k Keeper) RemoveAuctionScheduleQueue(ctx sdk.Context, schedule types.AuctionSchedule) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleQueueKey))
+
+	store.Delete(types.AuctionEndQueueEntryKey(schedule.NextHeight, schedule.Id))
+}
+
+// GetDueAuctionScheduleIDs returns the IDs of the active schedules whose next
+// auction is due at or before the given height.
+func (k Keeper) GetDueAuctionScheduleIDs(ctx sdk.Context, height int64) (scheduleIDs []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleQueueKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(types.AuctionEndQueueKeyPrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the 8 byte next height followed by the schedule ID
+		scheduleIDs = append(scheduleIDs, string(iterator.Key()[8:]))
+	}
+
+	return
+}
+
+// CreateAuctionSchedule deposits the balance of a new schedule in the storage
+// account and creates its first auction.
+func (k Keeper) CreateAuctionSchedule(ctx sdk.Context, msg *types.MsgCreateAuctionSchedule) (string, error) {
+	if msg.EndHeight != 0 && msg.EndHeight < ctx.BlockHeight() {
+		return "", errorsmod.Wrapf(types.ErrInvalidScheduleEnd, "end height %d is before the current height %d", msg.EndHeight, ctx.BlockHeight())
+	}
+
+	if msg.Balance != nil && msg.Balance.IsPositive() {
+		creatorAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return "", err
+		}
+		err = k.bankKeeper.SendCoins(ctx, creatorAddress, k.storageAddress, sdk.NewCoins(*msg.Balance))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	scheduleCount := k.GetAuctionScheduleCount(ctx)
+	schedule := types.AuctionSchedule{
+		Id:         fmt.Sprintf("schedule-%d", scheduleCount),
+		Creator:    msg.Creator,
+		Template:   msg.Template,
+		Interval:   msg.Interval,
+		Count:      msg.Count,
+		EndHeight:  msg.EndHeight,
+		Balance:    msg.Balance,
+		NextHeight: ctx.BlockHeight(),
+		Status:     types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}
+	k.SetAuctionScheduleCount(ctx, scheduleCount+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"create_auction_schedule",
+			sdk.NewAttribute("schedule_id", schedule.Id),
+		),
+	)
+
+	if err := k.RunAuctionSchedule(ctx, schedule); err != nil {
+		return "", err
+	}
+
+	return schedule.Id, nil
+}
+
+// RunAuctionSchedule creates the next auction of an active schedule, funded
+// from its balance, and queues the following one. The schedule completes and
+// returns the rest of its balance once it reached its count or end height, or
+// when the balance no longer funds an auction.
+func (k Keeper) RunAuctionSchedule(ctx sdk.Context, schedule types.AuctionSchedule) error {
+	if schedule.Status != types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+		return errorsmod.Wrapf(types.ErrScheduleNotActive, "schedule %s is %s", schedule.Id, schedule.Status)
+	}
+	k.RemoveAuctionScheduleQueue(ctx, schedule)
+
+	escrow := schedule.Template.RoundEscrow()
+	balance := sdk.NewCoins()
+	if schedule.Balance != nil {
+		balance = balance.Add(*schedule.Balance)
+	}
+	if !balance.IsAllGTE(escrow) {
+		return k.closeAuctionSchedule(ctx, schedule, types.ScheduleStatus_SCHEDULE_STATUS_COMPLETED)
+	}
+
+	res, err := k.appendAuction(ctx, schedule.Template.MsgCreateAuction(schedule.Creator), schedule.Id)
+	if err != nil {
+		return err
+	}
+	if err := k.Hooks().AfterAuctionCreated(ctx, res.AuctionId); err != nil {
+		return err
+	}
+	if schedule.Balance != nil {
+		remaining := schedule.Balance.Sub(sdk.NewCoin(schedule.Balance.Denom, escrow.AmountOf(schedule.Balance.Denom)))
+		schedule.Balance = &remaining
+	}
+	schedule.AuctionIds = append(schedule.AuctionIds, res.AuctionId)
+	schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"run_auction_schedule",
+			sdk.NewAttribute("schedule_id", schedule.Id),
+			sdk.NewAttribute("auction_id", res.AuctionId),
+		),
+	)
+
+	if (schedule.Count != 0 && uint64(len(schedule.AuctionIds)) >= schedule.Count) ||
+		(schedule.EndHeight != 0 && schedule.NextHeight > schedule.EndHeight) {
+		return k.closeAuctionSchedule(ctx, schedule, types.ScheduleStatus_SCHEDULE_STATUS_COMPLETED)
+	}
+	k.SetAuctionSchedule(ctx, schedule)
+	k.InsertAuctionScheduleQueue(ctx, schedule)
+
+	return nil
+}
+
+// CancelAuctionSchedule stops an active schedule on behalf of its creator.
+// Auctions it already created carry on.
+func (k Keeper) CancelAuctionSchedule(ctx sdk.Context, scheduleID string, creator string) error {
+	schedule, found := k.GetAuctionSchedule(ctx, scheduleID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidScheduleId, "schedule %s does not exist", scheduleID)
+	}
+	if schedule.Creator != creator {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the creator of schedule %s", creator, scheduleID)
+	}
+	if schedule.Status != types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+		return errorsmod.Wrapf(types.ErrScheduleNotActive, "schedule %s is %s", scheduleID, schedule.Status)
+	}
+
+	k.RemoveAuctionScheduleQueue(ctx, schedule)
+	return k.closeAuctionSchedule(ctx, schedule, types.ScheduleStatus_SCHEDULE_STATUS_CANCELLED)
+}
+
+// FailAuctionSchedule closes an active schedule whose next auction could not
+// be created and returns its balance, instead of retrying it every block. It
+// is called with the context the failed round did not write to.
+func (k Keeper) FailAuctionSchedule(ctx sdk.Context, schedule types.AuctionSchedule) {
+	k.RemoveAuctionScheduleQueue(ctx, schedule)
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.closeAuctionSchedule(cacheCtx, schedule, types.ScheduleStatus_SCHEDULE_STATUS_FAILED); err != nil {
+		// the balance stays recorded on the schedule
+		k.Logger().Error(fmt.Sprintf("Failed to return the balance of auction schedule %s: %v", schedule.Id, err))
+		schedule.Status = types.ScheduleStatus_SCHEDULE_STATUS_FAILED
+		k.SetAuctionSchedule(ctx, schedule)
+		return
+	}
+	write()
+}
+
+// closeAuctionSchedule returns the remaining balance of a schedule to its
+// creator and stores its final status.
+func (k Keeper) closeAuctionSchedule(ctx sdk.Context, schedule types.AuctionSchedule, status types.ScheduleStatus) error {
+	if schedule.Balance != nil && schedule.Balance.IsPositive() {
+		creatorAddress, err := sdk.AccAddressFromBech32(schedule.Creator)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(*schedule.Balance))
+		if err != nil {
+			return err
+		}
+		remaining := sdk.NewCoin(schedule.Balance.Denom, math.ZeroInt())
+		schedule.Balance = &remaining
+	}
+
+	schedule.Status = status
+	k.SetAuctionSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"close_auction_schedule",
+			sdk.NewAttribute("schedule_id", schedule.Id),
+			sdk.NewAttribute("status", status.String()),
+		),
+	)
+
+	return nil
+}
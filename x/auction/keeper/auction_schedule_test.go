@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestAuctionScheduleRunsRounds(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator := sample.AccAddress()
+
+	startingBid := sdk.NewInt64Coin("token", 10)
+	lot := sdk.NewInt64Coin("gold", 4)
+	balance := sdk.NewInt64Coin("gold", 10)
+	template := types.AuctionTemplate{
+		Item:        "gold",
+		StartingBid: &startingBid,
+		Duration:    3,
+		AuctionType: types.AuctionType_AUCTION_TYPE_MULTI_UNIT,
+		Quantity:    2,
+		Lot:         &lot,
+	}
+	msg := types.NewMsgCreateAuctionSchedule(creator, template, 5, 3, &balance)
+	require.NoError(t, msg.ValidateBasic())
+	res, err := ms.CreateAuctionSchedule(ctx, msg)
+	require.NoError(t, err)
+	storage := bank.Transfers[0].To
+	require.Equal(t, []keepertest.Transfer{
+		{From: creator, To: storage, Amount: sdk.NewCoins(balance)},
+	}, bank.Transfers)
+
+	// the first auction is created right away, funded from the balance
+	schedule, found := k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.True(t, found)
+	require.Len(t, schedule.AuctionIds, 1)
+	require.Equal(t, int64(6), schedule.NextHeight)
+	require.Equal(t, sdk.NewInt64Coin("gold", 6), *schedule.Balance)
+	auction, found := k.GetAuction(ctx, schedule.AuctionIds[0])
+	require.True(t, found)
+	require.Equal(t, res.ScheduleId, auction.ScheduleId)
+	require.Equal(t, creator, auction.Creator)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+
+	ctx = ctx.WithBlockHeight(5)
+	k.BeginBlocker(ctx)
+	schedule, _ = k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Len(t, schedule.AuctionIds, 1)
+
+	ctx = ctx.WithBlockHeight(6)
+	k.BeginBlocker(ctx)
+	schedule, _ = k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Len(t, schedule.AuctionIds, 2)
+	require.Equal(t, sdk.NewInt64Coin("gold", 2), *schedule.Balance)
+
+	// the balance does not fund a third round, the rest is returned
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.BeginBlocker(ctx)
+	schedule, _ = k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Equal(t, types.ScheduleStatus_SCHEDULE_STATUS_COMPLETED, schedule.Status)
+	require.Len(t, schedule.AuctionIds, 2)
+	require.True(t, schedule.Balance.IsZero())
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 2))},
+	}, bank.Transfers[transfers:])
+	require.Empty(t, k.GetDueAuctionScheduleIDs(ctx, 100))
+}
+
+func TestAuctionScheduleCompletesAtCount(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+
+	startingBid := sdk.NewInt64Coin("token", 10)
+	template := types.AuctionTemplate{Item: "item", StartingBid: &startingBid, Duration: 3}
+	res, err := ms.CreateAuctionSchedule(ctx, types.NewMsgCreateAuctionSchedule(sample.AccAddress(), template, 2, 2, nil))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(3)
+	k.BeginBlocker(ctx)
+	schedule, _ := k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Equal(t, types.ScheduleStatus_SCHEDULE_STATUS_COMPLETED, schedule.Status)
+	require.Len(t, schedule.AuctionIds, 2)
+
+	ctx = ctx.WithBlockHeight(5)
+	k.BeginBlocker(ctx)
+	require.Equal(t, 2, k.GetAuctionCount(ctx))
+}
+
+func TestCancelAuctionSchedule(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+	creator := sample.AccAddress()
+
+	startingBid := sdk.NewInt64Coin("token", 10)
+	template := types.AuctionTemplate{Item: "item", StartingBid: &startingBid, Duration: 3}
+	msg := types.NewMsgCreateAuctionSchedule(creator, template, 2, 0, nil)
+	msg.EndHeight = 100
+	res, err := ms.CreateAuctionSchedule(ctx, msg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgCancelAuctionSchedule
+		expErr error
+	}{
+		{
+			name:   "unknown schedule",
+			input:  types.NewMsgCancelAuctionSchedule(creator, "schedule-9"),
+			expErr: types.ErrInvalidScheduleId,
+		},
+		{
+			name:   "not the creator",
+			input:  types.NewMsgCancelAuctionSchedule(sample.AccAddress(), res.ScheduleId),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:  "creator",
+			input: types.NewMsgCancelAuctionSchedule(creator, res.ScheduleId),
+		},
+		{
+			name:   "already cancelled",
+			input:  types.NewMsgCancelAuctionSchedule(creator, res.ScheduleId),
+			expErr: types.ErrScheduleNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.CancelAuctionSchedule(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the auction created before the cancellation stays open
+	schedule, _ := k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Equal(t, types.ScheduleStatus_SCHEDULE_STATUS_CANCELLED, schedule.Status)
+	auction, _ := k.GetAuction(ctx, schedule.AuctionIds[0])
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+	require.Empty(t, k.GetDueAuctionScheduleIDs(ctx, 100))
+}
+
+// failingCreationHooks rejects every auction created once it is set.
+type failingCreationHooks struct {
+	recordingHooks
+}
+
+func (h *failingCreationHooks) AfterAuctionCreated(ctx context.Context, auctionID string) error {
+	return errors.New("rejected")
+}
+
+func TestAuctionScheduleFails(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator := sample.AccAddress()
+
+	startingBid := sdk.NewInt64Coin("token", 10)
+	lot := sdk.NewInt64Coin("gold", 4)
+	balance := sdk.NewInt64Coin("gold", 10)
+	template := types.AuctionTemplate{Item: "gold", StartingBid: &startingBid, Duration: 3, Lot: &lot}
+	res, err := ms.CreateAuctionSchedule(ctx, types.NewMsgCreateAuctionSchedule(creator, template, 5, 0, &balance))
+	require.NoError(t, err)
+	storage := bank.Transfers[0].To
+
+	// the next round fails, the schedule is closed and its balance returned
+	// instead of being retried every block
+	k.SetHooks(&failingCreationHooks{})
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(6)
+	k.BeginBlocker(ctx)
+	schedule, _ := k.GetAuctionSchedule(ctx, res.ScheduleId)
+	require.Equal(t, types.ScheduleStatus_SCHEDULE_STATUS_FAILED, schedule.Status)
+	require.Len(t, schedule.AuctionIds, 1)
+	require.True(t, schedule.Balance.IsZero())
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 6))},
+	}, bank.Transfers[transfers:])
+	require.Empty(t, k.GetDueAuctionScheduleIDs(ctx, 100))
+	require.Equal(t, 1, k.GetAuctionCount(ctx))
+}
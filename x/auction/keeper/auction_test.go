@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"auction/testutil/sample"
+	"auction/x/auction/types"
+)
+
+func TestCreateAuctionEndHeight(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+	creator := sample.AccAddress()
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 5))
+	require.NoError(t, err)
+	auction, found := k.GetAuction(sdkCtx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, int64(15), auction.EndHeight)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+
+	// zero duration falls back to the default_duration param
+	res, err = ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 0))
+	require.NoError(t, err)
+	auction, found = k.GetAuction(sdkCtx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, 10+int64(types.DefaultDefaultDuration), auction.EndHeight)
+}
+
+func TestPlaceBidClosedAuction(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 2))
+	require.NoError(t, err)
+
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("other", 20)))
+	require.ErrorIs(t, err, types.ErrInvalidBidAmount)
+
+	// only bids on multi-unit auctions have a quantity
+	bid := types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20))
+	bid.Quantity = 2
+	_, err = ms.PlaceBid(sdkCtx, bid)
+	require.ErrorIs(t, err, types.ErrInvalidBidQuantity)
+
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	sdkCtx = sdkCtx.WithBlockHeight(3)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 30)))
+	require.ErrorIs(t, err, types.ErrAuctionNotOpen)
+
+	k.EndBlocker(sdkCtx)
+	auction, found := k.GetAuction(sdkCtx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Empty(t, k.GetEndedAuctionIDs(sdkCtx, sdkCtx.BlockHeight()))
+}
+
+func TestCancelAuction(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+	creator := sample.AccAddress()
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	withBids, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), withBids.AuctionId, sdk.NewInt64Coin("token", 10)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgCancelAuction
+		expErr error
+	}{
+		{
+			name:   "unknown auction",
+			input:  types.NewMsgCancelAuction(creator, "auction-42"),
+			expErr: types.ErrInvalidAuctionId,
+		},
+		{
+			name:   "not the creator",
+			input:  types.NewMsgCancelAuction(sample.AccAddress(), res.AuctionId),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "auction with bids",
+			input:  types.NewMsgCancelAuction(creator, withBids.AuctionId),
+			expErr: types.ErrAuctionHasBids,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgCancelAuction(creator, res.AuctionId),
+		},
+		{
+			name:   "already cancelled",
+			input:  types.NewMsgCancelAuction(creator, res.AuctionId),
+			expErr: types.ErrAuctionNotOpen,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.CancelAuction(sdkCtx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	auction, found := k.GetAuction(sdkCtx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_CANCELLED, auction.Status)
+	require.Equal(t, []string{withBids.AuctionId}, k.GetEndedAuctionIDs(sdkCtx, 11))
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"auction/x/auction/types"
+)
+
+// bidAuthorizationGrant is a BidAuthorization along with the grant holding it.
+type bidAuthorizationGrant struct {
+	grantee       sdk.AccAddress
+	authorization *types.BidAuthorization
+	expiration    *time.Time
+}
+
+// getBidAuthorizationGrants returns the bid authorizations granted by the
+// bidder.
+func (k Keeper) getBidAuthorizationGrants(ctx sdk.Context, bidder sdk.AccAddress) ([]bidAuthorizationGrant, error) {
+	if k.authzKeeper == nil {
+		return nil, nil
+	}
+
+	res, err := k.authzKeeper.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{
+		Granter:    bidder.String(),
+		Pagination: &query.PageRequest{Limit: query.PaginationMaxLimit},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []bidAuthorizationGrant
+	for _, grant := range res.Grants {
+		authorization, ok := grant.Authorization.GetCachedValue().(*types.BidAuthorization)
+		if !ok {
+			continue
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, bidAuthorizationGrant{grantee: grantee, authorization: authorization, expiration: grant.Expiration})
+	}
+	return grants, nil
+}
+
+// saveBidAuthorizationGrant stores an updated bid authorization, or deletes
+// it once it has nothing left to spend nor in escrow.
+func (k Keeper) saveBidAuthorizationGrant(ctx sdk.Context, bidder sdk.AccAddress, grant bidAuthorizationGrant) error {
+	if grant.authorization.SpendLimit.IsZero() && len(grant.authorization.Escrowed) == 0 {
+		return k.authzKeeper.DeleteGrant(ctx, grant.grantee, bidder, grant.authorization.MsgTypeURL())
+	}
+	return k.authzKeeper.SaveGrant(ctx, grant.grantee, bidder, grant.authorization, grant.expiration)
+}
+
+// ValidateBidAuthorizations rejects bids funded through a bid authorization
+// that does not allow the auction creator. Bid authorizations only record
+// escrow against an auction once a bid on it was accepted, so any of them
+// holding escrow in the auction may have funded the bid.
+func (k Keeper) ValidateBidAuthorizations(ctx sdk.Context, auction types.Auction, bidder string) error {
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	grants, err := k.getBidAuthorizationGrants(ctx, bidderAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		if _, found := grant.authorization.EscrowOf(auction.Id); !found {
+			continue
+		}
+		if !grant.authorization.AllowsCreator(auction.Creator) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot bid for %s on auctions of %s", grant.grantee, bidder, auction.Creator)
+		}
+	}
+
+	return nil
+}
+
+// creditBidAuthorizations credits refunded escrow back to the spend limit of
+// the bid authorizations that funded it.
+func (k Keeper) creditBidAuthorizations(ctx sdk.Context, auctionID string, bidder sdk.AccAddress, refund sdk.Coin) error {
+	grants, err := k.getBidAuthorizationGrants(ctx, bidder)
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		if !refund.IsPositive() {
+			break
+		}
+		credit := grant.authorization.ReleaseEscrow(auctionID, refund)
+		if credit.IsZero() {
+			continue
+		}
+		grant.authorization.SpendLimit = grant.authorization.SpendLimit.Add(credit)
+		refund = refund.Sub(credit)
+		if err := k.saveBidAuthorizationGrant(ctx, bidder, grant); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseBidAuthorizations releases the escrow recorded against a closed
+// auction in the bid authorizations of its bidders. The escrow of the given
+// winners was spent and is dropped. Bids that were never escrowed, on reverse
+// and deferred-payment auctions, are credited back.
+func (k Keeper) releaseBidAuthorizations(ctx sdk.Context, auction types.Auction, winners []*types.Bid) error {
+	// bidders are visited in a fixed order, winners first
+	spent := make(map[string]bool)
+	var bidders []string
+	for _, bid := range winners {
+		if _, ok := spent[bid.Bidder]; !ok {
+			spent[bid.Bidder] = !auction.IsReverse()
+			bidders = append(bidders, bid.Bidder)
+		}
+	}
+	for _, bid := range auction.Bids {
+		if _, ok := spent[bid.Bidder]; !ok {
+			spent[bid.Bidder] = false
+			bidders = append(bidders, bid.Bidder)
+		}
+	}
+
+	for _, bidder := range bidders {
+		bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+		if err != nil {
+			return err
+		}
+		grants, err := k.getBidAuthorizationGrants(ctx, bidderAddress)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants {
+			escrow, found := grant.authorization.EscrowOf(auction.Id)
+			if !found {
+				continue
+			}
+			grant.authorization.ReleaseEscrow(auction.Id, escrow)
+			if !spent[bidder] {
+				grant.authorization.SpendLimit = grant.authorization.SpendLimit.Add(escrow)
+			}
+			if err := k.saveBidAuthorizationGrant(ctx, bidderAddress, grant); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
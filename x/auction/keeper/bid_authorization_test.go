@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// execBid places a bid through the grant of the bidder to the grantee, the
+// way the authz module dispatches it.
+func execBid(ctx sdk.Context, ms types.MsgServer, authzKeeper *keepertest.MockAuthzKeeper, grantee string, msg *types.MsgPlaceBid) error {
+	granter := sdk.MustAccAddressFromBech32(msg.Bidder)
+	granteeAddress := sdk.MustAccAddressFromBech32(grantee)
+	authorization := authzKeeper.Grants[msg.Bidder][grantee]
+	if authorization == nil {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	res, err := authorization.Accept(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+	saved := authzKeeper.Grants[msg.Bidder][grantee]
+	if err := authzKeeper.SaveGrant(cacheCtx, granteeAddress, granter, res.Updated, nil); err != nil {
+		return err
+	}
+	if _, err := ms.PlaceBid(cacheCtx, msg); err != nil {
+		// the mock grants are not part of the store and are rolled back here
+		authzKeeper.Grants[msg.Bidder][grantee] = saved
+		return err
+	}
+	write()
+	return nil
+}
+
+func TestBidAuthorizationCreditsRefunds(t *testing.T) {
+	k, ctx, _, authzKeeper := keepertest.AuctionKeeperWithAuthz(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	treasury := sample.AccAddress()
+	hotKey := sample.AccAddress()
+	rival := sample.AccAddress()
+
+	authorization := types.NewBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	require.NoError(t, authzKeeper.SaveGrant(ctx, sdk.MustAccAddressFromBech32(hotKey), sdk.MustAccAddressFromBech32(treasury), authorization, nil))
+	grant := func() *types.BidAuthorization {
+		return authzKeeper.Grants[treasury][hotKey].(*types.BidAuthorization)
+	}
+
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	require.NoError(t, execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, res.AuctionId, sdk.NewInt64Coin("token", 40))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 60)), grant().SpendLimit)
+
+	// raising its own bid refunds the previous one, only the difference stays
+	// spent
+	require.NoError(t, execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, res.AuctionId, sdk.NewInt64Coin("token", 60))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 40)), grant().SpendLimit)
+	escrow, found := grant().EscrowOf(res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("token", 60), escrow)
+
+	err = execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, res.AuctionId, sdk.NewInt64Coin("token", 110)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// being outbid credits the escrow back
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(rival, res.AuctionId, sdk.NewInt64Coin("token", 70)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 100)), grant().SpendLimit)
+	require.Empty(t, grant().Escrowed)
+
+	// winning spends it for good, and a spent authorization is removed
+	require.NoError(t, execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, res.AuctionId, sdk.NewInt64Coin("token", 100))))
+	require.True(t, grant().SpendLimit.IsZero())
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	_, found = authzKeeper.Grants[treasury][hotKey]
+	require.False(t, found)
+}
+
+func TestBidAuthorizationAllowedCreators(t *testing.T) {
+	k, ctx, _, authzKeeper := keepertest.AuctionKeeperWithAuthz(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	treasury := sample.AccAddress()
+	hotKey := sample.AccAddress()
+
+	authorization := types.NewBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	authorization.AllowedCreators = []string{creator}
+	require.NoError(t, authzKeeper.SaveGrant(ctx, sdk.MustAccAddressFromBech32(hotKey), sdk.MustAccAddressFromBech32(treasury), authorization, nil))
+
+	allowed, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	other, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	err = execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, other.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 100)), authzKeeper.Grants[treasury][hotKey].(*types.BidAuthorization).SpendLimit)
+
+	require.NoError(t, execBid(ctx, ms, authzKeeper, hotKey, types.NewMsgPlaceBid(treasury, allowed.AuctionId, sdk.NewInt64Coin("token", 20))))
+
+	// bids placed by the treasury itself are not restricted
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(treasury, other.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+}
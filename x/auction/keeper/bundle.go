@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// AppendLotBid stores a bid on a single lot after the previous bids on the
+// same lot.
+func (k Keeper) AppendLotBid(ctx sdk.Context, lotBid types.LotBid) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LotBidKey))
+
+	index := uint64(len(k.GetLotBids(ctx, lotBid.AuctionId, lotBid.LotId)))
+	store.Set(types.LotBidEntryKey(lotBid.AuctionId, lotBid.LotId, index), k.cdc.MustMarshal(&lotBid))
+}
+
+// GetLotBids returns the bids on a single lot in the order they were placed.
+func (k Keeper) GetLotBids(ctx sdk.Context, auctionID string, lotID string) (list []types.LotBid) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LotBidKey))
+	iterator := storetypes.KVStorePrefixIterator(store, types.LotBidLotKeyPrefix(auctionID, lotID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lotBid types.LotBid
+		k.cdc.MustUnmarshal(iterator.Value(), &lotBid)
+		list = append(list, lotBid)
+	}
+
+	return
+}
+
+// GetAuctionLotBids returns the bids on all lots of an auction.
+func (k Keeper) GetAuctionLotBids(ctx sdk.Context, auctionID string) (list []types.LotBid) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LotBidKey))
+	iterator := storetypes.KVStorePrefixIterator(store, types.LotBidKeyPrefix(auctionID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lotBid types.LotBid
+		k.cdc.MustUnmarshal(iterator.Value(), &lotBid)
+		list = append(list, lotBid)
+	}
+
+	return
+}
+
+// GetAllLotBid returns all lot bids.
+func (k Keeper) GetAllLotBid(ctx sdk.Context) (list []types.LotBid) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LotBidKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lotBid types.LotBid
+		k.cdc.MustUnmarshal(iterator.Value(), &lotBid)
+		list = append(list, lotBid)
+	}
+
+	return
+}
+
+// HighestLotBids returns the highest bid of every lot of a bundle auction,
+// keyed by lot ID. Only these bids are escrowed, lower bids were refunded when
+// outbid.
+func (k Keeper) HighestLotBids(ctx sdk.Context, auction types.Auction) map[string]*types.Bid {
+	highest := make(map[string]*types.Bid)
+	for _, lot := range auction.Lots {
+		if bids := k.GetLotBids(ctx, auction.Id, lot.Id); len(bids) > 0 {
+			highest[lot.Id] = bids[len(bids)-1].Bid
+		}
+	}
+
+	return highest
+}
+
+// IsValidLotBid checks if the bid amount is valid for a lot of a bundle
+// auction. Lot bids have no starting bid of their own, they must be positive
+// and at least as high as the previous bids on the lot. The lots are only
+// sold separately when their bids add up to the starting bid.
+func (k Keeper) IsValidLotBid(ctx sdk.Context, auction types.Auction, lotID string, bidAmount sdk.Coin) bool {
+	if bidAmount.Denom != auction.StartingBid.Denom || !bidAmount.IsPositive() {
+		return false
+	}
+	for _, lotBid := range k.GetLotBids(ctx, auction.Id, lotID) {
+		if bidAmount.IsLT(*lotBid.Bid.BidAmount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PlaceLotBid escrows a bid on a single lot of a bundle auction and refunds the
+// previous highest bid on the lot.
+func (k Keeper) PlaceLotBid(ctx sdk.Context, auction types.Auction, lotID string, bidder string, bidAmount sdk.Coin) error {
+	if _, found := auction.FindLot(lotID); !found {
+		return errorsmod.Wrapf(types.ErrInvalidLot, "auction %s has no lot %s", auction.Id, lotID)
+	}
+
+	bids := k.GetLotBids(ctx, auction.Id, lotID)
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoins(ctx, bidderAddress, k.storageAddress, sdk.NewCoins(bidAmount))
+	if err != nil {
+		return err
+	}
+	if len(bids) > 0 {
+		previousHighestBid := bids[len(bids)-1].Bid
+		previousHighestBidderAddress, err := sdk.AccAddressFromBech32(previousHighestBid.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, previousHighestBidderAddress, sdk.NewCoins(*previousHighestBid.BidAmount))
+		if err != nil {
+			return err
+		}
+		if err := k.afterBidRefunded(ctx, auction.Id, previousHighestBidderAddress, *previousHighestBid.BidAmount); err != nil {
+			return err
+		}
+	}
+
+	k.AppendLotBid(ctx, types.LotBid{
+		AuctionId: auction.Id,
+		LotId:     lotID,
+		Bid: &types.Bid{
+			Bidder:    bidder,
+			BidAmount: &bidAmount,
+		},
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"place_lot_bid",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("lot_id", lotID),
+			sdk.NewAttribute("bidder", bidder),
+			sdk.NewAttribute("amount", bidAmount.String()),
+		),
+	)
+
+	return k.Hooks().AfterBidPlaced(ctx, auction.Id, bidderAddress, bidAmount)
+}
+
+// LotsRevenue returns what selling every lot to its highest bid raises. Lots
+// without bids count for nothing.
+func LotsRevenue(lotBids map[string]*types.Bid) math.Int {
+	revenue := math.ZeroInt()
+	for _, bid := range lotBids {
+		revenue = revenue.Add(bid.BidAmount.Amount)
+	}
+
+	return revenue
+}
+
+// BundleWins reports whether the highest bundle bid raises at least as much as
+// selling every lot to its highest bid.
+func BundleWins(bundleBid *types.Bid, lotBids map[string]*types.Bid) bool {
+	if bundleBid == nil {
+		return false
+	}
+
+	return bundleBid.BidAmount.Amount.GTE(LotsRevenue(lotBids))
+}
+
+// settleBundle allocates the lots of a bundle auction that reached its end
+// height to the allocation raising the most: all lots to the highest bundle
+// bid, or every lot to its own highest bid. Lots are only sold separately when
+// they raise at least the starting bid, which prices the whole bundle, so
+// that low bids on single lots cannot take them below the price of the
+// seller. Losing bids are refunded and the coins of unsold lots are returned
+// to the creator.
+func (k Keeper) settleBundle(ctx sdk.Context, auction types.Auction) error {
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+
+	var bundleBid *types.Bid
+	if len(auction.Bids) > 0 {
+		bundleBid = auction.Bids[len(auction.Bids)-1]
+	}
+	lotBids := k.HighestLotBids(ctx, auction)
+	bundleWins := BundleWins(bundleBid, lotBids)
+	lotsWin := !bundleWins && LotsRevenue(lotBids).GTE(auction.StartingBid.Amount)
+
+	refunds := make([]*types.Bid, 0, len(lotBids)+1)
+	revenue := sdk.NewCoin(auction.StartingBid.Denom, math.ZeroInt())
+	allocation := "none"
+	switch {
+	case bundleWins:
+		allocation = "bundle"
+		auction.WinningBid = bundleBid
+		revenue = *bundleBid.BidAmount
+	case lotsWin:
+		allocation = "lots"
+	}
+	if !bundleWins && bundleBid != nil {
+		refunds = append(refunds, bundleBid)
+	}
+
+	for _, lot := range auction.Lots {
+		lotBid, hasBid := lotBids[lot.Id]
+		winner := ""
+		switch {
+		case bundleWins:
+			winner = bundleBid.Bidder
+			if hasBid {
+				refunds = append(refunds, lotBid)
+			}
+		case hasBid && lotsWin:
+			winner = lotBid.Bidder
+			lot.WinningBid = lotBid
+			revenue = revenue.Add(*lotBid.BidAmount)
+		case hasBid:
+			refunds = append(refunds, lotBid)
+		}
+
+		if lot.Coin == nil {
+			continue
+		}
+		recipient := creatorAddress
+		if winner != "" {
+			recipient, err = sdk.AccAddressFromBech32(winner)
+			if err != nil {
+				return err
+			}
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, recipient, sdk.NewCoins(*lot.Coin))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, bid := range refunds {
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(*bid.BidAmount))
+		if err != nil {
+			return err
+		}
+		if err := k.afterBidRefunded(ctx, auction.Id, bidderAddress, *bid.BidAmount); err != nil {
+			return err
+		}
+	}
+	if revenue.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(revenue))
+		if err != nil {
+			return err
+		}
+	}
+	if err := k.RefundDeposits(ctx, auction.Id); err != nil {
+		return err
+	}
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("allocation", allocation),
+			sdk.NewAttribute("revenue", revenue.String()),
+		),
+	)
+
+	if err := k.afterSettlement(ctx, auction); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterAuctionSettled(ctx, auction.Id)
+}
+
+// refundLotBids refunds the highest bid of every lot of a bundle auction.
+func (k Keeper) refundLotBids(ctx sdk.Context, auction types.Auction) error {
+	lotBids := k.HighestLotBids(ctx, auction)
+	for _, lot := range auction.Lots {
+		bid, found := lotBids[lot.Id]
+		if !found {
+			continue
+		}
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(*bid.BidAmount))
+		if err != nil {
+			return err
+		}
+		if err := k.afterBidRefunded(ctx, auction.Id, bidderAddress, *bid.BidAmount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// returnLots returns the escrowed coins of all lots of a bundle auction to the
+// creator.
+func (k Keeper) returnLots(ctx sdk.Context, auction types.Auction) error {
+	coins := lotCoins(auction)
+	if coins.IsZero() {
+		return nil
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, coins)
+}
+
+// lotCoins returns the sum of the coins of all lots of a bundle auction.
+func lotCoins(auction types.Auction) sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, lot := range auction.Lots {
+		if lot.Coin != nil {
+			coins = coins.Add(*lot.Coin)
+		}
+	}
+
+	return coins
+}
@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestBundleWins(t *testing.T) {
+	bid := func(amount int64) *types.Bid {
+		coin := sdk.NewInt64Coin("token", amount)
+		return &types.Bid{Bidder: sample.AccAddress(), BidAmount: &coin}
+	}
+
+	testCases := []struct {
+		name      string
+		bundleBid *types.Bid
+		lotBids   map[string]*types.Bid
+		expected  bool
+	}{
+		{
+			name:     "no bids",
+			expected: false,
+		},
+		{
+			name:      "bundle bid only",
+			bundleBid: bid(10),
+			expected:  true,
+		},
+		{
+			name:      "lots raise more",
+			bundleBid: bid(50),
+			lotBids:   map[string]*types.Bid{"lot-0": bid(30), "lot-1": bid(25)},
+			expected:  false,
+		},
+		{
+			name:      "bundle raises more",
+			bundleBid: bid(60),
+			lotBids:   map[string]*types.Bid{"lot-0": bid(30), "lot-1": bid(25)},
+			expected:  true,
+		},
+		{
+			name:      "tie goes to the bundle",
+			bundleBid: bid(55),
+			lotBids:   map[string]*types.Bid{"lot-0": bid(30), "lot-1": bid(25)},
+			expected:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, keeper.BundleWins(tc.bundleBid, tc.lotBids))
+		})
+	}
+}
+
+func createBundleAuction(t *testing.T, ms types.MsgServer, ctx sdk.Context, creator string) string {
+	t.Helper()
+	gold := sdk.NewInt64Coin("gold", 3)
+	msg := types.NewMsgCreateAuction(creator, "estate", sdk.NewInt64Coin("token", 10), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_BUNDLE
+	msg.Lots = []*types.AuctionLot{
+		{Item: "painting"},
+		{Item: "bullion", Coin: &gold},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	return res.AuctionId
+}
+
+func TestBundleAuctionSellsLots(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	auctionID := createBundleAuction(t, ms, ctx, creator)
+	storage := bank.Transfers[0].To
+
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, "lot-0", auction.Lots[0].Id)
+	require.Equal(t, "lot-1", auction.Lots[1].Id)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("gold", 3)), bank.Transfers[0].Amount)
+
+	bidOnLot := func(bidder string, lotID string, amount int64) *types.MsgPlaceBid {
+		msg := types.NewMsgPlaceBid(bidder, auctionID, sdk.NewInt64Coin("token", amount))
+		msg.LotId = lotID
+		return msg
+	}
+	testCases := []struct {
+		name   string
+		input  *types.MsgPlaceBid
+		expErr error
+	}{
+		{
+			name:   "unknown lot",
+			input:  bidOnLot(alice, "lot-2", 20),
+			expErr: types.ErrInvalidLot,
+		},
+		{
+			name:  "bundle",
+			input: types.NewMsgPlaceBid(carol, auctionID, sdk.NewInt64Coin("token", 50)),
+		},
+		{
+			name:  "first lot",
+			input: bidOnLot(alice, "lot-0", 20),
+		},
+		{
+			name:   "first lot below its highest bid",
+			input:  bidOnLot(bob, "lot-0", 15),
+			expErr: types.ErrInvalidBidAmount,
+		},
+		{
+			name:  "outbid on first lot",
+			input: bidOnLot(bob, "lot-0", 25),
+		},
+		{
+			name:  "second lot",
+			input: bidOnLot(alice, "lot-1", 30),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.PlaceBid(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+	require.Len(t, k.GetAuctionLotBids(ctx, auctionID), 3)
+
+	// the lots raise 55 against a bundle bid of 50
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 3))},
+		{From: storage, To: carol, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 50))},
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 55))},
+	}, bank.Transfers[transfers:])
+
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Nil(t, auction.Winner())
+	require.Equal(t, bob, auction.Lots[0].WinningBid.Bidder)
+	require.Equal(t, alice, auction.Lots[1].WinningBid.Bidder)
+	require.Len(t, auction.Winners(), 2)
+}
+
+func TestBundleAuctionSellsBundle(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	auctionID := createBundleAuction(t, ms, ctx, creator)
+	storage := bank.Transfers[0].To
+
+	msg := types.NewMsgPlaceBid(alice, auctionID, sdk.NewInt64Coin("token", 20))
+	msg.LotId = "lot-1"
+	_, err := ms.PlaceBid(ctx, msg)
+	require.NoError(t, err)
+
+	// bids on a single lot also prevent the creator from cancelling
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, auctionID))
+	require.ErrorIs(t, err, types.ErrAuctionHasBids)
+
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(carol, auctionID, sdk.NewInt64Coin("token", 40)))
+	require.NoError(t, err)
+
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: carol, Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 3))},
+		{From: storage, To: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 20))},
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 40))},
+	}, bank.Transfers[transfers:])
+
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, carol, auction.Winner().Bidder)
+	require.Nil(t, auction.Lots[1].WinningBid)
+}
+
+func TestBundleAuctionLotsBelowStartingBid(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	auctionID := createBundleAuction(t, ms, ctx, creator)
+	storage := bank.Transfers[0].To
+
+	for _, bid := range []struct {
+		bidder string
+		lotID  string
+		amount int64
+	}{
+		{bidder: alice, lotID: "lot-0", amount: 4},
+		{bidder: bob, lotID: "lot-1", amount: 1},
+	} {
+		msg := types.NewMsgPlaceBid(bid.bidder, auctionID, sdk.NewInt64Coin("token", bid.amount))
+		msg.LotId = bid.lotID
+		_, err := ms.PlaceBid(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// the lots raise 5 against a starting bid of 10, nothing is sold
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 3))},
+		{From: storage, To: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 4))},
+		{From: storage, To: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 1))},
+	}, bank.Transfers[transfers:])
+
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Empty(t, auction.Winners())
+}
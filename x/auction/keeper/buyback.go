@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"auction/x/auction/types"
+)
+
+// IsBuybackDue reports whether a buyback round runs in the current block.
+func (k Keeper) IsBuybackDue(ctx sdk.Context) bool {
+	interval := k.GetParams(ctx).BuybackInterval
+	return interval != 0 && ctx.BlockHeight()%int64(interval) == 0
+}
+
+// RunBuyback runs a buyback round. The proceeds of settled buyback auctions
+// held by the module account are burned, or sent to the community pool, then
+// the fees collected in denoms with a buyback min price are moved from the fee
+// collector to the module account. Every priced denom the module account
+// holds, including the lots of unsold buyback auctions, is offered in a new
+// auction paid in the buyback denom. Its starting bid is the min price of the
+// whole lot, so fees are never sold for less.
+func (k Keeper) RunBuyback(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	proceeds := k.bankKeeper.GetAllBalances(ctx, moduleAddress).AmountOf(params.BuybackDenom)
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.BuybackDenom, proceeds))
+		destination := "burn"
+		if params.BuybackToCommunityPool && k.distrKeeper != nil {
+			destination = "community_pool"
+			if err := k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddress); err != nil {
+				return err
+			}
+		} else if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"buyback_proceeds",
+				sdk.NewAttribute("amount", coins.String()),
+				sdk.NewAttribute("destination", destination),
+			),
+		)
+	}
+
+	fees := sdk.NewCoins()
+	for _, fee := range k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)) {
+		if params.BuybackMinPrices.AmountOf(fee.Denom).IsPositive() {
+			fees = fees.Add(fee)
+		}
+	}
+	if !fees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees); err != nil {
+			return err
+		}
+	}
+
+	for _, lot := range k.bankKeeper.GetAllBalances(ctx, moduleAddress) {
+		minPrice := params.BuybackMinPrices.AmountOf(lot.Denom)
+		if lot.Denom == params.BuybackDenom || !minPrice.IsPositive() {
+			continue
+		}
+
+		// the lot is sold as a single unit to the highest bidder
+		lot := lot
+		startingBid := sdk.NewCoin(params.BuybackDenom, minPrice.MulInt(lot.Amount).Ceil().TruncateInt())
+		msg := types.NewMsgCreateAuction(moduleAddress.String(), fmt.Sprintf("fees %s", lot), startingBid, 0)
+		msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+		msg.Quantity = 1
+		msg.Lot = &lot
+		res, err := k.AppendAuction(ctx, msg)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"buyback_auction",
+				sdk.NewAttribute("auction_id", res.AuctionId),
+				sdk.NewAttribute("lot", lot.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestBuybackAuctionsFees(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	params := types.DefaultParams()
+	params.BuybackInterval = 10
+	params.BuybackMinPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("ibc/atom", math.LegacyNewDecWithPrec(3, 1)))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	moduleAccount := authtypes.NewModuleAddress(types.ModuleName).String()
+	storage := sdk.MustAccAddressFromBech32("cosmos1nt2864p8390qm6tctx33e3zt8gh6aehpqv089g").String()
+	bidder := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		feeCollector: sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100), sdk.NewInt64Coin("ibc/osmo", 50), sdk.NewInt64Coin("stake", 5)),
+		bidder:       sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+	}
+
+	ctx = ctx.WithBlockHeight(9)
+	require.False(t, k.IsBuybackDue(ctx))
+	ctx = ctx.WithBlockHeight(10)
+	require.True(t, k.IsBuybackDue(ctx))
+	k.EndBlocker(ctx)
+
+	// only the fees in denoms with a min price are auctioned, for at least
+	// that price
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ibc/osmo", 50), sdk.NewInt64Coin("stake", 5)), bank.Balances[feeCollector])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100)), bank.Balances[storage])
+	auction, found := k.GetAuction(ctx, "auction-0")
+	require.True(t, found)
+	require.Equal(t, moduleAccount, auction.Creator)
+	require.Equal(t, types.AuctionType_AUCTION_TYPE_MULTI_UNIT, auction.AuctionType)
+	require.Equal(t, sdk.NewInt64Coin("ibc/atom", 100), *auction.Lot)
+	require.Equal(t, sdk.NewInt64Coin("stake", 30), *auction.StartingBid)
+	require.Equal(t, 1, k.GetAuctionCount(ctx))
+
+	msg := types.NewMsgPlaceBid(bidder, auction.Id, sdk.NewInt64Coin("stake", 29))
+	msg.Quantity = 1
+	_, err := ms.PlaceBid(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBidAmount)
+	msg.BidAmount = &sdk.Coin{Denom: "stake", Amount: math.NewInt(40)}
+	_, err = ms.PlaceBid(ctx, msg)
+	require.NoError(t, err)
+
+	// the auction ends on a buyback round, which burns the proceeds right away
+	require.Zero(t, auction.EndHeight%10)
+	ctx = ctx.WithBlockHeight(auction.EndHeight)
+	k.EndBlocker(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100)), bank.Balances[bidder])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), bank.Burned)
+	require.True(t, bank.Balances[moduleAccount].IsZero())
+	require.Equal(t, 1, k.GetAuctionCount(ctx))
+}
+
+func TestBuybackDisabled(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	bank.Balances = map[string]sdk.Coins{
+		feeCollector: sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 100)),
+	}
+
+	ctx = ctx.WithBlockHeight(100)
+	require.False(t, k.IsBuybackDue(ctx))
+	k.EndBlocker(ctx)
+	require.Empty(t, bank.Transfers)
+	require.Equal(t, 0, k.GetAuctionCount(ctx))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"auction/x/auction/types"
+)
+
+// TransmitCreateRemoteAuctionPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitCreateRemoteAuctionPacket(
+	ctx sdk.Context,
+	packetData types.CreateRemoteAuctionPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvCreateRemoteAuctionPacket creates an English auction on behalf of the
+// creator at the other end of the channel. The auction is priced in the
+// vouchers of the channel for the denom of the starting bid, the proceeds go
+// back to the creator as an auction result once it settles.
+func (k Keeper) OnRecvCreateRemoteAuctionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreateRemoteAuctionPacketData) (packetAck types.CreateRemoteAuctionPacketAck, err error) {
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	creator := IBCBidderAddress(packet.DestinationChannel, data.Creator)
+	startingBid := sdk.Coin{
+		Denom:  types.VoucherDenom(packet.DestinationPort, packet.DestinationChannel, data.StartingBid.Denom),
+		Amount: data.StartingBid.Amount,
+	}
+	if err := startingBid.Validate(); err != nil {
+		return packetAck, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid starting bid: %s", err)
+	}
+	msg := types.NewMsgCreateAuction(creator.String(), data.Item, startingBid, data.Duration)
+	if err := msg.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	k.SetIBCBidder(ctx, types.IBCBidder{
+		Address:   creator.String(),
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Sender:    data.Creator,
+	})
+	res, err := NewMsgServerImpl(k).CreateAuction(ctx, msg)
+	if err != nil {
+		return packetAck, err
+	}
+	packetAck.AuctionId = res.AuctionId
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_auction_created",
+			sdk.NewAttribute("auction_id", res.AuctionId),
+			sdk.NewAttribute("creator", creator.String()),
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("sender", data.Creator),
+		),
+	)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementCreateRemoteAuctionPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. Nothing was escrowed to
+// create the auction, there is nothing to roll back.
+func (k Keeper) OnAcknowledgementCreateRemoteAuctionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreateRemoteAuctionPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.CreateRemoteAuctionPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"remote_auction_listed",
+				sdk.NewAttribute("auction_id", packetAck.AuctionId),
+				sdk.NewAttribute("creator", data.Creator),
+				sdk.NewAttribute("channel_id", packet.SourceChannel),
+			),
+		)
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutCreateRemoteAuctionPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutCreateRemoteAuctionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreateRemoteAuctionPacketData) error {
+	return nil
+}
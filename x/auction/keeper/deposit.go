@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// SetBidderRegistration sets a bidder registration in the store.
+func (k Keeper) SetBidderRegistration(ctx sdk.Context, registration types.BidderRegistration) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BidderRegistrationKey))
+
+	key := types.BidderRegistrationEntryKey(registration.AuctionId, registration.Bidder)
+	store.Set(key, k.cdc.MustMarshal(&registration))
+}
+
+// GetBidderRegistration returns the registration of a bidder to an auction.
+func (k Keeper) GetBidderRegistration(ctx sdk.Context, auctionID string, bidder string) (registration types.BidderRegistration, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BidderRegistrationKey))
+
+	bz := store.Get(types.BidderRegistrationEntryKey(auctionID, bidder))
+	if bz == nil {
+		return registration, false
+	}
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// RemoveBidderRegistration removes the registration of a bidder to an auction.
+func (k Keeper) RemoveBidderRegistration(ctx sdk.Context, auctionID string, bidder string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BidderRegistrationKey))
+
+	store.Delete(types.BidderRegistrationEntryKey(auctionID, bidder))
+}
+
+// GetAuctionBidderRegistrations returns the registrations of all bidders of an
+// auction.
+func (k Keeper) GetAuctionBidderRegistrations(ctx sdk.Context, auctionID string) (list []types.BidderRegistration) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BidderRegistrationKey))
+	iterator := storetypes.KVStorePrefixIterator(store, types.BidderRegistrationKeyPrefix(auctionID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.BidderRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		list = append(list, registration)
+	}
+
+	return
+}
+
+// GetAllBidderRegistration returns all bidder registrations.
+func (k Keeper) GetAllBidderRegistration(ctx sdk.Context) (list []types.BidderRegistration) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BidderRegistrationKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.BidderRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		list = append(list, registration)
+	}
+
+	return
+}
+
+// RegisterBidder locks the auction deposit of a bidder in the storage account.
+func (k Keeper) RegisterBidder(ctx sdk.Context, auctionID string, bidder string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	// Bidders can register ahead of the start of upcoming auctions
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_UPCOMING && !k.IsAuctionOpen(ctx, auctionID) {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+	if !auction.RequiresDeposit() {
+		return errorsmod.Wrapf(types.ErrDepositNotRequired, "auction %s", auctionID)
+	}
+	if err := k.ValidateBidder(ctx, auction, bidder); err != nil {
+		return err
+	}
+	if _, found := k.GetBidderRegistration(ctx, auctionID, bidder); found {
+		return errorsmod.Wrapf(types.ErrAlreadyRegistered, "%s for auction %s", bidder, auctionID)
+	}
+
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoins(ctx, bidderAddress, k.storageAddress, sdk.NewCoins(*auction.Deposit))
+	if err != nil {
+		return err
+	}
+
+	k.SetBidderRegistration(ctx, types.BidderRegistration{
+		AuctionId: auctionID,
+		Bidder:    bidder,
+		Deposit:   auction.Deposit,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"register_bidder",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("bidder", bidder),
+			sdk.NewAttribute("deposit", auction.Deposit.String()),
+		),
+	)
+
+	return k.GrantBidFeeAllowance(ctx, auctionID, bidder)
+}
+
+// RefundDeposits returns the deposits of all registered bidders of an auction
+// and removes their registrations.
+func (k Keeper) RefundDeposits(ctx sdk.Context, auctionID string) error {
+	for _, registration := range k.GetAuctionBidderRegistrations(ctx, auctionID) {
+		bidderAddress, err := sdk.AccAddressFromBech32(registration.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(*registration.Deposit))
+		if err != nil {
+			return err
+		}
+		k.RemoveBidderRegistration(ctx, auctionID, registration.Bidder)
+	}
+
+	return nil
+}
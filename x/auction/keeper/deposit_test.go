@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestRegisterBidder(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, bidder := sample.AccAddress(), sample.AccAddress()
+	deposit := sdk.NewInt64Coin("token", 5)
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	noDeposit, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	// bidding requires a registration
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrBidderNotRegistered)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgRegisterBidder
+		expErr error
+	}{
+		{
+			name:   "unknown auction",
+			input:  types.NewMsgRegisterBidder(bidder, "auction-42"),
+			expErr: types.ErrInvalidAuctionId,
+		},
+		{
+			name:   "no deposit required",
+			input:  types.NewMsgRegisterBidder(bidder, noDeposit.AuctionId),
+			expErr: types.ErrDepositNotRequired,
+		},
+		{
+			name:   "creator",
+			input:  types.NewMsgRegisterBidder(creator, res.AuctionId),
+			expErr: types.ErrSelfBid,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgRegisterBidder(bidder, res.AuctionId),
+		},
+		{
+			name:   "already registered",
+			input:  types.NewMsgRegisterBidder(bidder, res.AuctionId),
+			expErr: types.ErrAlreadyRegistered,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RegisterBidder(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	lock := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, bidder, lock.From)
+	require.Equal(t, sdk.NewCoins(deposit), lock.Amount)
+
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	// the deposit is returned when the auction settles
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, bidder, refund.To)
+	require.Equal(t, sdk.NewCoins(deposit), refund.Amount)
+	require.Empty(t, k.GetAuctionBidderRegistrations(ctx, res.AuctionId))
+}
+
+func TestCancelAuctionRefundsDeposits(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, bidder := sample.AccAddress(), sample.AccAddress()
+	deposit := sdk.NewInt64Coin("token", 5)
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	_, err = ms.RegisterBidder(ctx, types.NewMsgRegisterBidder(bidder, res.AuctionId))
+	require.NoError(t, err)
+
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, res.AuctionId))
+	require.NoError(t, err)
+
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, bidder, refund.To)
+	require.Equal(t, sdk.NewCoins(deposit), refund.Amount)
+	_, found := k.GetBidderRegistration(ctx, res.AuctionId, bidder)
+	require.False(t, found)
+}
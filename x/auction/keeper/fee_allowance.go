@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"auction/x/auction/types"
+)
+
+// FeeSponsorAddress returns the address holding the fee allowance of an
+// auction. It grants the allowances paying the fees of bids on the auction.
+func FeeSponsorAddress(auctionID string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s", types.FeeSponsorName, auctionID))
+}
+
+// fundFeeAllowance escrows the fee allowance of a new auction from its
+// creator to the fee sponsor of the auction.
+func (k Keeper) fundFeeAllowance(ctx sdk.Context, auction *types.Auction, feeAllowance, perBidder sdk.Coins) error {
+	if feeAllowance.IsZero() {
+		return nil
+	}
+	if k.feegrantKeeper == nil {
+		return errorsmod.Wrap(types.ErrNoFeegrant, "cannot sponsor bid fees")
+	}
+	// without a cap every bidder could spend the whole fee allowance
+	if perBidder.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "fee allowance requires a fee allowance per bidder")
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	sponsor := FeeSponsorAddress(auction.Id)
+	if err := k.bankKeeper.SendCoins(ctx, creatorAddress, sponsor, feeAllowance); err != nil {
+		return err
+	}
+	auction.FeeAllowance = feeAllowance
+	auction.FeeAllowancePerBidder = perBidder
+	auction.FeeSponsor = sponsor.String()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fund_fee_allowance",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("fee_sponsor", auction.FeeSponsor),
+			sdk.NewAttribute("amount", feeAllowance.String()),
+		),
+	)
+
+	return nil
+}
+
+// GrantBidFeeAllowance grants the bidder an allowance from the fee sponsor of
+// the auction, unless the auction does not sponsor bid fees or the bidder
+// already holds one. Later bids of the bidder are then paid for by the
+// sponsor.
+func (k Keeper) GrantBidFeeAllowance(ctx sdk.Context, auctionID string, bidder string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.FeeSponsor == "" || k.feegrantKeeper == nil || slices.Contains(auction.FeeGrantees, bidder) {
+		return nil
+	}
+
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	allowance := types.NewBidFeeAllowance(auction.Id, auction.FeeAllowancePerBidder)
+	if err := k.feegrantKeeper.GrantAllowance(ctx, FeeSponsorAddress(auction.Id), bidderAddress, allowance); err != nil {
+		return err
+	}
+	auction.FeeGrantees = append(auction.FeeGrantees, bidder)
+	k.SetAuction(ctx, auction)
+
+	return nil
+}
+
+// GrantBidFeeAllowances grants allowances to bidders up front on behalf of the
+// creator of an auction sponsoring bid fees, so that their first bid is paid
+// for as well.
+func (k Keeper) GrantBidFeeAllowances(ctx sdk.Context, auctionID string, creator string, bidders []string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Creator != creator {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the creator of auction %s", creator, auctionID)
+	}
+	if auction.FeeSponsor == "" {
+		return errorsmod.Wrapf(types.ErrNoFeegrant, "auction %s does not sponsor bid fees", auctionID)
+	}
+	switch auction.Status {
+	case types.AuctionStatus_AUCTION_STATUS_OPEN, types.AuctionStatus_AUCTION_STATUS_PAUSED, types.AuctionStatus_AUCTION_STATUS_UPCOMING:
+	default:
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	for _, bidder := range bidders {
+		if err := k.ValidateBidder(ctx, auction, bidder); err != nil {
+			return err
+		}
+		if err := k.GrantBidFeeAllowance(ctx, auctionID, bidder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reclaimFeeAllowance closes the fee allowances granted for a closing auction
+// and returns what is left of its fee allowance to the creator. Allowances
+// cannot be revoked by another module, they are expired instead and removed
+// by x/feegrant the next time they are used.
+func (k Keeper) reclaimFeeAllowance(ctx sdk.Context, auction types.Auction) error {
+	if auction.FeeSponsor == "" || k.feegrantKeeper == nil {
+		return nil
+	}
+
+	sponsor := FeeSponsorAddress(auction.Id)
+	for _, grantee := range auction.FeeGrantees {
+		granteeAddress, err := sdk.AccAddressFromBech32(grantee)
+		if err != nil {
+			return err
+		}
+		// the allowance is gone once it was used up or expired
+		allowance, err := k.feegrantKeeper.GetAllowance(ctx, sponsor, granteeAddress)
+		if err != nil {
+			continue
+		}
+		bidAllowance, ok := allowance.(*types.BidFeeAllowance)
+		if !ok || bidAllowance.Expiration != nil {
+			continue
+		}
+		closedAt := ctx.BlockTime()
+		bidAllowance.Expiration = &closedAt
+		if err := k.feegrantKeeper.UpdateAllowance(ctx, sponsor, granteeAddress, bidAllowance); err != nil {
+			return err
+		}
+	}
+
+	left := k.bankKeeper.GetAllBalances(ctx, sponsor)
+	if left.IsZero() {
+		return nil
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sponsor, creatorAddress, left); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"reclaim_fee_allowance",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("amount", left.String()),
+		),
+	)
+
+	return nil
+}
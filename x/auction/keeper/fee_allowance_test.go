@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestBidFeeAllowance(t *testing.T) {
+	k, ctx, bank, feegrantKeeper := keepertest.AuctionKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	bidder := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		bidder:  sdk.NewCoins(sdk.NewInt64Coin("token", 100)),
+	}
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	msg.FeeAllowancePerBidder = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	other, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	sponsor := keeper.FeeSponsorAddress(res.AuctionId)
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, sponsor.String(), auction.FeeSponsor)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), bank.Balances[sponsor.String()])
+
+	// the first bid earns the bidder an allowance for its next bids
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	bidderAddress := sdk.MustAccAddressFromBech32(bidder)
+	rebid := []sdk.Msg{types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 30))}
+	require.NoError(t, feegrantKeeper.UseGrantedFees(ctx, sponsor, bidderAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), rebid))
+	bank.SendCoins(ctx, sponsor, authtypes.NewModuleAddress(authtypes.FeeCollectorName), sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+
+	err = feegrantKeeper.UseGrantedFees(ctx, sponsor, bidderAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		[]sdk.Msg{types.NewMsgPlaceBid(bidder, other.AuctionId, sdk.NewInt64Coin("token", 30))})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	err = feegrantKeeper.UseGrantedFees(ctx, sponsor, bidderAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		[]sdk.Msg{types.NewMsgCancelAuction(bidder, res.AuctionId)})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	err = feegrantKeeper.UseGrantedFees(ctx, sponsor, bidderAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 16)), rebid)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// a second bid does not grant another allowance
+	_, err = ms.PlaceBid(ctx, rebid[0].(*types.MsgPlaceBid))
+	require.NoError(t, err)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, []string{bidder}, auction.FeeGrantees)
+
+	// closing the auction returns what is left and expires the allowance
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	require.True(t, bank.Balances[sponsor.String()].IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 95), sdk.NewInt64Coin("token", 30)), bank.Balances[creator])
+	err = feegrantKeeper.UseGrantedFees(ctx, sponsor, bidderAddress, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), rebid)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExpired)
+	_, err = feegrantKeeper.GetAllowance(ctx, sponsor, bidderAddress)
+	require.Error(t, err)
+}
+
+func TestBidFeeAllowanceReclaimedOnCancel(t *testing.T) {
+	k, ctx, bank, _ := keepertest.AuctionKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	msg.FeeAllowancePerBidder = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), bank.Balances[creator])
+
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, res.AuctionId))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.Balances[creator])
+}
+
+func TestBidFeeAllowanceWithoutFeegrant(t *testing.T) {
+	k, ctx := keepertest.AuctionKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	msg.FeeAllowancePerBidder = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	_, err := ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoFeegrant)
+}
+
+func TestBidFeeAllowanceRequiresPerBidderCap(t *testing.T) {
+	k, ctx, bank, _ := keepertest.AuctionKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	_, err := ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), bank.Balances[creator])
+}
+
+func TestGrantBidFeeAllowance(t *testing.T) {
+	k, ctx, bank, feegrantKeeper := keepertest.AuctionKeeperWithFeegrant(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	bidder := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	msg.FeeAllowancePerBidder = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	unsponsored, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	_, err = ms.GrantBidFeeAllowance(ctx, types.NewMsgGrantBidFeeAllowance(bidder, res.AuctionId, []string{bidder}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.GrantBidFeeAllowance(ctx, types.NewMsgGrantBidFeeAllowance(creator, unsponsored.AuctionId, []string{bidder}))
+	require.ErrorIs(t, err, types.ErrNoFeegrant)
+	_, err = ms.GrantBidFeeAllowance(ctx, types.NewMsgGrantBidFeeAllowance(creator, res.AuctionId, []string{creator}))
+	require.Error(t, err)
+
+	// a bidder granted up front has the first bid paid for as well
+	_, err = ms.GrantBidFeeAllowance(ctx, types.NewMsgGrantBidFeeAllowance(creator, res.AuctionId, []string{bidder}))
+	require.NoError(t, err)
+	sponsor := keeper.FeeSponsorAddress(res.AuctionId)
+	bid := []sdk.Msg{types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20))}
+	require.NoError(t, feegrantKeeper.UseGrantedFees(ctx, sponsor, sdk.MustAccAddressFromBech32(bidder), sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), bid))
+
+	// granting again does not add another allowance
+	_, err = ms.GrantBidFeeAllowance(ctx, types.NewMsgGrantBidFeeAllowance(creator, res.AuctionId, []string{bidder}))
+	require.NoError(t, err)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, []string{bidder}, auction.FeeGrantees)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// hooks holds the hooks registered by other modules.
+type hooks struct {
+	auction     types.AuctionHooks
+	liquidation types.LiquidationHooks
+}
+
+// Hooks gets the auction hooks, a no-op implementation when none are set.
+func (k Keeper) Hooks() types.AuctionHooks {
+	if k.hooks.auction == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiAuctionHooks{}
+	}
+
+	return k.hooks.auction
+}
+
+// SetHooks sets the auction hooks. It can only be called once.
+func (k Keeper) SetHooks(ah types.AuctionHooks) {
+	if k.hooks.auction != nil {
+		panic("cannot set auction hooks twice")
+	}
+	k.hooks.auction = ah
+}
+
+// afterBidRefunded credits a refund to the bid authorizations that funded the
+// bid before calling the AfterBidRefunded hooks. Refunds of IBC bidders are
+// then sent back to their origin chain.
+func (k Keeper) afterBidRefunded(ctx sdk.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.creditBidAuthorizations(ctx, auctionID, bidder, amount); err != nil {
+		return err
+	}
+	if err := k.Hooks().AfterBidRefunded(ctx, auctionID, bidder, amount); err != nil {
+		return err
+	}
+	k.returnToIBCBidder(ctx, auctionID, bidder.String(), amount.Denom, types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_REFUNDED)
+	return nil
+}
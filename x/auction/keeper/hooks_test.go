@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// recordingHooks records every hook call as a short description.
+type recordingHooks struct {
+	calls []string
+}
+
+func (h *recordingHooks) AfterAuctionCreated(ctx context.Context, auctionID string) error {
+	h.calls = append(h.calls, "created "+auctionID)
+	return nil
+}
+
+func (h *recordingHooks) AfterBidPlaced(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error {
+	h.calls = append(h.calls, fmt.Sprintf("bid %s %s %s", auctionID, bidder, amount))
+	return nil
+}
+
+func (h *recordingHooks) AfterBidRefunded(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error {
+	h.calls = append(h.calls, fmt.Sprintf("refund %s %s %s", auctionID, bidder, amount))
+	return nil
+}
+
+func (h *recordingHooks) AfterAuctionSettled(ctx context.Context, auctionID string) error {
+	h.calls = append(h.calls, "settled "+auctionID)
+	return nil
+}
+
+func (h *recordingHooks) AfterAuctionCancelled(ctx context.Context, auctionID string) error {
+	h.calls = append(h.calls, "cancelled "+auctionID)
+	return nil
+}
+
+func TestAuctionHooks(t *testing.T) {
+	k, ctx := keepertest.AuctionKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	hooks := &recordingHooks{}
+	k.SetHooks(types.NewMultiAuctionHooks(hooks))
+	require.Panics(t, func() { k.SetHooks(hooks) })
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+
+	cancelled, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, cancelled.AuctionId))
+	require.NoError(t, err)
+
+	k.EndBlocker(ctx.WithBlockHeight(11))
+
+	require.Equal(t, []string{
+		"created auction-0",
+		fmt.Sprintf("bid auction-0 %s 20token", alice),
+		fmt.Sprintf("bid auction-0 %s 30token", bob),
+		fmt.Sprintf("refund auction-0 %s 20token", alice),
+		"created auction-1",
+		"cancelled auction-1",
+		"settled auction-0",
+	}, hooks.calls)
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"auction/x/auction/types"
+)
+
+// ibcKeepers holds the IBC keepers set on the keeper by the app.
+type ibcKeepers struct {
+	transfer types.TransferKeeper
+}
+
+// SetTransferKeeper sets the transfer keeper sending the refunds of IBC
+// bidders back to their origin chain. Without it the refunds stay on the
+// accounts of the IBC bidders.
+func (k Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.ibc.transfer = transferKeeper
+}
+
+// ChanCloseInit defines a wrapper function for the channel Keeper's function.
+func (k Keeper) ChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	capName := host.ChannelCapabilityPath(portID, channelID)
+	chanCap, ok := k.ScopedKeeper().GetCapability(ctx, capName)
+	if !ok {
+		return errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "could not retrieve channel capability at: %s", capName)
+	}
+	return k.ibcKeeperFn().ChannelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap)
+}
+
+// ShouldBound checks if the IBC app module can be bound to the desired port.
+// It cannot without IBC wired in the app.
+func (k Keeper) ShouldBound(ctx sdk.Context, portID string) bool {
+	if k.ibcKeeperFn == nil || k.capabilityScopedFn == nil {
+		return false
+	}
+	_, ok := k.ScopedKeeper().GetCapability(ctx, host.PortPath(portID))
+	return !ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	portCap := k.ibcKeeperFn().PortKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the IBC app module.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the IBC app module.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability
+// function.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.ScopedKeeper().AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the IBC app module to claim a capability that core
+// IBC passes to it.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.ScopedKeeper().ClaimCapability(ctx, cap, name)
+}
+
+// ScopedKeeper returns the capability keeper scoped to the module.
+func (k Keeper) ScopedKeeper() exported.ScopedKeeper {
+	return k.capabilityScopedFn(types.ModuleName)
+}
+
+// sendPacket sends the data of an auction packet over a channel the module
+// owns.
+func (k Keeper) sendPacket(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	if k.ibcKeeperFn == nil || k.capabilityScopedFn == nil {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "IBC is not wired in the app")
+	}
+	channelCap, ok := k.ScopedKeeper().GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// RemoteEscrowAddress returns the account escrowing the bids sent to the
+// auctions at the other end of an auction channel until their outcome comes
+// back.
+func RemoteEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s/%s", types.RemoteEscrowName, portID, channelID))
+}
+
+// IBCBidderAddress returns the account bidding on behalf of a sender on the
+// other end of a channel. Deriving it from the channel keeps senders of
+// different chains with the same address apart.
+func IBCBidderAddress(channelID string, sender string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s/%s", types.IBCBidderName, channelID, sender))
+}
+
+// SetIBCBidder sets the origin of an IBC bidder in the store.
+func (k Keeper) SetIBCBidder(ctx sdk.Context, bidder types.IBCBidder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IBCBidderKey))
+
+	store.Set([]byte(bidder.Address), k.cdc.MustMarshal(&bidder))
+}
+
+// GetIBCBidder returns the origin of an IBC bidder.
+func (k Keeper) GetIBCBidder(ctx sdk.Context, address string) (bidder types.IBCBidder, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IBCBidderKey))
+
+	bz := store.Get([]byte(address))
+	if bz == nil {
+		return bidder, false
+	}
+	k.cdc.MustUnmarshal(bz, &bidder)
+	return bidder, true
+}
+
+// GetAllIBCBidder returns the origins of all IBC bidders.
+func (k Keeper) GetAllIBCBidder(ctx sdk.Context) (list []types.IBCBidder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IBCBidderKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bidder types.IBCBidder
+		k.cdc.MustUnmarshal(iterator.Value(), &bidder)
+		list = append(list, bidder)
+	}
+
+	return
+}
+
+// returnToIBCBidder sends the balance of an IBC bidder back to the sender on
+// its origin chain. Nobody holds the keys of the bidder account, anything it
+// receives, refunds and won lots alike, belongs to the remote sender.
+//
+// Bidders of the auction IBC application get the vouchers of the bid denom
+// back with an auction result telling the outcome, bidders over ICS-20 get
+// their whole balance transferred back.
+//
+// A failed transfer must not fail the refund or the settlement that triggered
+// it, the funds then stay on the account and go out with its next return.
+// Transfers that time out or are rejected by the origin chain come back to the
+// account the same way.
+func (k Keeper) returnToIBCBidder(ctx sdk.Context, auctionID, address, denom string, outcome types.RemoteAuctionOutcome) {
+	bidder, found := k.GetIBCBidder(ctx, address)
+	if !found {
+		return
+	}
+	if bidder.PortId == k.GetPort(ctx) {
+		k.sendAuctionResult(ctx, bidder, auctionID, denom, outcome)
+		return
+	}
+	if k.ibc.transfer == nil {
+		return
+	}
+	bidderAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return
+	}
+
+	timeout := uint64(ctx.BlockTime().Add(types.IBCRefundTimeout).UnixNano())
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, bidderAddress) {
+		msg := transfertypes.NewMsgTransfer(bidder.PortId, bidder.ChannelId, coin, address, bidder.Sender, ibcclienttypes.ZeroHeight(), timeout, "")
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.ibc.transfer.Transfer(cacheCtx, msg); err != nil {
+			k.Logger().Error("failed to return funds to IBC bidder", "bidder", address, "amount", coin, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"return_to_ibc_bidder",
+				sdk.NewAttribute("bidder", address),
+				sdk.NewAttribute("channel_id", bidder.ChannelId),
+				sdk.NewAttribute("receiver", bidder.Sender),
+				sdk.NewAttribute("amount", coin.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"auction/x/auction/types"
+)
+
+// IncentivePoolAddress returns the address funding incentive rebates.
+func IncentivePoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.IncentivePoolName)
+}
+
+// AuctionRewardsAddress returns the address holding accrued rebates until
+// they are claimed.
+func AuctionRewardsAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.AuctionRewardsName)
+}
+
+// SetAuctionReward sets the rebates accrued by an address in the store.
+func (k Keeper) SetAuctionReward(ctx sdk.Context, reward types.AuctionReward) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionRewardKey))
+
+	store.Set([]byte(reward.Address), k.cdc.MustMarshal(&reward))
+}
+
+// GetAuctionReward returns the rebates accrued by an address.
+func (k Keeper) GetAuctionReward(ctx sdk.Context, address string) (reward types.AuctionReward, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionRewardKey))
+
+	bz := store.Get([]byte(address))
+	if bz == nil {
+		return reward, false
+	}
+	k.cdc.MustUnmarshal(bz, &reward)
+	return reward, true
+}
+
+// RemoveAuctionReward removes the rebates accrued by an address from the
+// store.
+func (k Keeper) RemoveAuctionReward(ctx sdk.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionRewardKey))
+
+	store.Delete([]byte(address))
+}
+
+// GetAllAuctionReward returns the rebates accrued by all addresses.
+func (k Keeper) GetAllAuctionReward(ctx sdk.Context) (list []types.AuctionReward) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionRewardKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.AuctionReward
+		k.cdc.MustUnmarshal(iterator.Value(), &reward)
+		list = append(list, reward)
+	}
+
+	return
+}
+
+// GetIncentiveEpoch returns the rebates accrued in the last epoch rebates
+// were accrued in.
+func (k Keeper) GetIncentiveEpoch(ctx sdk.Context) (epoch types.IncentiveEpoch) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(types.IncentiveEpochKey)
+	if bz == nil {
+		return epoch
+	}
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
+}
+
+// SetIncentiveEpoch sets the rebates accrued in the current epoch.
+func (k Keeper) SetIncentiveEpoch(ctx sdk.Context, epoch types.IncentiveEpoch) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	store.Set(types.IncentiveEpochKey, k.cdc.MustMarshal(&epoch))
+}
+
+// FundIncentivePool moves funds from the community pool to the incentive
+// pool.
+func (k Keeper) FundIncentivePool(ctx sdk.Context, amount sdk.Coins) error {
+	if k.distrKeeper == nil {
+		return types.ErrNoCommunityPool
+	}
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, IncentivePoolAddress()); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fund_incentive_pool",
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// rewardSettlement accrues the incentive rebates of a settled auction. The
+// creator earns the incentive rate on the total amount paid, every winner on
+// the amount they paid. Auctions created by the module itself or by the
+// treasury only reward their winners.
+//
+// A win by an address linked to the creator through authz or feegrant is
+// likely a trade of the creator with itself. It earns nothing on either side,
+// so that wash trades cannot drain the epoch cap.
+func (k Keeper) rewardSettlement(ctx sdk.Context, auction types.Auction) error {
+	params := k.GetParams(ctx)
+	if !params.IncentiveRate.IsPositive() {
+		return nil
+	}
+
+	winners := auction.Winners()
+	volume := sdk.NewCoins()
+	for _, bid := range winners {
+		linked, err := k.IsLinkedToCreator(ctx, auction, bid.Bidder)
+		if err != nil {
+			return err
+		}
+		if linked {
+			continue
+		}
+		paid := auction.PaidAmount(bid)
+		volume = volume.Add(paid)
+		if err := k.accrueReward(ctx, params, bid.Bidder, paid); err != nil {
+			return err
+		}
+	}
+	if auction.Creator == authtypes.NewModuleAddress(types.ModuleName).String() || IsTreasuryAuction(auction) {
+		return nil
+	}
+	for _, paid := range volume {
+		if err := k.accrueReward(ctx, params, auction.Creator, paid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// accrueReward moves the rebate on the given amount from the incentive pool to
+// the rewards address and credits it to the recipient. Rebates are capped by
+// what is left of the epoch cap of their denom and by the pool balance.
+func (k Keeper) accrueReward(ctx sdk.Context, params types.Params, recipient string, paid sdk.Coin) error {
+	rebate := params.IncentiveRate.MulInt(paid.Amount).TruncateInt()
+
+	current := uint64(ctx.BlockHeight()) / params.IncentiveEpochLength
+	epoch := k.GetIncentiveEpoch(ctx)
+	if epoch.Epoch != current {
+		epoch = types.IncentiveEpoch{Epoch: current}
+	}
+	rebate = math.MinInt(rebate, params.IncentiveEpochCap.AmountOf(paid.Denom).Sub(epoch.Accrued.AmountOf(paid.Denom)))
+	rebate = math.MinInt(rebate, k.bankKeeper.GetAllBalances(ctx, IncentivePoolAddress()).AmountOf(paid.Denom))
+	if !rebate.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(paid.Denom, rebate))
+	if err := k.bankKeeper.SendCoins(ctx, IncentivePoolAddress(), AuctionRewardsAddress(), coins); err != nil {
+		return err
+	}
+	epoch.Accrued = epoch.Accrued.Add(coins...)
+	k.SetIncentiveEpoch(ctx, epoch)
+
+	reward, found := k.GetAuctionReward(ctx, recipient)
+	if !found {
+		reward = types.AuctionReward{Address: recipient}
+	}
+	reward.Amount = reward.Amount.Add(coins...)
+	k.SetAuctionReward(ctx, reward)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"accrue_reward",
+			sdk.NewAttribute("address", recipient),
+			sdk.NewAttribute("amount", coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// ClaimAuctionRewards pays the rebates accrued by the claimant.
+func (k Keeper) ClaimAuctionRewards(ctx sdk.Context, claimant string) (sdk.Coins, error) {
+	reward, found := k.GetAuctionReward(ctx, claimant)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNoRewards, "%s has no accrued rewards", claimant)
+	}
+
+	claimantAddress, err := sdk.AccAddressFromBech32(claimant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, AuctionRewardsAddress(), claimantAddress, reward.Amount); err != nil {
+		return nil, err
+	}
+	k.RemoveAuctionReward(ctx, claimant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"claim_rewards",
+			sdk.NewAttribute("address", claimant),
+			sdk.NewAttribute("amount", reward.Amount.String()),
+		),
+	)
+
+	return reward.Amount, nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestAuctionRewards(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	params := types.DefaultParams()
+	params.IncentiveRate = math.LegacyNewDecWithPrec(1, 1)
+	params.IncentiveEpochLength = 100
+	params.IncentiveEpochCap = sdk.NewCoins(sdk.NewInt64Coin("stake", 15))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	pool := keeper.IncentivePoolAddress().String()
+	rewards := keeper.AuctionRewardsAddress().String()
+	creator := sample.AccAddress()
+	bidder := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		pool:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		bidder: sdk.NewCoins(sdk.NewInt64Coin("stake", 300)),
+	}
+
+	settle := func(ctx sdk.Context) {
+		res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("stake", 10), 10))
+		require.NoError(t, err)
+		_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("stake", 100)))
+		require.NoError(t, err)
+		require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	}
+
+	// the winner earns its rebate first, the creator gets what is left of the
+	// epoch cap
+	ctx = ctx.WithBlockHeight(110)
+	settle(ctx)
+	res, err := k.AuctionRewards(ctx, &types.QueryAuctionRewardsRequest{Address: bidder})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), res.Rewards)
+	res, err = k.AuctionRewards(ctx, &types.QueryAuctionRewardsRequest{Address: creator})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), res.Rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), bank.Balances[rewards])
+
+	// the cap is exhausted for the rest of the epoch
+	settle(ctx.WithBlockHeight(150))
+	res, err = k.AuctionRewards(ctx, &types.QueryAuctionRewardsRequest{Address: bidder})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), res.Rewards)
+
+	// and resets with the next one
+	settle(ctx.WithBlockHeight(200))
+	res, err = k.AuctionRewards(ctx, &types.QueryAuctionRewardsRequest{Address: bidder})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), res.Rewards)
+
+	claim, err := ms.ClaimAuctionRewards(ctx, types.NewMsgClaimAuctionRewards(bidder))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), claim.Amount)
+	res, err = k.AuctionRewards(ctx, &types.QueryAuctionRewardsRequest{Address: bidder})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bank.Balances[rewards])
+
+	_, err = ms.ClaimAuctionRewards(ctx, types.NewMsgClaimAuctionRewards(bidder))
+	require.ErrorIs(t, err, types.ErrNoRewards)
+}
+
+func TestAuctionRewardsBoundedByPool(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	params := types.DefaultParams()
+	params.IncentiveRate = math.LegacyNewDecWithPrec(1, 1)
+	params.IncentiveEpochCap = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	creator := sample.AccAddress()
+	bidder := sample.AccAddress()
+	bank.Balances = map[string]sdk.Coins{
+		keeper.IncentivePoolAddress().String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 4)),
+		bidder:                                 sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 100)),
+	}
+
+	// auctions paid in a denom without a cap earn nothing
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("atom", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("atom", 100)))
+	require.NoError(t, err)
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	_, found := k.GetAuctionReward(ctx, bidder)
+	require.False(t, found)
+
+	res, err = ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("stake", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	reward, found := k.GetAuctionReward(ctx, bidder)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), reward.Amount)
+	_, found = k.GetAuctionReward(ctx, creator)
+	require.False(t, found)
+}
+
+func TestAuctionRewardsWashTrade(t *testing.T) {
+	params := types.DefaultParams()
+	params.IncentiveRate = math.LegacyNewDecWithPrec(1, 1)
+	params.IncentiveEpochCap = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	creator, second, bidder := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	creatorAddress, secondAddress := sdk.MustAccAddressFromBech32(creator), sdk.MustAccAddressFromBech32(second)
+
+	// trade runs an auction of the creator won by the second account, then
+	// one won by an unrelated bidder
+	trade := func(k keeper.Keeper, ctx sdk.Context, bank *keepertest.MockBankKeeper) {
+		require.NoError(t, k.SetParams(ctx, params))
+		bank.Balances = map[string]sdk.Coins{
+			keeper.IncentivePoolAddress().String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			second:                                 sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			bidder:                                 sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		}
+		ms := keeper.NewMsgServerImpl(k)
+		for _, winner := range []string{second, bidder} {
+			res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("stake", 10), 10))
+			require.NoError(t, err)
+			_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(winner, res.AuctionId, sdk.NewInt64Coin("stake", 100)))
+			require.NoError(t, err)
+			require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+		}
+
+		// the wash trade earns nothing on either side
+		_, found := k.GetAuctionReward(ctx, second)
+		require.False(t, found)
+		reward, _ := k.GetAuctionReward(ctx, creator)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), reward.Amount)
+		reward, _ = k.GetAuctionReward(ctx, bidder)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), reward.Amount)
+	}
+
+	// the second account holds an authz grant from the creator
+	k, ctx, bank, authzKeeper := keepertest.AuctionKeeperWithAuthz(t)
+	require.NoError(t, authzKeeper.SaveGrant(ctx, secondAddress, creatorAddress, authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), nil))
+	trade(k, ctx, bank)
+
+	// the second account pays the fees of the creator
+	k, ctx, bank, feegrantKeeper := keepertest.AuctionKeeperWithFeegrant(t)
+	require.NoError(t, feegrantKeeper.GrantAllowance(ctx, secondAddress, creatorAddress, &feegrant.BasicAllowance{}))
+	trade(k, ctx, bank)
+}
+
+func TestFundIncentivePool(t *testing.T) {
+	k, ctx := keepertest.AuctionKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	_, err := ms.FundIncentivePool(ctx, &types.MsgFundIncentivePool{Authority: sample.AccAddress(), Amount: amount})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// the test keeper is built without a distribution keeper
+	_, err = ms.FundIncentivePool(ctx, &types.MsgFundIncentivePool{Authority: k.GetAuthority(), Amount: amount})
+	require.ErrorIs(t, err, types.ErrNoCommunityPool)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// RegisterInvariants registers the auction module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "rewards", RewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vouchers", VoucherInvariant(k))
+}
+
+// EscrowInvariant checks that the storage account holds at least the escrowed
+// bids, lots and budgets of every open or upcoming auction, the balances of
+// active schedules and the deposits of all registered bidders.
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed := sdk.NewCoins()
+		for _, auction := range k.GetAllAuction(ctx) {
+			if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN && auction.Status != types.AuctionStatus_AUCTION_STATUS_UPCOMING {
+				continue
+			}
+			for _, bid := range EscrowedBids(auction) {
+				escrowed = escrowed.Add(auction.BidEscrow(bid))
+			}
+			if auction.Lot != nil {
+				escrowed = escrowed.Add(*auction.Lot)
+			}
+			if auction.IsReverse() {
+				escrowed = escrowed.Add(*auction.StartingBid)
+			}
+			for _, bid := range k.HighestLotBids(ctx, auction) {
+				escrowed = escrowed.Add(*bid.BidAmount)
+			}
+			escrowed = escrowed.Add(lotCoins(auction)...)
+		}
+		for _, schedule := range k.GetAllAuctionSchedule(ctx) {
+			if schedule.Status == types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE && schedule.Balance != nil {
+				escrowed = escrowed.Add(*schedule.Balance)
+			}
+		}
+		for _, registration := range k.GetAllBidderRegistration(ctx) {
+			escrowed = escrowed.Add(*registration.Deposit)
+		}
+
+		balance := k.bankKeeper.SpendableCoins(ctx, k.storageAddress)
+		broken := !balance.IsAllGTE(escrowed)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow",
+			fmt.Sprintf("\tstorage account balance: %s\n\tescrowed bids, lots, budgets, schedule balances and deposits: %s\n", balance, escrowed),
+		), broken
+	}
+}
+
+// RewardsInvariant checks that the rewards address holds at least the
+// incentive rebates accrued and not claimed yet.
+func RewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		accrued := sdk.NewCoins()
+		for _, reward := range k.GetAllAuctionReward(ctx) {
+			accrued = accrued.Add(reward.Amount...)
+		}
+
+		balance := k.bankKeeper.SpendableCoins(ctx, AuctionRewardsAddress())
+		broken := !balance.IsAllGTE(accrued)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "rewards",
+			fmt.Sprintf("\trewards account balance: %s\n\taccrued rewards: %s\n", balance, accrued),
+		), broken
+	}
+}
+
+// VoucherInvariant checks that the supply of the vouchers of auction channels
+// equals the remote bids escrowed with them plus the vouchers remote
+// participants hold until an auction result sends them back. Vouchers are
+// minted when a remote bid is received or an auction result bounces, and
+// burned when an auction result is sent, so nothing else can hold them.
+func VoucherInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		port := k.GetPort(ctx)
+		held := sdk.NewCoins()
+		for _, auction := range k.GetAllAuction(ctx) {
+			switch auction.Status {
+			case types.AuctionStatus_AUCTION_STATUS_OPEN, types.AuctionStatus_AUCTION_STATUS_UPCOMING, types.AuctionStatus_AUCTION_STATUS_PAUSED:
+			default:
+				continue
+			}
+			for _, bid := range EscrowedBids(auction) {
+				if escrow := auction.BidEscrow(bid); types.IsVoucherDenom(port, escrow.Denom) {
+					held = held.Add(escrow)
+				}
+			}
+		}
+		for _, bidder := range k.GetAllIBCBidder(ctx) {
+			if bidder.PortId != port {
+				continue
+			}
+			for _, coin := range k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(bidder.Address)) {
+				if types.IsVoucherDenom(port, coin.Denom) {
+					held = held.Add(coin)
+				}
+			}
+		}
+
+		supply := sdk.NewCoins()
+		k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			if types.IsVoucherDenom(port, coin.Denom) {
+				supply = supply.Add(coin)
+			}
+			return false
+		})
+		broken := !supply.Equal(held)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "vouchers",
+			fmt.Sprintf("\tvoucher supply: %s\n\tescrowed remote bids and vouchers of remote participants: %s\n", supply, held),
+		), broken
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 
@@ -15,6 +16,8 @@ import (
 	// storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 )
 
 type (
@@ -24,6 +27,30 @@ type (
 		logger        log.Logger
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
+		// circuitKeeper is optional, when set a tripped MsgPlaceBid circuit
+		// halts bidding module wide.
+		circuitKeeper types.CircuitKeeper
+		// authzKeeper is optional, it is used to reject bids from grantees of
+		// the auction creator and to credit refunds to bid authorizations.
+		authzKeeper types.AuthzKeeper
+		// distrKeeper is optional, without it the proceeds of buyback auctions
+		// are burned even when the params send them to the community pool.
+		distrKeeper types.DistributionKeeper
+		// feegrantKeeper is optional, without it auctions cannot sponsor the
+		// fees of their bids.
+		feegrantKeeper types.FeegrantKeeper
+		// nftKeeper is optional, without it auctions cannot sell NFT lots.
+		nftKeeper types.NFTKeeper
+		// hooks is shared by all copies of the keeper so that hooks set after
+		// the keeper was handed out are seen everywhere.
+		hooks *hooks
+		// ibc holds the IBC keepers, which the app only creates after the
+		// keeper. Like hooks, it is shared by all copies of the keeper.
+		ibc *ibcKeepers
+		// ibcKeeperFn and capabilityScopedFn are nil when the app does not
+		// wire IBC, the auction IBC application is then unavailable.
+		ibcKeeperFn        func() *ibckeeper.Keeper
+		capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority      string
@@ -36,8 +63,15 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	logger log.Logger,
 	authority string,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	circuitKeeper types.CircuitKeeper,
+	authzKeeper types.AuthzKeeper,
+	distrKeeper types.DistributionKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	nftKeeper types.NFTKeeper,
 	storageAddress sdk.AccAddress,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -45,13 +79,22 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		authority:      authority,
-		logger:         logger,
-		bankKeeper:     bankKeeper,
-		accountKeeper:  accountKeeper,
-		storageAddress: storageAddress,
+		cdc:                cdc,
+		storeService:       storeService,
+		authority:          authority,
+		logger:             logger,
+		bankKeeper:         bankKeeper,
+		accountKeeper:      accountKeeper,
+		circuitKeeper:      circuitKeeper,
+		authzKeeper:        authzKeeper,
+		distrKeeper:        distrKeeper,
+		feegrantKeeper:     feegrantKeeper,
+		nftKeeper:          nftKeeper,
+		hooks:              &hooks{},
+		ibc:                &ibcKeepers{},
+		ibcKeeperFn:        ibcKeeperFn,
+		capabilityScopedFn: capabilityScopedFn,
+		storageAddress:     storageAddress,
 	}
 }
 
@@ -67,22 +110,118 @@ func (k Keeper) Logger() log.Logger {
 
 // AppendAuction creates a new auction.
 func (k Keeper) AppendAuction(ctx sdk.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	res, err := k.appendAuction(ctx, msg, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterAuctionCreated(ctx, res.AuctionId); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// appendAuction creates a new auction. Auctions created by a schedule are
+// funded from the schedule balance, which is already held by the storage
+// account, other auctions escrow their lots and budget from the creator.
+// Callers run the AfterAuctionCreated hook once the auction is complete.
+func (k Keeper) appendAuction(ctx sdk.Context, msg *types.MsgCreateAuction, scheduleID string) (*types.MsgCreateAuctionResponse, error) {
+	if err := k.ValidateStart(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
 
 	auctionCount := k.GetAuctionCount(ctx)
 	auctionID := fmt.Sprintf("auction-%d", auctionCount)
 
+	// Fall back to the default duration if none was given
+	duration := msg.Duration
+	if duration == 0 {
+		duration = k.GetParams(ctx).DefaultDuration
+	}
+
 	auction := types.Auction{
 		Creator:     msg.Creator,
 		Item:        msg.Item,
 		StartingBid: msg.StartingBid,
 		Id:          auctionID,
 		Bids:        []*types.Bid{},
+		EndHeight:   ctx.BlockHeight() + int64(duration),
+		Status:      types.AuctionStatus_AUCTION_STATUS_OPEN,
+
+		DeferredPayment: msg.DeferredPayment,
+		AuctionType:     msg.AuctionType,
+		Quantity:        msg.Quantity,
+		PricingRule:     msg.PricingRule,
+		Duration:        duration,
+		RelistPolicy:    msg.RelistPolicy,
+		ScheduleId:      scheduleID,
+
+		ReserveCommitment: msg.ReserveCommitment,
+	}
+	// Scheduled auctions are upcoming until BeginBlock opens them, the end
+	// height of auctions starting at a given time is only known then
+	switch {
+	case msg.StartHeight != 0:
+		auction.Status = types.AuctionStatus_AUCTION_STATUS_UPCOMING
+		auction.StartHeight = msg.StartHeight
+		auction.EndHeight = msg.StartHeight + int64(duration)
+	case msg.StartTime != nil:
+		auction.Status = types.AuctionStatus_AUCTION_STATUS_UPCOMING
+		auction.StartTime = msg.StartTime
+	}
+	if msg.Deposit != nil && msg.Deposit.IsPositive() {
+		auction.Deposit = msg.Deposit
+	}
+	if err := k.fundFeeAllowance(ctx, &auction, msg.FeeAllowance, msg.FeeAllowancePerBidder); err != nil {
+		return nil, err
+	}
+
+	// Number the lots of bundle auctions within the auction
+	for i, lot := range msg.Lots {
+		auction.Lots = append(auction.Lots, &types.AuctionLot{
+			Id:   fmt.Sprintf("lot-%d", i),
+			Item: lot.Item,
+			Coin: lot.Coin,
+		})
+	}
+
+	// Escrow the coin lots of multi-unit and bundle auctions and the budget of
+	// reverse auctions until settlement
+	creatorEscrow := lotCoins(auction)
+	if msg.Lot != nil {
+		auction.Lot = msg.Lot
+		creatorEscrow = creatorEscrow.Add(*msg.Lot)
+	}
+	if auction.IsReverse() {
+		creatorEscrow = creatorEscrow.Add(*msg.StartingBid)
+	}
+	if !creatorEscrow.IsZero() && scheduleID == "" {
+		creatorAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		err = k.bankKeeper.SendCoins(ctx, creatorAddress, k.storageAddress, creatorEscrow)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.NftLot != nil {
+		if err := k.escrowNFTLot(ctx, &auction, msg.NftLot); err != nil {
+			return nil, err
+		}
 	}
 
 	auctionBytes := k.cdc.MustMarshal(&auction)
 	store.Set([]byte(auctionID), auctionBytes)
+	if auction.Status == types.AuctionStatus_AUCTION_STATUS_UPCOMING {
+		k.InsertStartQueue(ctx, auction)
+	} else {
+		k.InsertAuctionEndQueue(ctx, auction)
+	}
 
 	// Update the auction count
 	k.SetAuctionCount(ctx, auctionCount+1)
@@ -108,6 +247,16 @@ func (k Keeper) IsAuctionExists(ctx sdk.Context, auctionID string) bool {
 	return store.Has([]byte(auctionID))
 }
 
+// IsAuctionOpen checks if the auction still accepts bids.
+func (k Keeper) IsAuctionOpen(ctx sdk.Context, auctionID string) bool {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return false
+	}
+
+	return auction.Status == types.AuctionStatus_AUCTION_STATUS_OPEN && ctx.BlockHeight() < auction.EndHeight
+}
+
 // IsValidBid checks if the bid amount is valid for the auction.
 func (k Keeper) IsValidBid(ctx sdk.Context, auctionID string, bidAmount sdk.Coin) bool {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -118,10 +267,33 @@ func (k Keeper) IsValidBid(ctx sdk.Context, auctionID string, bidAmount sdk.Coin
 	var auction types.Auction
 	k.cdc.MustUnmarshal(auctionBytes, &auction)
 
+	// Bids must be placed in the denom of the starting bid
+	if bidAmount.Denom != auction.StartingBid.Denom {
+		return false
+	}
+
+	// Reverse auctions take bids at or below the budget and all previous bids
+	if auction.IsReverse() {
+		if !bidAmount.IsPositive() || auction.StartingBid.IsLT(bidAmount) {
+			return false
+		}
+		for _, bid := range auction.Bids {
+			if bid.BidAmount.IsLT(bidAmount) {
+				return false
+			}
+		}
+		return true
+	}
+
 	// Check if the bid amount is greater than or equal to the starting bid and all previous bids
 	if bidAmount.IsLT(*auction.StartingBid) {
 		return false
 	}
+	// Multi-unit bids compete on price at close, they only need to meet the
+	// starting bid per unit
+	if auction.IsMultiUnit() {
+		return true
+	}
 	for _, bid := range auction.Bids {
 		if bidAmount.IsLT(*bid.BidAmount) {
 			return false
@@ -131,8 +303,9 @@ func (k Keeper) IsValidBid(ctx sdk.Context, auctionID string, bidAmount sdk.Coin
 	return true
 }
 
-// AppendBid appends a bid to the auction.
-func (k Keeper) AppendBid(ctx sdk.Context, auctionID string, bidder string, bidAmount sdk.Coin) (*types.MsgPlaceBidResponse, error) {
+// AppendBid appends a bid to the auction. The quantity is only used by
+// multi-unit auctions.
+func (k Keeper) AppendBid(ctx sdk.Context, auctionID string, bidder string, bidAmount sdk.Coin, quantity uint64) (*types.MsgPlaceBidResponse, error) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
 
@@ -152,26 +325,48 @@ func (k Keeper) AppendBid(ctx sdk.Context, auctionID string, bidder string, bidA
 		Bidder:    bidder,
 		BidAmount: &bidAmount,
 	}
+	if auction.IsMultiUnit() {
+		// Every multi-unit bid stays escrowed until settlement
+		bid.Quantity = quantity
+		previousHighestBid = nil
+	}
 	auction.Bids = append(auction.Bids, bid)
 
 	// Save the updated auction to the store
 	auctionBytes = k.cdc.MustMarshal(&auction)
 	store.Set([]byte(auctionID), auctionBytes)
 
-	// Send coins from bidder to storage account
-	bidderAddress, _ := sdk.AccAddressFromBech32(bidder)
-	err := k.bankKeeper.SendCoins(ctx, bidderAddress, k.storageAddress, sdk.NewCoins(bidAmount))
+	// Bids on deferred-payment auctions are only backed by the bidder deposit,
+	// and sellers in reverse auctions are paid from the escrowed budget
+	if auction.DeferredPayment || auction.IsReverse() {
+		previousHighestBid = nil
+	} else {
+		// Send coins from bidder to storage account
+		bidderAddress, _ := sdk.AccAddressFromBech32(bidder)
+		err := k.bankKeeper.SendCoins(ctx, bidderAddress, k.storageAddress, sdk.NewCoins(auction.BidEscrow(bid)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.Hooks().AfterBidPlaced(ctx, auctionID, bidderAddress, bidAmount); err != nil {
+		return nil, err
+	}
 
 	// Refund the previous highest bidder if there was one
 	if previousHighestBid != nil {
 		previousHighestBidderAddress, _ := sdk.AccAddressFromBech32(previousHighestBid.Bidder)
-		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, previousHighestBidderAddress, sdk.NewCoins(*previousHighestBid.BidAmount))
+		err := k.bankKeeper.SendCoins(ctx, k.storageAddress, previousHighestBidderAddress, sdk.NewCoins(*previousHighestBid.BidAmount))
 		if err != nil {
 			return nil, err
 		}
+		if err := k.afterBidRefunded(ctx, auctionID, previousHighestBidderAddress, *previousHighestBid.BidAmount); err != nil {
+			return nil, err
+		}
 	}
 
 	// Broadcast an event
@@ -189,6 +384,266 @@ func (k Keeper) AppendBid(ctx sdk.Context, auctionID string, bidder string, bidA
 	return &types.MsgPlaceBidResponse{Success: true}, nil
 }
 
+// CancelAuction cancels an open or upcoming auction on behalf of its creator.
+// Only auctions without bids can be cancelled.
+func (k Keeper) CancelAuction(ctx sdk.Context, auctionID string, creator string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Creator != creator {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the creator of auction %s", creator, auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN && auction.Status != types.AuctionStatus_AUCTION_STATUS_UPCOMING {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+	if len(auction.Bids) > 0 {
+		return errorsmod.Wrapf(types.ErrAuctionHasBids, "auction %s has %d bids", auctionID, len(auction.Bids))
+	}
+	if lotBids := k.GetAuctionLotBids(ctx, auctionID); len(lotBids) > 0 {
+		return errorsmod.Wrapf(types.ErrAuctionHasBids, "auction %s has %d lot bids", auctionID, len(lotBids))
+	}
+	if err := k.RefundDeposits(ctx, auctionID); err != nil {
+		return err
+	}
+	if err := k.returnLot(ctx, auction, auction.Quantity); err != nil {
+		return err
+	}
+	if err := k.returnBudget(ctx, auction, *auction.StartingBid); err != nil {
+		return err
+	}
+	if err := k.returnLots(ctx, auction); err != nil {
+		return err
+	}
+	if err := k.returnNFTLot(ctx, &auction); err != nil {
+		return err
+	}
+
+	if auction.Status == types.AuctionStatus_AUCTION_STATUS_UPCOMING {
+		k.RemoveStartQueue(ctx, auction)
+	} else {
+		k.RemoveAuctionEndQueue(ctx, auction)
+	}
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_CANCELLED
+	k.SetAuction(ctx, auction)
+	if err := k.reclaimFeeAllowance(ctx, auction); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cancel_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+		),
+	)
+
+	return k.Hooks().AfterAuctionCancelled(ctx, auctionID)
+}
+
+// AdminCancelAuction voids an open, paused, upcoming or awaiting payment
+// auction on behalf of the module authority, refunds the escrowed bids and the
+// bidder deposits, returns the lots and stores the reason.
+func (k Keeper) AdminCancelAuction(ctx sdk.Context, auctionID string, reason string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	switch auction.Status {
+	case types.AuctionStatus_AUCTION_STATUS_OPEN, types.AuctionStatus_AUCTION_STATUS_PAUSED, types.AuctionStatus_AUCTION_STATUS_UPCOMING,
+		types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT:
+	default:
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	for _, bid := range EscrowedBids(auction) {
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(auction.BidEscrow(bid)))
+		if err != nil {
+			return err
+		}
+		if err := k.afterBidRefunded(ctx, auctionID, bidderAddress, auction.BidEscrow(bid)); err != nil {
+			return err
+		}
+	}
+	if err := k.refundLotBids(ctx, auction); err != nil {
+		return err
+	}
+	if err := k.RefundDeposits(ctx, auctionID); err != nil {
+		return err
+	}
+	if err := k.returnLot(ctx, auction, auction.Quantity); err != nil {
+		return err
+	}
+	if err := k.returnBudget(ctx, auction, *auction.StartingBid); err != nil {
+		return err
+	}
+	if err := k.returnLots(ctx, auction); err != nil {
+		return err
+	}
+	if err := k.returnNFTLot(ctx, &auction); err != nil {
+		return err
+	}
+
+	switch auction.Status {
+	case types.AuctionStatus_AUCTION_STATUS_UPCOMING:
+		k.RemoveStartQueue(ctx, auction)
+	case types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT:
+		// the winner has not paid, nothing of the winning bid is escrowed
+		k.RemovePaymentQueue(ctx, auction)
+	default:
+		k.RemoveAuctionEndQueue(ctx, auction)
+	}
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_CANCELLED
+	auction.PausedAt = 0
+	auction.CancelReason = reason
+	k.SetAuction(ctx, auction)
+	if err := k.releaseBidAuthorizations(ctx, auction, nil); err != nil {
+		return err
+	}
+	if err := k.reclaimFeeAllowance(ctx, auction); err != nil {
+		return err
+	}
+	if err := k.returnToCommunityPool(ctx, auction); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"admin_cancel_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	return k.Hooks().AfterAuctionCancelled(ctx, auctionID)
+}
+
+// SettleAuction closes an auction that reached its end height, pays the
+// escrowed highest bid out to the creator and refunds the bidder deposits.
+// Deferred-payment auctions with bids await payment of the highest bid
+// instead.
+func (k Keeper) SettleAuction(ctx sdk.Context, auctionID string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	// Bidding is over, whatever the outcome
+	if err := k.reclaimFeeAllowance(ctx, auction); err != nil {
+		return err
+	}
+
+	// Unsold auctions are relisted with their lots still in escrow
+	if k.CanRelist(ctx, auction) {
+		return k.relistAuction(ctx, auction)
+	}
+
+	switch {
+	case auction.IsMultiUnit():
+		return k.settleMultiUnit(ctx, auction)
+	case auction.IsReverse():
+		return k.settleReverse(ctx, auction)
+	case auction.IsBundle():
+		return k.settleBundle(ctx, auction)
+	}
+
+	// Deferred-payment auctions are offered to the highest bidder instead, as
+	// long as the bid meets the reserve
+	if auction.DeferredPayment && len(auction.Bids) > 0 && auction.MeetsReserve(*auction.Bids[len(auction.Bids)-1].BidAmount) {
+		k.RemoveAuctionEndQueue(ctx, auction)
+		k.offerAuction(ctx, auction, auction.Bids[len(auction.Bids)-1])
+		return nil
+	}
+
+	winner := ""
+	amount := ""
+	switch {
+	case len(auction.Bids) == 0 || auction.DeferredPayment:
+		// Nothing is escrowed, deferred bids below the reserve are not offered
+	case auction.Winner() == nil:
+		// The highest bid did not meet the reserve, the item goes unsold
+		if err := k.refundReserveNotMet(ctx, auction); err != nil {
+			return err
+		}
+	default:
+		// The last bid is the highest one and the only one still in escrow
+		highestBid := auction.Bids[len(auction.Bids)-1]
+		creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(*highestBid.BidAmount))
+		if err != nil {
+			return err
+		}
+		winner = highestBid.Bidder
+		amount = highestBid.BidAmount.String()
+	}
+	if err := k.RefundDeposits(ctx, auctionID); err != nil {
+		return err
+	}
+	if err := k.settleNFTLot(ctx, &auction); err != nil {
+		return err
+	}
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("winner", winner),
+			sdk.NewAttribute("amount", amount),
+		),
+	)
+
+	if err := k.afterSettlement(ctx, auction); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterAuctionSettled(ctx, auctionID)
+}
+
+// afterSettlement rewards the settlement of a sold auction, releases the escrow
+// recorded against it in bid authorizations, returns the lots and proceeds of
+// remote participants and returns what a treasury auction raised to the
+// community pool.
+func (k Keeper) afterSettlement(ctx sdk.Context, auction types.Auction) error {
+	if err := k.rewardSettlement(ctx, auction); err != nil {
+		return err
+	}
+	if err := k.releaseBidAuthorizations(ctx, auction, auction.Winners()); err != nil {
+		return err
+	}
+	for _, winner := range auction.Winners() {
+		k.returnToIBCBidder(ctx, auction.Id, winner.Bidder, auction.StartingBid.GetDenom(), types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_WON)
+	}
+	k.returnToIBCBidder(ctx, auction.Id, auction.Creator, auction.StartingBid.GetDenom(), types.RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_SOLD)
+	return k.returnToCommunityPool(ctx, auction)
+}
+
+// EscrowedBids returns the bids of an open auction whose funds are held in the
+// storage account. Only the highest bid of a single item auction is escrowed,
+// lower bids were refunded when outbid.
+func EscrowedBids(auction types.Auction) []*types.Bid {
+	switch {
+	case auction.DeferredPayment || auction.IsReverse() || len(auction.Bids) == 0:
+		return nil
+	case auction.IsMultiUnit():
+		return auction.Bids
+	default:
+		return auction.Bids[len(auction.Bids)-1:]
+	}
+}
+
 // GetAuctionCount gets the number of auctions from the store.
 func (k Keeper) GetAuctionCount(ctx sdk.Context) int {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// SetLiquidationHooks sets the hooks notified of liquidation shortfalls. It can
+// only be called once.
+func (k Keeper) SetLiquidationHooks(lh types.LiquidationHooks) {
+	if k.hooks.liquidation != nil {
+		panic("cannot set liquidation hooks twice")
+	}
+	k.hooks.liquidation = lh
+}
+
+// StartLiquidationAuction lets another module liquidate collateral. The lot is
+// escrowed from the beneficiary, typically the module account holding the
+// seized collateral, and sold for the denom of the debt target. At settlement
+// the beneficiary is repaid up to the debt target, the surplus is returned to
+// the owner of the collateral and a shortfall is reported to the liquidation
+// hooks. Unsold units of the lot are returned to the beneficiary. It returns
+// the ID of the new auction.
+//
+// Besides the lot, the debt target and the beneficiary, it takes the owner of
+// the collateral, as the beneficiary is usually a module account that must not
+// keep the surplus of the borrower, and the config of the auction, whose type,
+// quantity, minimum price and duration depend on the calling module.
+func (k Keeper) StartLiquidationAuction(
+	ctx sdk.Context,
+	lot sdk.Coin,
+	debtTarget sdk.Coin,
+	beneficiary sdk.AccAddress,
+	owner sdk.AccAddress,
+	config types.LiquidationConfig,
+) (string, error) {
+	if k.IsModulePaused(ctx) {
+		return "", errorsmod.Wrapf(types.ErrModulePaused, "auction creation is paused")
+	}
+	if !debtTarget.IsValid() || !debtTarget.IsPositive() {
+		return "", errorsmod.Wrapf(types.ErrInvalidLiquidation, "invalid debt target %s", debtTarget)
+	}
+	if owner.Empty() {
+		return "", errorsmod.Wrap(types.ErrInvalidLiquidation, "owner cannot be empty")
+	}
+	if !config.MinPrice.IsValid() || !config.MinPrice.IsPositive() || config.MinPrice.Denom != debtTarget.Denom {
+		return "", errorsmod.Wrapf(types.ErrInvalidLiquidation, "minimum price %s must be a positive amount of %s", config.MinPrice, debtTarget.Denom)
+	}
+
+	quantity := config.Quantity
+	switch config.AuctionType {
+	case types.AuctionType_AUCTION_TYPE_ENGLISH:
+		quantity = 1
+	case types.AuctionType_AUCTION_TYPE_MULTI_UNIT:
+	default:
+		return "", errorsmod.Wrapf(types.ErrInvalidLiquidation, "%s auctions cannot liquidate collateral", config.AuctionType)
+	}
+
+	msg := types.NewMsgCreateAuction(
+		beneficiary.String(),
+		fmt.Sprintf("liquidation %s", lot),
+		config.MinPrice,
+		config.Duration,
+	)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = quantity
+	msg.PricingRule = config.PricingRule
+	msg.Lot = &lot
+	if err := msg.ValidateBasic(); err != nil {
+		return "", errorsmod.Wrap(types.ErrInvalidLiquidation, err.Error())
+	}
+
+	res, err := k.appendAuction(ctx, msg, "")
+	if err != nil {
+		return "", err
+	}
+
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	auction.Liquidation = &types.Liquidation{
+		DebtTarget:  &debtTarget,
+		Beneficiary: beneficiary.String(),
+		Owner:       owner.String(),
+	}
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"start_liquidation_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("lot", lot.String()),
+			sdk.NewAttribute("debt_target", debtTarget.String()),
+			sdk.NewAttribute("beneficiary", beneficiary.String()),
+			sdk.NewAttribute("owner", owner.String()),
+		),
+	)
+
+	if err := k.Hooks().AfterAuctionCreated(ctx, auction.Id); err != nil {
+		return "", err
+	}
+	return auction.Id, nil
+}
+
+// payLiquidation pays the proceeds of a liquidation auction to its beneficiary
+// up to the debt target and the surplus to the owner. It records the repaid
+// amount and the shortfall on the auction and reports the shortfall to the
+// liquidation hooks.
+func (k Keeper) payLiquidation(ctx sdk.Context, auction *types.Auction, proceeds sdk.Coins) error {
+	liquidation := auction.Liquidation
+	denom := liquidation.DebtTarget.Denom
+	raised := proceeds.AmountOf(denom)
+
+	repaid := sdk.NewCoin(denom, math.MinInt(raised, liquidation.DebtTarget.Amount))
+	surplus := sdk.NewCoin(denom, raised.Sub(repaid.Amount))
+	shortfall := liquidation.DebtTarget.Sub(repaid)
+	liquidation.Repaid = &repaid
+	liquidation.Shortfall = &shortfall
+
+	beneficiary, err := sdk.AccAddressFromBech32(liquidation.Beneficiary)
+	if err != nil {
+		return err
+	}
+	if repaid.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, beneficiary, sdk.NewCoins(repaid))
+		if err != nil {
+			return err
+		}
+	}
+	if surplus.IsPositive() {
+		owner, err := sdk.AccAddressFromBech32(liquidation.Owner)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, owner, sdk.NewCoins(surplus))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_liquidation",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("repaid", repaid.String()),
+			sdk.NewAttribute("surplus", surplus.String()),
+			sdk.NewAttribute("shortfall", shortfall.String()),
+		),
+	)
+
+	if shortfall.IsPositive() && k.hooks.liquidation != nil {
+		return k.hooks.liquidation.AfterLiquidationShortfall(ctx, auction.Id, beneficiary, shortfall)
+	}
+
+	return nil
+}
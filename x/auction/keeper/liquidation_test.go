@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+type shortfall struct {
+	auctionID   string
+	beneficiary string
+	amount      sdk.Coin
+}
+
+type mockLiquidationHooks struct {
+	shortfalls []shortfall
+}
+
+func (h *mockLiquidationHooks) AfterLiquidationShortfall(ctx context.Context, auctionID string, beneficiary sdk.AccAddress, amount sdk.Coin) error {
+	h.shortfalls = append(h.shortfalls, shortfall{auctionID: auctionID, beneficiary: beneficiary.String(), amount: amount})
+	return nil
+}
+
+func TestStartLiquidationAuction(t *testing.T) {
+	k, ctx, _ := keepertest.AuctionKeeperWithBank(t)
+	beneficiary := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	owner := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	lot := sdk.NewInt64Coin("atom", 10)
+	debt := sdk.NewInt64Coin("usd", 100)
+	minPrice := sdk.NewInt64Coin("usd", 40)
+	multiUnit := func(quantity uint64) types.LiquidationConfig {
+		return types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_MULTI_UNIT, Quantity: quantity, MinPrice: minPrice, Duration: 5}
+	}
+
+	testCases := []struct {
+		name     string
+		lot      sdk.Coin
+		debt     sdk.Coin
+		owner    sdk.AccAddress
+		config   types.LiquidationConfig
+		quantity uint64
+		expErr   error
+	}{
+		{
+			name:   "no debt",
+			lot:    lot,
+			debt:   sdk.NewInt64Coin("usd", 0),
+			owner:  owner,
+			config: multiUnit(1),
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:   "no owner",
+			lot:    lot,
+			debt:   debt,
+			config: multiUnit(1),
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:   "lot does not split into units",
+			lot:    lot,
+			debt:   debt,
+			owner:  owner,
+			config: multiUnit(3),
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:   "no minimum price",
+			lot:    lot,
+			debt:   debt,
+			owner:  owner,
+			config: types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_MULTI_UNIT, Quantity: 2},
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:   "minimum price in another denom",
+			lot:    lot,
+			debt:   debt,
+			owner:  owner,
+			config: types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_MULTI_UNIT, Quantity: 2, MinPrice: sdk.NewInt64Coin("atom", 1)},
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:   "reverse auction",
+			lot:    lot,
+			debt:   debt,
+			owner:  owner,
+			config: types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_REVERSE, MinPrice: minPrice},
+			expErr: types.ErrInvalidLiquidation,
+		},
+		{
+			name:     "multi-unit",
+			lot:      lot,
+			debt:     debt,
+			owner:    owner,
+			config:   multiUnit(2),
+			quantity: 2,
+		},
+		{
+			name:     "english",
+			lot:      lot,
+			debt:     debt,
+			owner:    owner,
+			config:   types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_ENGLISH, Quantity: 2, MinPrice: minPrice},
+			quantity: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			auctionID, err := k.StartLiquidationAuction(ctx, tc.lot, tc.debt, beneficiary, tc.owner, tc.config)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			auction, found := k.GetAuction(ctx, auctionID)
+			require.True(t, found)
+			require.True(t, auction.IsLiquidation())
+			require.Equal(t, beneficiary.String(), auction.Creator)
+			require.Equal(t, tc.quantity, auction.Quantity)
+			require.Equal(t, minPrice, *auction.StartingBid)
+			require.Equal(t, debt, *auction.Liquidation.DebtTarget)
+		})
+	}
+}
+
+func TestLiquidationSurplus(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	hooks := &mockLiquidationHooks{}
+	k.SetLiquidationHooks(hooks)
+	ctx = ctx.WithBlockHeight(1)
+	beneficiary := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	owner := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	auctionID, err := k.StartLiquidationAuction(ctx, sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("usd", 100), beneficiary, owner, types.LiquidationConfig{AuctionType: types.AuctionType_AUCTION_TYPE_MULTI_UNIT, Quantity: 2, MinPrice: sdk.NewInt64Coin("usd", 50), Duration: 5})
+	require.NoError(t, err)
+	storage := bank.Transfers[0].To
+
+	for _, bidder := range []string{alice, bob} {
+		msg := types.NewMsgPlaceBid(bidder, auctionID, sdk.NewInt64Coin("usd", 60))
+		msg.Quantity = 1
+		_, err = ms.PlaceBid(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// 120usd raised against a debt of 100usd
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(6)
+	k.EndBlocker(ctx)
+	require.Contains(t, bank.Transfers[transfers:], keepertest.Transfer{From: storage, To: beneficiary.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("usd", 100))})
+	require.Contains(t, bank.Transfers[transfers:], keepertest.Transfer{From: storage, To: owner.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("usd", 20))})
+	require.Empty(t, hooks.shortfalls)
+
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, sdk.NewInt64Coin("usd", 100), *auction.Liquidation.Repaid)
+	require.True(t, auction.Liquidation.Shortfall.IsZero())
+}
+
+func TestLiquidationShortfall(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	hooks := &mockLiquidationHooks{}
+	k.SetLiquidationHooks(hooks)
+	require.Panics(t, func() { k.SetLiquidationHooks(hooks) })
+	ctx = ctx.WithBlockHeight(1)
+	beneficiary := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	owner := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	auctionID, err := k.StartLiquidationAuction(ctx, sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("usd", 100), beneficiary, owner, types.LiquidationConfig{MinPrice: sdk.NewInt64Coin("usd", 50), Duration: 5})
+	require.NoError(t, err)
+	storage := bank.Transfers[0].To
+
+	// the collateral is not sold below its minimum price
+	msg := types.NewMsgPlaceBid(sample.AccAddress(), auctionID, sdk.NewInt64Coin("usd", 1))
+	msg.Quantity = 1
+	_, err = ms.PlaceBid(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidBidAmount)
+
+	msg = types.NewMsgPlaceBid(sample.AccAddress(), auctionID, sdk.NewInt64Coin("usd", 70))
+	msg.Quantity = 1
+	_, err = ms.PlaceBid(ctx, msg)
+	require.NoError(t, err)
+
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(6)
+	k.EndBlocker(ctx)
+	require.Contains(t, bank.Transfers[transfers:], keepertest.Transfer{From: storage, To: beneficiary.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("usd", 70))})
+	for _, transfer := range bank.Transfers[transfers:] {
+		require.NotEqual(t, owner.String(), transfer.To)
+	}
+	require.Equal(t, []shortfall{
+		{auctionID: auctionID, beneficiary: beneficiary.String(), amount: sdk.NewInt64Coin("usd", 30)},
+	}, hooks.shortfalls)
+}
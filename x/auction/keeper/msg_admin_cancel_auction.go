@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+func (k msgServer) AdminCancelAuction(goCtx context.Context, req *types.MsgAdminCancelAuction) (*types.MsgAdminCancelAuctionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AdminCancelAuction(ctx, req.AuctionId, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAdminCancelAuctionResponse{}, nil
+}
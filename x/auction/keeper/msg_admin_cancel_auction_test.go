@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestMsgAdminCancelAuction(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	bidder := sample.AccAddress()
+
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgAdminCancelAuction
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  &types.MsgAdminCancelAuction{Authority: sample.AccAddress(), AuctionId: res.AuctionId, Reason: "scam"},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "unknown auction",
+			input:  &types.MsgAdminCancelAuction{Authority: k.GetAuthority(), AuctionId: "auction-42", Reason: "scam"},
+			expErr: types.ErrInvalidAuctionId,
+		},
+		{
+			name:  "all good",
+			input: &types.MsgAdminCancelAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId, Reason: "scam"},
+		},
+		{
+			name:   "already cancelled",
+			input:  &types.MsgAdminCancelAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId, Reason: "scam"},
+			expErr: types.ErrAuctionNotOpen,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.AdminCancelAuction(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_CANCELLED, auction.Status)
+	require.Equal(t, "scam", auction.CancelReason)
+	require.Empty(t, k.GetEndedAuctionIDs(ctx, auction.EndHeight))
+
+	// the escrowed bid went back to the bidder
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, bidder, refund.To)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 20)), refund.Amount)
+}
+
+func TestMsgAdminCancelAuctionAwaitingPayment(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	bidder := sample.AccAddress()
+	deposit := sdk.NewInt64Coin("token", 5)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	msg.DeferredPayment = true
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	_, err = ms.RegisterBidder(ctx, types.NewMsgRegisterBidder(bidder, res.AuctionId))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT, auction.Status)
+
+	_, err = ms.AdminCancelAuction(ctx, &types.MsgAdminCancelAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId, Reason: "scam"})
+	require.NoError(t, err)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_CANCELLED, auction.Status)
+	require.Empty(t, k.GetExpiredPaymentAuctionIDs(ctx, auction.PaymentDeadline))
+
+	// the deposit of the winner went back instead of being forfeited
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, bidder, refund.To)
+	require.Equal(t, sdk.NewCoins(deposit), refund.Amount)
+	_, found := k.GetBidderRegistration(ctx, res.AuctionId, bidder)
+	require.False(t, found)
+
+	// the winner cannot pay for it anymore
+	_, err = ms.CompletePurchase(ctx, types.NewMsgCompletePurchase(bidder, res.AuctionId))
+	require.ErrorIs(t, err, types.ErrNotAwaitingPayment)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// CreateAuctionSchedule handles the creation of a recurring auction schedule.
+func (m msgServer) CreateAuctionSchedule(goCtx context.Context, msg *types.MsgCreateAuctionSchedule) (*types.MsgCreateAuctionScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.Keeper.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrModulePaused, "auction creation is paused")
+	}
+
+	scheduleID, err := m.Keeper.CreateAuctionSchedule(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAuctionScheduleResponse{ScheduleId: scheduleID}, nil
+}
+
+// CancelAuctionSchedule handles the cancellation of a schedule by its creator.
+func (m msgServer) CancelAuctionSchedule(goCtx context.Context, msg *types.MsgCancelAuctionSchedule) (*types.MsgCancelAuctionScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelAuctionSchedule(ctx, msg.ScheduleId, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAuctionScheduleResponse{}, nil
+}
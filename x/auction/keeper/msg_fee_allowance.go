@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// GrantBidFeeAllowance handles the allowances the creator of an auction grants
+// to bidders before their first bid.
+func (m msgServer) GrantBidFeeAllowance(goCtx context.Context, msg *types.MsgGrantBidFeeAllowance) (*types.MsgGrantBidFeeAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.GrantBidFeeAllowances(ctx, msg.AuctionId, msg.Creator, msg.Bidders); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantBidFeeAllowanceResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+func (k msgServer) FundIncentivePool(goCtx context.Context, req *types.MsgFundIncentivePool) (*types.MsgFundIncentivePoolResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.FundIncentivePool(ctx, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundIncentivePoolResponse{}, nil
+}
+
+// ClaimAuctionRewards handles the payment of accrued incentive rebates.
+func (m msgServer) ClaimAuctionRewards(goCtx context.Context, msg *types.MsgClaimAuctionRewards) (*types.MsgClaimAuctionRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, err := m.Keeper.ClaimAuctionRewards(ctx, msg.Claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAuctionRewardsResponse{Amount: amount}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// ClaimNFTLot handles the claims of winners whose NFT lot could not be
+// delivered over ICS-721.
+func (m msgServer) ClaimNFTLot(goCtx context.Context, msg *types.MsgClaimNFTLot) (*types.MsgClaimNFTLotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClaimNFTLot(ctx, msg.AuctionId, msg.Claimant); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimNFTLotResponse{}, nil
+}
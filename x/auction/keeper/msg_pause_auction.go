@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+func (k msgServer) PauseAuction(goCtx context.Context, req *types.MsgPauseAuction) (*types.MsgPauseAuctionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.AuctionId != "" {
+		if err := k.Keeper.PauseAuction(ctx, req.AuctionId); err != nil {
+			return nil, err
+		}
+		return &types.MsgPauseAuctionResponse{}, nil
+	}
+
+	if k.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrap(types.ErrModulePaused, "module is already paused")
+	}
+	k.SetModulePaused(ctx, true)
+
+	return &types.MsgPauseAuctionResponse{}, nil
+}
+
+func (k msgServer) ResumeAuction(goCtx context.Context, req *types.MsgResumeAuction) (*types.MsgResumeAuctionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.AuctionId != "" {
+		if err := k.Keeper.ResumeAuction(ctx, req.AuctionId); err != nil {
+			return nil, err
+		}
+		return &types.MsgResumeAuctionResponse{}, nil
+	}
+
+	if !k.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrap(types.ErrModuleNotPaused, "module is not paused")
+	}
+	// end heights are shifted by EndBlocker once bidding is no longer halted
+	k.SetModulePaused(ctx, false)
+
+	return &types.MsgResumeAuctionResponse{}, nil
+}
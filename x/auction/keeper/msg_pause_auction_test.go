@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestMsgPauseAuction(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	_, err = ms.PauseAuction(sdkCtx, &types.MsgPauseAuction{Authority: sample.AccAddress(), AuctionId: res.AuctionId})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.PauseAuction(sdkCtx, &types.MsgPauseAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId})
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrAuctionPaused)
+
+	// a paused auction does not end
+	sdkCtx = sdkCtx.WithBlockHeight(15)
+	k.EndBlocker(sdkCtx)
+	auction, _ := k.GetAuction(sdkCtx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_PAUSED, auction.Status)
+
+	_, err = ms.ResumeAuction(sdkCtx, &types.MsgResumeAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId})
+	require.NoError(t, err)
+	auction, _ = k.GetAuction(sdkCtx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+	require.Equal(t, int64(25), auction.EndHeight)
+
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	_, err = ms.ResumeAuction(sdkCtx, &types.MsgResumeAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId})
+	require.ErrorIs(t, err, types.ErrAuctionNotOpen)
+}
+
+func TestMsgPauseModule(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	_, err = ms.ResumeAuction(sdkCtx, &types.MsgResumeAuction{Authority: k.GetAuthority()})
+	require.ErrorIs(t, err, types.ErrModuleNotPaused)
+
+	_, err = ms.PauseAuction(sdkCtx, &types.MsgPauseAuction{Authority: k.GetAuthority()})
+	require.NoError(t, err)
+	k.EndBlocker(sdkCtx)
+
+	_, err = ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.ErrorIs(t, err, types.ErrModulePaused)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrModulePaused)
+
+	// auctions do not end while the module is paused
+	sdkCtx = sdkCtx.WithBlockHeight(20)
+	k.EndBlocker(sdkCtx)
+	auction, _ := k.GetAuction(sdkCtx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+
+	_, err = ms.ResumeAuction(sdkCtx, &types.MsgResumeAuction{Authority: k.GetAuthority()})
+	require.NoError(t, err)
+	k.EndBlocker(sdkCtx)
+	auction, _ = k.GetAuction(sdkCtx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+	require.Equal(t, int64(30), auction.EndHeight)
+	require.Zero(t, k.GetHaltedSince(sdkCtx))
+}
+
+func TestPlaceBidCircuitTripped(t *testing.T) {
+	k, ctx, circuitKeeper := keepertest.AuctionKeeperWithCircuit(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	// the msg server rejects the bids even when they do not come from a
+	// transaction checked by the circuit breaker of baseapp
+	circuitKeeper.Disabled = []string{sdk.MsgTypeURL(&types.MsgPlaceBid{})}
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrModulePaused)
+
+	circuitKeeper.Disabled = nil
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(sample.AccAddress(), res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+}
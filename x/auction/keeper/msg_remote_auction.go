@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"auction/x/auction/types"
+)
+
+// SendCreateRemoteAuction lists an auction on the chain at the other end of an
+// auction channel.
+func (m msgServer) SendCreateRemoteAuction(goCtx context.Context, msg *types.MsgSendCreateRemoteAuction) (*types.MsgSendCreateRemoteAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packet := types.CreateRemoteAuctionPacketData{
+		Creator:     msg.Creator,
+		Item:        msg.Item,
+		StartingBid: msg.StartingBid,
+		Duration:    msg.Duration,
+	}
+	sequence, err := m.Keeper.TransmitCreateRemoteAuctionPacket(ctx, packet, msg.Port, msg.ChannelId, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendCreateRemoteAuctionResponse{Sequence: sequence}, nil
+}
+
+// SendPlaceRemoteBid escrows a bid and places it on an auction of the chain at
+// the other end of an auction channel. The escrow is refunded when the bid is
+// rejected or times out, and released by the results of the auction.
+func (m msgServer) SendPlaceRemoteBid(goCtx context.Context, msg *types.MsgSendPlaceRemoteBid) (*types.MsgSendPlaceRemoteBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.Keeper.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrModulePaused, "bidding is paused")
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	escrow := RemoteEscrowAddress(msg.Port, msg.ChannelId)
+	if err := m.Keeper.bankKeeper.SendCoins(ctx, bidder, escrow, sdk.NewCoins(msg.BidAmount)); err != nil {
+		return nil, err
+	}
+
+	packet := types.PlaceRemoteBidPacketData{
+		Bidder:    msg.Bidder,
+		AuctionId: msg.AuctionId,
+		BidAmount: msg.BidAmount,
+	}
+	sequence, err := m.Keeper.TransmitPlaceRemoteBidPacket(ctx, packet, msg.Port, msg.ChannelId, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendPlaceRemoteBidResponse{Sequence: sequence}, nil
+}
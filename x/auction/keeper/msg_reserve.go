@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// RevealReserve handles the reveals of hidden reserve prices by auction
+// creators.
+func (m msgServer) RevealReserve(goCtx context.Context, msg *types.MsgRevealReserve) (*types.MsgRevealReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RevealReserve(ctx, msg.AuctionId, msg.Creator, msg.Reserve, msg.Salt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealReserveResponse{}, nil
+}
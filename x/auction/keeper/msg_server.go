@@ -6,8 +6,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type msgServer struct {
@@ -23,9 +21,14 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 // CreateAuction handles the creation of an auction.
 func (m msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.Keeper.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrModulePaused, "auction creation is paused")
+	}
+
 	auctionID, err := m.Keeper.AppendAuction(ctx, msg)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid request")
+		return nil, err
 	}
 
 	return &types.MsgCreateAuctionResponse{AuctionId: auctionID.AuctionId}, nil
@@ -35,15 +38,122 @@ func (m msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuct
 func (m msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !m.Keeper.IsAuctionExists(ctx, msg.AuctionId) {
+	auction, found := m.Keeper.GetAuction(ctx, msg.AuctionId)
+	if !found {
 		return nil, errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction does not exist")
 	}
 
+	// bids placed by the IBC middleware and the auction IBC application do
+	// not go through the circuit breaker of baseapp, check it here as well
+	if m.Keeper.IsBiddingHalted(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrModulePaused, "bidding is paused")
+	}
+
+	if auction.Status == types.AuctionStatus_AUCTION_STATUS_PAUSED {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPaused, "bidding on auction %s is paused", msg.AuctionId)
+	}
+
+	if err := m.Keeper.ValidateBidder(ctx, auction, msg.Bidder); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.ValidateBidAuthorizations(ctx, auction, msg.Bidder); err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.IsAuctionOpen(ctx, msg.AuctionId) {
+		return nil, errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction is not open")
+	}
+
+	if auction.RequiresDeposit() {
+		if _, found := m.Keeper.GetBidderRegistration(ctx, msg.AuctionId, msg.Bidder); !found {
+			return nil, errorsmod.Wrapf(types.ErrBidderNotRegistered, "auction %s requires a deposit of %s", msg.AuctionId, auction.Deposit)
+		}
+	}
+
+	// only multi-unit bids escrow their quantity, a stray quantity would make
+	// bid authorizations take more than the escrow
+	if !auction.IsMultiUnit() && msg.Quantity != 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBidQuantity, "auction %s is not a multi-unit auction", msg.AuctionId)
+	}
+
+	if err := m.Keeper.ValidateNFTDestination(ctx, auction, msg.NftDestination); err != nil {
+		return nil, err
+	}
+
+	if msg.LotId != "" {
+		if !auction.IsBundle() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidLot, "auction %s has no lots", msg.AuctionId)
+		}
+		if !m.Keeper.IsValidLotBid(ctx, auction, msg.LotId, *msg.BidAmount) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBidAmount, "invalid bid amount")
+		}
+		if err := m.Keeper.PlaceLotBid(ctx, auction, msg.LotId, msg.Bidder, *msg.BidAmount); err != nil {
+			return nil, err
+		}
+		if err := m.Keeper.GrantBidFeeAllowance(ctx, msg.AuctionId, msg.Bidder); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgPlaceBidResponse{Success: true}, nil
+	}
+
 	if !m.Keeper.IsValidBid(ctx, msg.AuctionId, *msg.BidAmount) {
 		return nil, errorsmod.Wrapf(types.ErrInvalidBidAmount, "invalid bid amount")
 	}
 
-	m.Keeper.AppendBid(ctx, msg.AuctionId, msg.Bidder, *msg.BidAmount)
+	if auction.IsMultiUnit() && (msg.Quantity == 0 || msg.Quantity > auction.Quantity) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBidQuantity, "quantity must be between 1 and %d", auction.Quantity)
+	}
+
+	if _, err := m.Keeper.AppendBid(ctx, msg.AuctionId, msg.Bidder, *msg.BidAmount, msg.Quantity); err != nil {
+		return nil, err
+	}
+	if msg.NftDestination != nil {
+		m.Keeper.setBidNFTDestination(ctx, msg.AuctionId, msg.NftDestination)
+	}
+	if err := m.Keeper.GrantBidFeeAllowance(ctx, msg.AuctionId, msg.Bidder); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgPlaceBidResponse{Success: true}, nil
 }
+
+// CancelAuction handles the cancellation of an auction by its creator.
+func (m msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelAuction(ctx, msg.AuctionId, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAuctionResponse{}, nil
+}
+
+// RegisterBidder handles the deposit a bidder locks to participate in an
+// auction.
+func (m msgServer) RegisterBidder(goCtx context.Context, msg *types.MsgRegisterBidder) (*types.MsgRegisterBidderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.Keeper.IsModulePaused(ctx) {
+		return nil, errorsmod.Wrapf(types.ErrModulePaused, "bidder registration is paused")
+	}
+
+	if err := m.Keeper.RegisterBidder(ctx, msg.AuctionId, msg.Bidder); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterBidderResponse{}, nil
+}
+
+// CompletePurchase handles the payment of a deferred-payment auction by its
+// winning bidder.
+func (m msgServer) CompletePurchase(goCtx context.Context, msg *types.MsgCompletePurchase) (*types.MsgCompletePurchaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CompletePurchase(ctx, msg.AuctionId, msg.Buyer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCompletePurchaseResponse{}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+func (k msgServer) CreateTreasuryAuction(goCtx context.Context, req *types.MsgCreateTreasuryAuction) (*types.MsgCreateTreasuryAuctionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	auctionID, err := k.Keeper.CreateTreasuryAuction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTreasuryAuctionResponse{AuctionId: auctionID}, nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// AllocateUnits fills bids in descending price order, earlier bids first on
+// equal prices, until quantity units are allocated. The last bid filled may be
+// filled partially. It sets Filled on every bid and returns the lowest winning
+// price, nil when no unit was allocated.
+func AllocateUnits(bids []*types.Bid, quantity uint64) *sdk.Coin {
+	order := make([]*types.Bid, len(bids))
+	copy(order, bids)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].BidAmount.Amount.GT(order[j].BidAmount.Amount)
+	})
+
+	var clearingPrice *sdk.Coin
+	remaining := quantity
+	for _, bid := range order {
+		bid.Filled = 0
+		if remaining == 0 {
+			continue
+		}
+
+		bid.Filled = bid.Quantity
+		if bid.Filled > remaining {
+			bid.Filled = remaining
+		}
+		remaining -= bid.Filled
+		clearingPrice = bid.BidAmount
+	}
+
+	return clearingPrice
+}
+
+// settleMultiUnit allocates the units of a multi-unit auction that reached its
+// end height. Every winner pays the clearing price, or its own bid price under
+// the pay-as-bid rule, for the units it was allocated and receives their share
+// of the lot. The rest of the escrowed bids is refunded and unsold units are
+// returned to the creator.
+func (k Keeper) settleMultiUnit(ctx sdk.Context, auction types.Auction) error {
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+
+	clearingPrice := AllocateUnits(auction.Bids, auction.Quantity)
+	proceeds := sdk.NewCoins()
+	sold := uint64(0)
+	for _, bid := range auction.Bids {
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return err
+		}
+
+		escrow := auction.BidEscrow(bid)
+		if bid.Filled > 0 {
+			price := *clearingPrice
+			if auction.PricingRule == types.PricingRule_PRICING_RULE_PAY_AS_BID {
+				price = *bid.BidAmount
+			}
+			paid := sdk.NewCoin(price.Denom, price.Amount.Mul(math.NewIntFromUint64(bid.Filled)))
+			proceeds = proceeds.Add(paid)
+			escrow = escrow.Sub(paid)
+			sold += bid.Filled
+
+			if auction.Lot != nil {
+				unitLot := auction.UnitLot()
+				delivery := sdk.NewCoin(unitLot.Denom, unitLot.Amount.Mul(math.NewIntFromUint64(bid.Filled)))
+				err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(delivery))
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"fill_bid",
+					sdk.NewAttribute("auction_id", auction.Id),
+					sdk.NewAttribute("bidder", bid.Bidder),
+					sdk.NewAttribute("quantity", strconv.FormatUint(bid.Quantity, 10)),
+					sdk.NewAttribute("filled", strconv.FormatUint(bid.Filled, 10)),
+					sdk.NewAttribute("unit_price", price.String()),
+					sdk.NewAttribute("paid", paid.String()),
+				),
+			)
+		}
+		if escrow.IsPositive() {
+			err = k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(escrow))
+			if err != nil {
+				return err
+			}
+			if err := k.afterBidRefunded(ctx, auction.Id, bidderAddress, escrow); err != nil {
+				return err
+			}
+		}
+	}
+
+	if auction.IsLiquidation() {
+		if err := k.payLiquidation(ctx, &auction, proceeds); err != nil {
+			return err
+		}
+	} else if !proceeds.IsZero() {
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, proceeds)
+		if err != nil {
+			return err
+		}
+	}
+	if err := k.returnLot(ctx, auction, auction.Quantity-sold); err != nil {
+		return err
+	}
+	if err := k.RefundDeposits(ctx, auction.Id); err != nil {
+		return err
+	}
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	auction.ClearingPrice = clearingPrice
+	k.SetAuction(ctx, auction)
+
+	price := ""
+	if clearingPrice != nil {
+		price = clearingPrice.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("clearing_price", price),
+			sdk.NewAttribute("pricing_rule", auction.PricingRule.String()),
+			sdk.NewAttribute("units_sold", strconv.FormatUint(sold, 10)),
+		),
+	)
+
+	if err := k.afterSettlement(ctx, auction); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterAuctionSettled(ctx, auction.Id)
+}
+
+// returnLot returns the share of the escrowed lot of the given number of units
+// to the creator.
+func (k Keeper) returnLot(ctx sdk.Context, auction types.Auction, units uint64) error {
+	if auction.Lot == nil || units == 0 {
+		return nil
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	unitLot := auction.UnitLot()
+	lot := sdk.NewCoin(unitLot.Denom, unitLot.Amount.Mul(math.NewIntFromUint64(units)))
+
+	return k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(lot))
+}
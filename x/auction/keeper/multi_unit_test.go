@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestAllocateUnits(t *testing.T) {
+	bid := func(price int64, quantity uint64) *types.Bid {
+		amount := sdk.NewInt64Coin("token", price)
+		return &types.Bid{BidAmount: &amount, Quantity: quantity}
+	}
+
+	testCases := []struct {
+		name     string
+		bids     []*types.Bid
+		quantity uint64
+		expFills []uint64
+		expPrice int64
+	}{
+		{
+			name:     "no bids",
+			quantity: 10,
+		},
+		{
+			name:     "undersubscribed",
+			bids:     []*types.Bid{bid(5, 3), bid(7, 2)},
+			quantity: 10,
+			expFills: []uint64{3, 2},
+			expPrice: 5,
+		},
+		{
+			name:     "partial fill of the lowest winning bid",
+			bids:     []*types.Bid{bid(5, 4), bid(9, 3), bid(7, 4), bid(3, 2)},
+			quantity: 8,
+			expFills: []uint64{1, 3, 4, 0},
+			expPrice: 5,
+		},
+		{
+			name:     "earlier bid wins a tie",
+			bids:     []*types.Bid{bid(6, 2), bid(6, 2), bid(8, 1)},
+			quantity: 2,
+			expFills: []uint64{1, 0, 1},
+			expPrice: 6,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price := keeper.AllocateUnits(tc.bids, tc.quantity)
+			if tc.expPrice == 0 {
+				require.Nil(t, price)
+				return
+			}
+			require.Equal(t, sdk.NewInt64Coin("token", tc.expPrice), *price)
+			for i, bid := range tc.bids {
+				require.Equal(t, tc.expFills[i], bid.Filled, "bid %d", i)
+			}
+		})
+	}
+}
+
+func TestMultiUnitAuction(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	lot := sdk.NewInt64Coin("launch", 1000)
+
+	msg := types.NewMsgCreateAuction(creator, "launch tokens", sdk.NewInt64Coin("token", 2), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = 10
+	msg.Lot = &lot
+	require.NoError(t, msg.ValidateBasic())
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, keepertest.Transfer{From: creator, To: bank.Transfers[0].To, Amount: sdk.NewCoins(lot)}, bank.Transfers[0])
+	storage := bank.Transfers[0].To
+
+	bid := types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 5))
+	_, err = ms.PlaceBid(ctx, bid)
+	require.ErrorIs(t, err, types.ErrInvalidBidQuantity)
+	bid.Quantity = 11
+	_, err = ms.PlaceBid(ctx, bid)
+	require.ErrorIs(t, err, types.ErrInvalidBidQuantity)
+
+	// alice wants 6 units at 5, bob 8 units at 3, bob is partially filled
+	bid.Quantity = 6
+	_, err = ms.PlaceBid(ctx, bid)
+	require.NoError(t, err)
+	bid = types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 3))
+	bid.Quantity = 8
+	_, err = ms.PlaceBid(ctx, bid)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 24)), bank.Transfers[len(bank.Transfers)-1].Amount)
+
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("launch", 600))},
+		{From: storage, To: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 12))},
+		{From: storage, To: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("launch", 400))},
+		{From: storage, To: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 12))},
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 30))},
+	}, bank.Transfers[transfers:])
+
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Equal(t, sdk.NewInt64Coin("token", 3), *auction.ClearingPrice)
+	require.Len(t, auction.Winners(), 2)
+}
+
+func TestMultiUnitAuctionPayAsBid(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	msg := types.NewMsgCreateAuction(creator, "bonds", sdk.NewInt64Coin("token", 2), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = 10
+	msg.PricingRule = types.PricingRule_PRICING_RULE_PAY_AS_BID
+	require.NoError(t, msg.ValidateBasic())
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	bid := types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 5))
+	bid.Quantity = 6
+	_, err = ms.PlaceBid(ctx, bid)
+	require.NoError(t, err)
+	bid = types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 3))
+	bid.Quantity = 8
+	_, err = ms.PlaceBid(ctx, bid)
+	require.NoError(t, err)
+	storage := bank.Transfers[0].To
+
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	k.EndBlocker(ctx)
+
+	// alice pays 5 per unit for 6 units, bob pays 3 per unit for the 4 left
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 12))},
+		{From: storage, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 42))},
+	}, bank.Transfers[transfers:])
+
+	var fills []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "fill_bid" {
+			fills = append(fills, event)
+		}
+	}
+	require.Len(t, fills, 2)
+	for i, exp := range []struct{ bidder, filled, paid string }{
+		{alice, "6", "30token"},
+		{bob, "4", "12token"},
+	} {
+		attrs := make(map[string]string)
+		for _, attr := range fills[i].Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		require.Equal(t, exp.bidder, attrs["bidder"])
+		require.Equal(t, exp.filled, attrs["filled"])
+		require.Equal(t, exp.paid, attrs["paid"])
+	}
+}
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"auction/x/auction/types"
+)
+
+// NFTEscrowAddress returns the account escrowing the NFT lots sent over an
+// ICS-721 channel of the auction port. NFTs stay there while they are on the
+// other chain and are released when they come back over the channel.
+func NFTEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s/%s", types.NFTEscrowName, portID, channelID))
+}
+
+// IsNFTTransferChannel reports whether a channel of the port delivers NFT lots
+// over ICS-721 rather than carrying auction packets.
+func (k Keeper) IsNFTTransferChannel(ctx sdk.Context, portID, channelID string) bool {
+	if k.ibcKeeperFn == nil {
+		return false
+	}
+	channel, found := k.ibcKeeperFn().ChannelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.Version == types.NFTTransferVersion
+}
+
+// transferNFT moves an NFT between accounts through the NFT module.
+func (k Keeper) transferNFT(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if k.nftKeeper == nil {
+		return errorsmod.Wrap(types.ErrNoNFT, "the NFT module is not wired in the app")
+	}
+	return k.nftKeeper.Transfer(ctx, classID, nftID, receiver)
+}
+
+// escrowNFTLot moves the NFT a creator sells from the creator to the storage
+// account and records it as the NFT lot of the auction.
+func (k Keeper) escrowNFTLot(ctx sdk.Context, auction *types.Auction, lot *types.NFTLot) error {
+	if k.nftKeeper == nil {
+		return errorsmod.Wrap(types.ErrNoNFT, "the NFT module is not wired in the app")
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	if owner := k.nftKeeper.GetOwner(ctx, lot.ClassId, lot.Id); !owner.Equals(creatorAddress) {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "%s does not own NFT %s/%s", auction.Creator, lot.ClassId, lot.Id)
+	}
+	if err := k.transferNFT(ctx, lot.ClassId, lot.Id, k.storageAddress); err != nil {
+		return err
+	}
+
+	auction.NftLot = &types.NFTLot{
+		ClassId: lot.ClassId,
+		Id:      lot.Id,
+		Status:  types.NFTLotStatus_NFT_LOT_STATUS_ESCROWED,
+	}
+	return nil
+}
+
+// returnNFTLot returns the escrowed NFT lot of an auction that did not sell to
+// its creator.
+func (k Keeper) returnNFTLot(ctx sdk.Context, auction *types.Auction) error {
+	if auction.NftLot == nil || auction.NftLot.Status != types.NFTLotStatus_NFT_LOT_STATUS_ESCROWED {
+		return nil
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.transferNFT(ctx, auction.NftLot.ClassId, auction.NftLot.Id, creatorAddress); err != nil {
+		return err
+	}
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_RETURNED
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"return_nft_lot",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("class_id", auction.NftLot.ClassId),
+			sdk.NewAttribute("nft_id", auction.NftLot.Id),
+			sdk.NewAttribute("creator", auction.Creator),
+		),
+	)
+
+	return nil
+}
+
+// settleNFTLot delivers the escrowed NFT lot of a settled auction to its
+// winner, or returns it to the creator when the auction did not sell.
+//
+// Winners without an NFT destination receive the NFT on this chain. The others
+// have it sent over the ICS-721 channel they chose when bidding. A transfer
+// that cannot be sent leaves the NFT claimable by the winner instead of failing
+// the settlement.
+func (k Keeper) settleNFTLot(ctx sdk.Context, auction *types.Auction) error {
+	if auction.NftLot == nil {
+		return nil
+	}
+	winner := auction.Winner()
+	if winner == nil {
+		return k.returnNFTLot(ctx, auction)
+	}
+
+	if winner.NftDestination == nil {
+		winnerAddress, err := sdk.AccAddressFromBech32(winner.Bidder)
+		if err != nil {
+			return err
+		}
+		if err := k.transferNFT(ctx, auction.NftLot.ClassId, auction.NftLot.Id, winnerAddress); err != nil {
+			return err
+		}
+		auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED
+		k.emitNFTLotEvent(ctx, "deliver_nft_lot", *auction, winner.Bidder)
+		return nil
+	}
+
+	if err := k.sendNFTLot(ctx, *auction, winner); err != nil {
+		k.Logger().Error("failed to send NFT lot", "auction_id", auction.Id, "winner", winner.Bidder, "error", err)
+		auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE
+		k.emitNFTLotEvent(ctx, "nft_lot_claimable", *auction, winner.Bidder)
+		return nil
+	}
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT
+	k.emitNFTLotEvent(ctx, "send_nft_lot", *auction, winner.Bidder)
+	return nil
+}
+
+// sendNFTLot escrows the NFT lot of an auction for the channel the winner chose
+// and sends it to the receiver at the other end. Nothing is written when the
+// transfer cannot be sent.
+func (k Keeper) sendNFTLot(ctx sdk.Context, auction types.Auction, winner *types.Bid) error {
+	portID := k.GetPort(ctx)
+	channelID := winner.NftDestination.ChannelId
+	if !k.IsNFTTransferChannel(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "channel %s is not an ICS-721 channel of port %s", channelID, portID)
+	}
+	lot := auction.NftLot
+	data := types.NonFungibleTokenPacketData{
+		ClassId:  lot.ClassId,
+		TokenIds: []string{lot.Id},
+		Sender:   k.storageAddress.String(),
+		Receiver: winner.NftDestination.Receiver,
+		Memo:     types.NewNFTLotMemo(auction.Id),
+	}
+	if class, found := k.nftKeeper.GetClass(ctx, lot.ClassId); found {
+		data.ClassUri = class.Uri
+	}
+	if token, found := k.nftKeeper.GetNFT(ctx, lot.ClassId, lot.Id); found {
+		data.TokenUris = []string{token.Uri}
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.transferNFT(cacheCtx, lot.ClassId, lot.Id, NFTEscrowAddress(portID, channelID)); err != nil {
+		return err
+	}
+	timeout := uint64(ctx.BlockTime().Add(types.NFTTransferTimeout).UnixNano())
+	if _, err := k.sendPacket(cacheCtx, portID, channelID, clienttypes.ZeroHeight(), timeout, data.GetBytes()); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// ClaimNFTLot releases the NFT lot of an auction to its winner after the
+// delivery over ICS-721 failed.
+func (k Keeper) ClaimNFTLot(ctx sdk.Context, auctionID string, claimant string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.NftLot == nil {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "auction %s does not sell an NFT lot", auctionID)
+	}
+	if auction.NftLot.Status != types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE {
+		return errorsmod.Wrapf(types.ErrNFTLotNotClaimable, "NFT lot of auction %s is %s", auctionID, auction.NftLot.Status)
+	}
+	winner := auction.Winner()
+	if winner == nil || winner.Bidder != claimant {
+		return errorsmod.Wrapf(types.ErrNotWinner, "%s did not win auction %s", claimant, auctionID)
+	}
+
+	claimantAddress, err := sdk.AccAddressFromBech32(claimant)
+	if err != nil {
+		return err
+	}
+	if err := k.transferNFT(ctx, auction.NftLot.ClassId, auction.NftLot.Id, claimantAddress); err != nil {
+		return err
+	}
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED
+	k.SetAuction(ctx, auction)
+
+	k.emitNFTLotEvent(ctx, "claim_nft_lot", auction, claimant)
+	return nil
+}
+
+// ValidateNFTDestination checks that a bid asking for the NFT lot to be sent
+// over ICS-721 names an auction selling an NFT lot and an ICS-721 channel of
+// the auction port.
+func (k Keeper) ValidateNFTDestination(ctx sdk.Context, auction types.Auction, destination *types.NFTDestination) error {
+	if destination == nil {
+		return nil
+	}
+	if auction.NftLot == nil {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "auction %s does not sell an NFT lot", auction.Id)
+	}
+	if portID := k.GetPort(ctx); !k.IsNFTTransferChannel(ctx, portID, destination.ChannelId) {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "channel %s is not an ICS-721 channel of port %s", destination.ChannelId, portID)
+	}
+	return nil
+}
+
+// setBidNFTDestination records where the NFT lot goes should the highest bid
+// of an auction win.
+func (k Keeper) setBidNFTDestination(ctx sdk.Context, auctionID string, destination *types.NFTDestination) {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found || len(auction.Bids) == 0 {
+		return
+	}
+	auction.Bids[len(auction.Bids)-1].NftDestination = destination
+	k.SetAuction(ctx, auction)
+}
+
+// OnRecvNFTTransferPacket takes back an NFT lot sent over an ICS-721 channel
+// earlier and releases it from the escrow of the channel to the receiver. The
+// module does not mint vouchers for NFTs of other chains.
+func (k Keeper) OnRecvNFTTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	classID, ok := types.ParseNFTClassTrace(packet.SourcePort, packet.SourceChannel, data.ClassId)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidNFTLot, "class %s did not leave this chain over channel %s", data.ClassId, packet.DestinationChannel)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+	if k.nftKeeper == nil {
+		return errorsmod.Wrap(types.ErrNoNFT, "the NFT module is not wired in the app")
+	}
+
+	escrow := NFTEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+	for _, nftID := range data.TokenIds {
+		if owner := k.nftKeeper.GetOwner(ctx, classID, nftID); !owner.Equals(escrow) {
+			return errorsmod.Wrapf(types.ErrInvalidNFTLot, "NFT %s/%s is not escrowed for channel %s", classID, nftID, packet.DestinationChannel)
+		}
+		if err := k.transferNFT(ctx, classID, nftID, receiver); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"receive_nft",
+			sdk.NewAttribute("class_id", classID),
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("receiver", data.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// OnAcknowledgementNFTTransferPacket marks the NFT lot delivered once the
+// other chain took it, or makes it claimable by the winner when the other chain
+// rejected it.
+func (k Keeper) OnAcknowledgementNFTTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundNFTLot(ctx, data)
+	case *channeltypes.Acknowledgement_Result:
+		auction, err := k.inTransitNFTLot(ctx, data)
+		if err != nil {
+			return err
+		}
+		auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED
+		k.SetAuction(ctx, auction)
+		k.emitNFTLotEvent(ctx, "deliver_nft_lot", auction, data.Receiver)
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutNFTTransferPacket makes an NFT lot that never reached the other
+// chain claimable by the winner.
+func (k Keeper) OnTimeoutNFTTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundNFTLot(ctx, data)
+}
+
+// refundNFTLot moves an NFT lot the other chain did not take from the escrow of
+// the channel back to the storage account, where the winner can claim it.
+func (k Keeper) refundNFTLot(ctx sdk.Context, data types.NonFungibleTokenPacketData) error {
+	auction, err := k.inTransitNFTLot(ctx, data)
+	if err != nil {
+		return err
+	}
+	if err := k.transferNFT(ctx, auction.NftLot.ClassId, auction.NftLot.Id, k.storageAddress); err != nil {
+		return err
+	}
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE
+	k.SetAuction(ctx, auction)
+
+	k.emitNFTLotEvent(ctx, "nft_lot_claimable", auction, data.Receiver)
+	return nil
+}
+
+// inTransitNFTLot returns the auction whose NFT lot an ICS-721 packet sent.
+func (k Keeper) inTransitNFTLot(ctx sdk.Context, data types.NonFungibleTokenPacketData) (types.Auction, error) {
+	auctionID, ok := types.ParseNFTLotMemo(data.Memo)
+	if !ok {
+		return types.Auction{}, errorsmod.Wrapf(types.ErrInvalidNFTLot, "memo %q does not name an auction", data.Memo)
+	}
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.Auction{}, errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	lot := auction.NftLot
+	if lot == nil || lot.ClassId != data.ClassId || len(data.TokenIds) != 1 || lot.Id != data.TokenIds[0] {
+		return types.Auction{}, errorsmod.Wrapf(types.ErrInvalidNFTLot, "packet does not carry the NFT lot of auction %s", auctionID)
+	}
+	if lot.Status != types.NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT {
+		return types.Auction{}, errorsmod.Wrapf(types.ErrInvalidNFTLot, "NFT lot of auction %s is %s", auctionID, lot.Status)
+	}
+	return auction, nil
+}
+
+func (k Keeper) emitNFTLotEvent(ctx sdk.Context, eventType string, auction types.Auction, receiver string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("class_id", auction.NftLot.ClassId),
+			sdk.NewAttribute("nft_id", auction.NftLot.Id),
+			sdk.NewAttribute("receiver", receiver),
+		),
+	)
+}
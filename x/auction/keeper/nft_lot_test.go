@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+var storageAddress = sdk.MustAccAddressFromBech32("cosmos1nt2864p8390qm6tctx33e3zt8gh6aehpqv089g")
+
+func newNFTAuction(t *testing.T, ms types.MsgServer, ctx sdk.Context, creator string) string {
+	msg := types.NewMsgCreateAuction(creator, "painting", sdk.NewInt64Coin("token", 10), 10)
+	msg.NftLot = &types.NFTLot{ClassId: "art", Id: "mona-lisa"}
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	return res.AuctionId
+}
+
+func TestNFTLotLocalWinner(t *testing.T) {
+	k, ctx, _, nfts := keepertest.AuctionKeeperWithNFT(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	nfts.Mint("art", "mona-lisa", sdk.MustAccAddressFromBech32(creator))
+
+	// only the owner can sell the NFT
+	msg := types.NewMsgCreateAuction(alice, "painting", sdk.NewInt64Coin("token", 10), 10)
+	msg.NftLot = &types.NFTLot{ClassId: "art", Id: "mona-lisa"}
+	_, err := ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidNFTLot)
+
+	auctionID := newNFTAuction(t, ms, ctx, creator)
+	require.True(t, storageAddress.Equals(nfts.GetOwner(ctx, "art", "mona-lisa")))
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_ESCROWED, auction.NftLot.Status)
+
+	// a destination needs an ICS-721 channel of the auction port
+	bid := types.NewMsgPlaceBid(alice, auctionID, sdk.NewInt64Coin("token", 20))
+	bid.NftDestination = &types.NFTDestination{ChannelId: "channel-0", Receiver: "receiver"}
+	_, err = ms.PlaceBid(ctx, bid)
+	require.ErrorIs(t, err, types.ErrInvalidNFTLot)
+
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, auctionID, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, auctionID, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, bob, nfts.GetOwner(ctx, "art", "mona-lisa").String())
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED, auction.NftLot.Status)
+
+	_, err = ms.ClaimNFTLot(ctx, types.NewMsgClaimNFTLot(bob, auctionID))
+	require.ErrorIs(t, err, types.ErrNFTLotNotClaimable)
+}
+
+func TestNFTLotReturned(t *testing.T) {
+	k, ctx, _, nfts := keepertest.AuctionKeeperWithNFT(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator := sample.AccAddress()
+	nfts.Mint("art", "mona-lisa", sdk.MustAccAddressFromBech32(creator))
+
+	// an auction closing without bids returns the NFT
+	auctionID := newNFTAuction(t, ms, ctx, creator)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, creator, nfts.GetOwner(ctx, "art", "mona-lisa").String())
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_RETURNED, auction.NftLot.Status)
+
+	// so does cancelling the auction
+	auctionID = newNFTAuction(t, ms, ctx, creator)
+	require.True(t, storageAddress.Equals(nfts.GetOwner(ctx, "art", "mona-lisa")))
+	_, err := ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, auctionID))
+	require.NoError(t, err)
+	require.Equal(t, creator, nfts.GetOwner(ctx, "art", "mona-lisa").String())
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_RETURNED, auction.NftLot.Status)
+}
+
+func TestNFTLotClaim(t *testing.T) {
+	k, ctx, _, nfts := keepertest.AuctionKeeperWithNFT(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	nfts.Mint("art", "mona-lisa", sdk.MustAccAddressFromBech32(creator))
+	auctionID := newNFTAuction(t, ms, ctx, creator)
+
+	_, err := ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, auctionID, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+	// the channel the winner chose is gone by settlement
+	auction, _ := k.GetAuction(ctx, auctionID)
+	auction.Bids[0].NftDestination = &types.NFTDestination{ChannelId: "channel-0", Receiver: "receiver"}
+	k.SetAuction(ctx, auction)
+
+	// the delivery cannot be sent, the NFT stays claimable
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.True(t, storageAddress.Equals(nfts.GetOwner(ctx, "art", "mona-lisa")))
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE, auction.NftLot.Status)
+
+	_, err = ms.ClaimNFTLot(ctx, types.NewMsgClaimNFTLot(alice, auctionID))
+	require.ErrorIs(t, err, types.ErrNotWinner)
+	_, err = ms.ClaimNFTLot(ctx, types.NewMsgClaimNFTLot(bob, auctionID))
+	require.NoError(t, err)
+	require.Equal(t, bob, nfts.GetOwner(ctx, "art", "mona-lisa").String())
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED, auction.NftLot.Status)
+
+	_, err = ms.ClaimNFTLot(ctx, types.NewMsgClaimNFTLot(bob, auctionID))
+	require.ErrorIs(t, err, types.ErrNFTLotNotClaimable)
+}
+
+func TestNFTTransferPackets(t *testing.T) {
+	k, ctx, _, nfts := keepertest.AuctionKeeperWithNFT(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, bob := sample.AccAddress(), sample.AccAddress()
+	escrow := keeper.NFTEscrowAddress(types.PortID, "channel-0")
+	nfts.Mint("art", "mona-lisa", sdk.MustAccAddressFromBech32(creator))
+	auctionID := newNFTAuction(t, ms, ctx, creator)
+
+	// the NFT lot of bob is on its way over channel-0
+	_, err := ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, auctionID, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+	auction, _ := k.GetAuction(ctx, auctionID)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT
+	k.SetAuction(ctx, auction)
+	nfts.Mint("art", "mona-lisa", escrow)
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: "channel-0", DestinationPort: "nft-transfer", DestinationChannel: "channel-1"}
+	data := types.NonFungibleTokenPacketData{
+		ClassId:  "art",
+		TokenIds: []string{"mona-lisa"},
+		Sender:   storageAddress.String(),
+		Receiver: "receiver",
+		Memo:     types.NewNFTLotMemo(auctionID),
+	}
+
+	// a timeout makes it claimable
+	require.NoError(t, k.OnTimeoutNFTTransferPacket(ctx, packet, data))
+	require.True(t, storageAddress.Equals(nfts.GetOwner(ctx, "art", "mona-lisa")))
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE, auction.NftLot.Status)
+	require.ErrorIs(t, k.OnTimeoutNFTTransferPacket(ctx, packet, data), types.ErrInvalidNFTLot)
+
+	// a success acknowledgement leaves it with the other chain
+	auction.NftLot.Status = types.NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT
+	k.SetAuction(ctx, auction)
+	nfts.Mint("art", "mona-lisa", escrow)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, k.OnAcknowledgementNFTTransferPacket(ctx, packet, data, ack))
+	require.True(t, escrow.Equals(nfts.GetOwner(ctx, "art", "mona-lisa")))
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.NFTLotStatus_NFT_LOT_STATUS_DELIVERED, auction.NftLot.Status)
+
+	// the NFT can come back over the channel it left on
+	back := channeltypes.Packet{SourcePort: "nft-transfer", SourceChannel: "channel-1", DestinationPort: types.PortID, DestinationChannel: "channel-0"}
+	data = types.NonFungibleTokenPacketData{
+		ClassId:  types.NFTClassTrace("nft-transfer", "channel-1", "art"),
+		TokenIds: []string{"mona-lisa"},
+		Sender:   "receiver",
+		Receiver: bob,
+	}
+	require.NoError(t, k.OnRecvNFTTransferPacket(ctx, back, data))
+	require.Equal(t, bob, nfts.GetOwner(ctx, "art", "mona-lisa").String())
+	require.ErrorIs(t, k.OnRecvNFTTransferPacket(ctx, back, data), types.ErrInvalidNFTLot)
+
+	// NFTs of other chains are not taken
+	data.ClassId = "art"
+	require.ErrorIs(t, k.OnRecvNFTTransferPacket(ctx, back, data), types.ErrInvalidNFTLot)
+}
+
+func TestNFTLotWithoutNFTModule(t *testing.T) {
+	k, ctx := keepertest.AuctionKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "painting", sdk.NewInt64Coin("token", 10), 10)
+	msg.NftLot = &types.NFTLot{ClassId: "art", Id: "mona-lisa"}
+	_, err := ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoNFT)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// IsModulePaused checks if the module authority paused the whole module.
+func (k Keeper) IsModulePaused(ctx sdk.Context) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.ModulePausedKey)
+}
+
+// SetModulePaused pauses or resumes the whole module.
+func (k Keeper) SetModulePaused(ctx sdk.Context, paused bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if paused {
+		store.Set(types.ModulePausedKey, []byte{0x01})
+	} else {
+		store.Delete(types.ModulePausedKey)
+	}
+}
+
+// GetHaltedSince returns the height at which bidding was halted module wide,
+// or zero if bidding is not halted.
+func (k Keeper) GetHaltedSince(ctx sdk.Context) int64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.HaltedSinceKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// SetHaltedSince sets the height at which bidding was halted module wide. Zero
+// clears it.
+func (k Keeper) SetHaltedSince(ctx sdk.Context, height int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if height == 0 {
+		store.Delete(types.HaltedSinceKey)
+		return
+	}
+	store.Set(types.HaltedSinceKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// IsBiddingHalted checks if bidding is halted module wide, either by the
+// module authority or because x/circuit disabled MsgPlaceBid.
+func (k Keeper) IsBiddingHalted(ctx sdk.Context) bool {
+	if k.IsModulePaused(ctx) {
+		return true
+	}
+	if k.circuitKeeper == nil {
+		return false
+	}
+
+	allowed, err := k.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(&types.MsgPlaceBid{}))
+	if err != nil {
+		k.Logger().Error("Failed to check the MsgPlaceBid circuit", "err", err)
+		return false
+	}
+	return !allowed
+}
+
+// PauseAuction halts bidding on an open auction. Its remaining blocks are kept
+// until it is resumed.
+func (k Keeper) PauseAuction(ctx sdk.Context, auctionID string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_PAUSED
+	auction.PausedAt = ctx.BlockHeight()
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"pause_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+		),
+	)
+
+	return nil
+}
+
+// ResumeAuction reopens a paused auction and shifts its end height by the
+// paused duration.
+func (k Keeper) ResumeAuction(ctx sdk.Context, auctionID string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_PAUSED {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	auction.EndHeight += ctx.BlockHeight() - auction.PausedAt
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_OPEN
+	auction.PausedAt = 0
+	k.SetAuction(ctx, auction)
+	k.InsertAuctionEndQueue(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"resume_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("end_height", fmt.Sprint(auction.EndHeight)),
+		),
+	)
+
+	return nil
+}
+
+// ShiftOpenAuctions pushes back the end height of every open auction by the
+// given number of blocks.
+func (k Keeper) ShiftOpenAuctions(ctx sdk.Context, blocks int64) {
+	for _, auction := range k.GetAllAuction(ctx) {
+		if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN {
+			continue
+		}
+		k.RemoveAuctionEndQueue(ctx, auction)
+		auction.EndHeight += blocks
+		k.SetAuction(ctx, auction)
+		k.InsertAuctionEndQueue(ctx, auction)
+	}
+}
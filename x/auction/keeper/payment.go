@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// InsertPaymentQueue indexes an auction awaiting payment by its deadline.
+func (k Keeper) InsertPaymentQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentQueueKey))
+
+	store.Set(types.AuctionEndQueueEntryKey(auction.PaymentDeadline, auction.Id), []byte{})
+}
+
+// RemovePaymentQueue removes an auction from the payment deadline index.
+func (k Keeper) RemovePaymentQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentQueueKey))
+
+	store.Delete(types.AuctionEndQueueEntryKey(auction.PaymentDeadline, auction.Id))
+}
+
+// GetExpiredPaymentAuctionIDs returns the IDs of the auctions awaiting payment
+// whose deadline is lower than or equal to the given height.
+func (k Keeper) GetExpiredPaymentAuctionIDs(ctx sdk.Context, height int64) (auctionIDs []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentQueueKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(types.AuctionEndQueueKeyPrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the 8 byte deadline followed by the auction ID
+		auctionIDs = append(auctionIDs, string(iterator.Key()[8:]))
+	}
+
+	return
+}
+
+// offerAuction offers a closed deferred-payment auction to the bidder of the
+// given bid, who has settlement_window blocks to complete the purchase.
+func (k Keeper) offerAuction(ctx sdk.Context, auction types.Auction, bid *types.Bid) {
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT
+	auction.WinningBid = bid
+	auction.PaymentDeadline = ctx.BlockHeight() + int64(k.GetParams(ctx).SettlementWindow)
+	k.SetAuction(ctx, auction)
+	k.InsertPaymentQueue(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"offer_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("bidder", bid.Bidder),
+			sdk.NewAttribute("amount", bid.BidAmount.String()),
+			sdk.NewAttribute("payment_deadline", strconv.FormatInt(auction.PaymentDeadline, 10)),
+		),
+	)
+}
+
+// CompletePurchase pays the winning bid of an auction awaiting payment to the
+// creator, settles the auction and refunds the bidder deposits.
+func (k Keeper) CompletePurchase(ctx sdk.Context, auctionID string, buyer string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT {
+		return errorsmod.Wrapf(types.ErrNotAwaitingPayment, "auction %s is %s", auctionID, auction.Status)
+	}
+	if auction.WinningBid.Bidder != buyer {
+		return errorsmod.Wrapf(types.ErrNotWinner, "auction %s is offered to %s", auctionID, auction.WinningBid.Bidder)
+	}
+
+	buyerAddress, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return err
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoins(ctx, buyerAddress, creatorAddress, sdk.NewCoins(*auction.WinningBid.BidAmount))
+	if err != nil {
+		return err
+	}
+	if err := k.RefundDeposits(ctx, auctionID); err != nil {
+		return err
+	}
+	if err := k.settleNFTLot(ctx, &auction); err != nil {
+		return err
+	}
+
+	k.RemovePaymentQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("winner", buyer),
+			sdk.NewAttribute("amount", auction.WinningBid.BidAmount.String()),
+		),
+	)
+
+	if err := k.afterSettlement(ctx, auction); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterAuctionSettled(ctx, auctionID)
+}
+
+// ExpirePayment slashes the deposit of a winner that did not pay in time to
+// the creator and offers the auction to the next-highest bidder. The auction
+// settles unsold when no registered bidder is left.
+func (k Keeper) ExpirePayment(ctx sdk.Context, auctionID string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT {
+		return errorsmod.Wrapf(types.ErrNotAwaitingPayment, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	defaulter := auction.WinningBid.Bidder
+	registration, found := k.GetBidderRegistration(ctx, auctionID, defaulter)
+	if found {
+		creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(*registration.Deposit))
+		if err != nil {
+			return err
+		}
+		k.RemoveBidderRegistration(ctx, auctionID, defaulter)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"forfeit_deposit",
+				sdk.NewAttribute("auction_id", auctionID),
+				sdk.NewAttribute("bidder", defaulter),
+				sdk.NewAttribute("deposit", registration.Deposit.String()),
+			),
+		)
+	}
+	k.RemovePaymentQueue(ctx, auction)
+
+	// Bids are in ascending order, the first one from a bidder who still holds
+	// a deposit is the next-highest one. Bids below the reserve are never
+	// offered.
+	for i := len(auction.Bids) - 1; i >= 0; i-- {
+		bid := auction.Bids[i]
+		if !auction.MeetsReserve(*bid.BidAmount) {
+			break
+		}
+		if _, found := k.GetBidderRegistration(ctx, auctionID, bid.Bidder); found {
+			k.offerAuction(ctx, auction, bid)
+			return nil
+		}
+	}
+
+	if err := k.RefundDeposits(ctx, auctionID); err != nil {
+		return err
+	}
+	if err := k.returnNFTLot(ctx, &auction); err != nil {
+		return err
+	}
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	auction.WinningBid = nil
+	k.SetAuction(ctx, auction)
+	if err := k.releaseBidAuthorizations(ctx, auction, nil); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("winner", ""),
+			sdk.NewAttribute("amount", ""),
+		),
+	)
+
+	return k.Hooks().AfterAuctionSettled(ctx, auctionID)
+}
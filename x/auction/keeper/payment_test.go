@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestDeferredPayment(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	deposit := sdk.NewInt64Coin("token", 5)
+	window := int64(k.GetParams(ctx).SettlementWindow)
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	msg.DeferredPayment = true
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	for _, bidder := range []string{alice, bob} {
+		_, err = ms.RegisterBidder(ctx, types.NewMsgRegisterBidder(bidder, res.AuctionId))
+		require.NoError(t, err)
+	}
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	transfers := len(bank.Transfers)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+	// bids are not escrowed
+	require.Len(t, bank.Transfers, transfers)
+
+	// the auction is offered to the highest bidder at close
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT, auction.Status)
+	require.Equal(t, bob, auction.WinningBid.Bidder)
+	require.Equal(t, 11+window, auction.PaymentDeadline)
+
+	_, err = ms.CompletePurchase(ctx, types.NewMsgCompletePurchase(alice, res.AuctionId))
+	require.ErrorIs(t, err, types.ErrNotWinner)
+
+	// bob does not pay, the deposit goes to the creator and alice gets the offer
+	ctx = ctx.WithBlockHeight(11 + window)
+	k.EndBlocker(ctx)
+	forfeit := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, creator, forfeit.To)
+	require.Equal(t, sdk.NewCoins(deposit), forfeit.Amount)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT, auction.Status)
+	require.Equal(t, alice, auction.WinningBid.Bidder)
+	require.Equal(t, 11+2*window, auction.PaymentDeadline)
+
+	_, err = ms.CompletePurchase(ctx, types.NewMsgCompletePurchase(alice, res.AuctionId))
+	require.NoError(t, err)
+	payment, refund := bank.Transfers[len(bank.Transfers)-2], bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, keepertest.Transfer{From: alice, To: creator, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 20))}, payment)
+	require.Equal(t, alice, refund.To)
+	require.Equal(t, sdk.NewCoins(deposit), refund.Amount)
+
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Equal(t, alice, auction.Winner().Bidder)
+	require.Empty(t, k.GetExpiredPaymentAuctionIDs(ctx, auction.PaymentDeadline))
+
+	_, err = ms.CompletePurchase(ctx, types.NewMsgCompletePurchase(alice, res.AuctionId))
+	require.ErrorIs(t, err, types.ErrNotAwaitingPayment)
+}
+
+func TestDeferredPaymentUnsold(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+	bidder := sample.AccAddress()
+	deposit := sdk.NewInt64Coin("token", 5)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 1)
+	msg.Deposit = &deposit
+	msg.DeferredPayment = true
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	_, err = ms.RegisterBidder(ctx, types.NewMsgRegisterBidder(bidder, res.AuctionId))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2)
+	k.EndBlocker(ctx)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	ctx = ctx.WithBlockHeight(auction.PaymentDeadline)
+	k.EndBlocker(ctx)
+
+	// no registered bidder is left to offer the auction to
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Nil(t, auction.Winner())
+	require.Empty(t, k.GetAuctionBidderRegistrations(ctx, res.AuctionId))
+}
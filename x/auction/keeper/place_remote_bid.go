@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"auction/x/auction/types"
+)
+
+// TransmitPlaceRemoteBidPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitPlaceRemoteBidPacket(
+	ctx sdk.Context,
+	packetData types.PlaceRemoteBidPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvPlaceRemoteBidPacket mints the vouchers of the bid escrowed at the
+// other end of the channel to the IBC bidder of the remote bidder and bids
+// them. Only auctions priced in the vouchers of the channel and selling no
+// coin or NFT lot take such bids, as nothing else can be returned to the
+// bidder. A bid that fails is answered with an error acknowledgement, which
+// discards the vouchers and refunds the escrow on the other chain.
+func (k Keeper) OnRecvPlaceRemoteBidPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PlaceRemoteBidPacketData) (packetAck types.PlaceRemoteBidPacketAck, err error) {
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	auction, found := k.GetAuction(ctx, data.AuctionId)
+	if !found {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", data.AuctionId)
+	}
+	// the bidder cannot sign the payment of a deferred-payment auction, and
+	// sellers of reverse auctions do not escrow their bids
+	if auction.DeferredPayment || auction.IsReverse() {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s does not take bids over IBC", auction.Id)
+	}
+
+	// auction results only carry the vouchers of the channel back, lots and
+	// vouchers of other channels would be stranded on the IBC bidder
+	if auction.Lot != nil || len(auction.Lots) > 0 {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s sells a coin lot", auction.Id)
+	}
+	// NFT lots that cannot be delivered wait for a claim the bidder cannot
+	// sign
+	if auction.NftLot != nil {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s sells an NFT lot", auction.Id)
+	}
+
+	// vouchers paid to a creator on this chain could never be sent back
+	creator, found := k.GetIBCBidder(ctx, auction.Creator)
+	if !found || creator.PortId != packet.DestinationPort || creator.ChannelId != packet.DestinationChannel {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s was not created over channel %s", auction.Id, packet.DestinationChannel)
+	}
+
+	bidder := IBCBidderAddress(packet.DestinationChannel, data.Bidder)
+	voucher := sdk.Coin{
+		Denom:  types.VoucherDenom(packet.DestinationPort, packet.DestinationChannel, data.BidAmount.Denom),
+		Amount: data.BidAmount.Amount,
+	}
+	if err := voucher.Validate(); err != nil {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "invalid bid amount: %s", err)
+	}
+	if auction.StartingBid.Denom != voucher.Denom {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s is not priced in the vouchers of channel %s", auction.Id, packet.DestinationChannel)
+	}
+	msg := types.NewMsgPlaceBid(bidder.String(), data.AuctionId, voucher)
+	if err := msg.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	vouchers := sdk.NewCoins(voucher)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return packetAck, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, vouchers); err != nil {
+		return packetAck, err
+	}
+	k.SetIBCBidder(ctx, types.IBCBidder{
+		Address:   bidder.String(),
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Sender:    data.Bidder,
+	})
+	if _, err := NewMsgServerImpl(k).PlaceBid(ctx, msg); err != nil {
+		return packetAck, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_bid",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("bidder", bidder.String()),
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("sender", data.Bidder),
+			sdk.NewAttribute("amount", voucher.String()),
+		),
+	)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementPlaceRemoteBidPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. A rejected bid is refunded
+// from the escrow of the channel.
+func (k Keeper) OnAcknowledgementPlaceRemoteBidPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PlaceRemoteBidPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundRemoteBid(ctx, packet, data)
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.PlaceRemoteBidPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutPlaceRemoteBidPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutPlaceRemoteBidPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PlaceRemoteBidPacketData) error {
+	return k.refundRemoteBid(ctx, packet, data)
+}
+
+// refundRemoteBid returns a bid that never reached the auction from the
+// escrow of the channel to the bidder.
+func (k Keeper) refundRemoteBid(ctx sdk.Context, packet channeltypes.Packet, data types.PlaceRemoteBidPacketData) error {
+	bidder, err := sdk.AccAddressFromBech32(data.Bidder)
+	if err != nil {
+		return err
+	}
+	escrow := RemoteEscrowAddress(packet.SourcePort, packet.SourceChannel)
+	if err := k.bankKeeper.SendCoins(ctx, escrow, bidder, sdk.NewCoins(data.BidAmount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_bid_refunded",
+			sdk.NewAttribute("auction_id", data.AuctionId),
+			sdk.NewAttribute("bidder", data.Bidder),
+			sdk.NewAttribute("channel_id", packet.SourceChannel),
+			sdk.NewAttribute("amount", data.BidAmount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"auction/x/auction/types"
+)
+
+func (k Keeper) AuctionRewards(goCtx context.Context, req *types.QueryAuctionRewardsRequest) (*types.QueryAuctionRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reward, _ := k.GetAuctionReward(ctx, req.Address)
+
+	return &types.QueryAuctionRewardsResponse{Rewards: reward.Amount}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"auction/x/auction/types"
+)
+
+func (k Keeper) AuctionSchedule(goCtx context.Context, req *types.QueryAuctionScheduleRequest) (*types.QueryAuctionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, found := k.GetAuctionSchedule(ctx, req.ScheduleId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "schedule not found")
+	}
+
+	return &types.QueryAuctionScheduleResponse{Schedule: schedule}, nil
+}
+
+func (k Keeper) AuctionSchedules(goCtx context.Context, req *types.QueryAuctionSchedulesRequest) (*types.QueryAuctionSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionScheduleKey))
+
+	var schedules []types.AuctionSchedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var schedule types.AuctionSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
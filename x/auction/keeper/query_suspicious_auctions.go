@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"auction/x/auction/types"
+)
+
+// defaultMinOverlapPercent is used when the request does not set a threshold.
+const defaultMinOverlapPercent = 50
+
+func (k Keeper) SuspiciousAuctions(goCtx context.Context, req *types.QuerySuspiciousAuctionsRequest) (*types.QuerySuspiciousAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+	}
+	if req.MinOverlapPercent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "min overlap percent cannot exceed 100")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minOverlapPercent := req.MinOverlapPercent
+	if minOverlapPercent == 0 {
+		minOverlapPercent = defaultMinOverlapPercent
+	}
+	var sinceHeight int64
+	if req.Lookback != 0 {
+		sinceHeight = ctx.BlockHeight() - int64(req.Lookback)
+	}
+	counterparties := k.GetCounterparties(ctx, req.Creator, sinceHeight)
+
+	suspicious := []types.SuspiciousAuction{}
+	if len(counterparties) == 0 {
+		return &types.QuerySuspiciousAuctionsResponse{Auctions: suspicious}, nil
+	}
+
+	for _, auction := range k.GetAllAuction(ctx) {
+		if auction.Creator != req.Creator {
+			continue
+		}
+		if auction.Status != types.AuctionStatus_AUCTION_STATUS_OPEN && auction.Status != types.AuctionStatus_AUCTION_STATUS_PAUSED {
+			continue
+		}
+
+		bidders := make(map[string]struct{})
+		var counterpartyBidders []string
+		for _, bid := range auction.Bids {
+			if _, seen := bidders[bid.Bidder]; seen {
+				continue
+			}
+			bidders[bid.Bidder] = struct{}{}
+			if _, ok := counterparties[bid.Bidder]; ok {
+				counterpartyBidders = append(counterpartyBidders, bid.Bidder)
+			}
+		}
+		if len(counterpartyBidders) == 0 {
+			continue
+		}
+
+		overlapPercent := uint32(len(counterpartyBidders) * 100 / len(bidders))
+		if overlapPercent < minOverlapPercent {
+			continue
+		}
+		suspicious = append(suspicious, types.SuspiciousAuction{
+			AuctionId:           auction.Id,
+			CounterpartyBidders: counterpartyBidders,
+			OverlapPercent:      overlapPercent,
+		})
+	}
+
+	return &types.QuerySuspiciousAuctionsResponse{Auctions: suspicious}, nil
+}
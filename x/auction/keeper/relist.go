@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// CanRelist reports whether an auction closing without any bid has relists
+// left in its relist policy.
+func (k Keeper) CanRelist(ctx sdk.Context, auction types.Auction) bool {
+	if auction.RelistPolicy == nil || auction.RelistRound >= auction.RelistPolicy.MaxRelists {
+		return false
+	}
+	if len(auction.Bids) > 0 {
+		return false
+	}
+
+	return len(k.GetAuctionLotBids(ctx, auction.Id)) == 0
+}
+
+// relistAuction settles an unsold auction and lists it again as a new auction
+// linked to it, with the starting bid lowered by the policy decrement. Lots
+// and budgets stay escrowed for the new auction while bidder deposits are
+// refunded, as registrations do not carry over.
+func (k Keeper) relistAuction(ctx sdk.Context, auction types.Auction) error {
+	if err := k.RefundDeposits(ctx, auction.Id); err != nil {
+		return err
+	}
+
+	auctionCount := k.GetAuctionCount(ctx)
+	relist := types.Auction{
+		Creator:     auction.Creator,
+		Item:        auction.Item,
+		StartingBid: auction.StartingBid,
+		Id:          fmt.Sprintf("auction-%d", auctionCount),
+		Bids:        []*types.Bid{},
+		EndHeight:   ctx.BlockHeight() + int64(auction.Duration),
+		Status:      types.AuctionStatus_AUCTION_STATUS_OPEN,
+
+		Deposit:         auction.Deposit,
+		DeferredPayment: auction.DeferredPayment,
+		AuctionType:     auction.AuctionType,
+		Quantity:        auction.Quantity,
+		Lot:             auction.Lot,
+		PricingRule:     auction.PricingRule,
+		Lots:            auction.Lots,
+		NftLot:          auction.NftLot,
+		Duration:        auction.Duration,
+		RelistPolicy:    auction.RelistPolicy,
+		RelistRound:     auction.RelistRound + 1,
+		RelistedFrom:    auction.Id,
+
+		ReserveCommitment: auction.ReserveCommitment,
+		Reserve:           auction.Reserve,
+	}
+	if decrement := auction.RelistPolicy.Decrement; decrement != nil {
+		startingBid := auction.StartingBid.Sub(*decrement)
+		relist.StartingBid = &startingBid
+	}
+	k.SetAuction(ctx, relist)
+	k.InsertAuctionEndQueue(ctx, relist)
+	k.SetAuctionCount(ctx, auctionCount+1)
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	auction.RelistedAs = relist.Id
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"relist_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("relisted_as", relist.Id),
+			sdk.NewAttribute("round", strconv.FormatUint(uint64(relist.RelistRound), 10)),
+			sdk.NewAttribute("starting_bid", relist.StartingBid.String()),
+		),
+	)
+
+	if err := k.Hooks().AfterAuctionSettled(ctx, auction.Id); err != nil {
+		return err
+	}
+	return k.Hooks().AfterAuctionCreated(ctx, relist.Id)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestRelistUnsoldAuction(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+	decrement := sdk.NewInt64Coin("token", 3)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.RelistPolicy = &types.RelistPolicy{MaxRelists: 2, Decrement: &decrement}
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	// every round closes without bids
+	auctionID := res.AuctionId
+	for round, startingBid := range []int64{7, 4} {
+		auction, _ := k.GetAuction(ctx, auctionID)
+		ctx = ctx.WithBlockHeight(auction.EndHeight)
+		k.EndBlocker(ctx)
+
+		unsold, _ := k.GetAuction(ctx, auctionID)
+		require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, unsold.Status)
+		require.NotEmpty(t, unsold.RelistedAs)
+
+		relist, found := k.GetAuction(ctx, unsold.RelistedAs)
+		require.True(t, found)
+		require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, relist.Status)
+		require.Equal(t, auctionID, relist.RelistedFrom)
+		require.Equal(t, uint32(round+1), relist.RelistRound)
+		require.Equal(t, sdk.NewInt64Coin("token", startingBid), *relist.StartingBid)
+		require.Equal(t, ctx.BlockHeight()+10, relist.EndHeight)
+		auctionID = relist.Id
+	}
+
+	// no relist is left
+	auction, _ := k.GetAuction(ctx, auctionID)
+	ctx = ctx.WithBlockHeight(auction.EndHeight)
+	k.EndBlocker(ctx)
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Empty(t, auction.RelistedAs)
+	require.Equal(t, 3, k.GetAuctionCount(ctx))
+}
+
+func TestRelistKeepsLotInEscrow(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, bidder := sample.AccAddress(), sample.AccAddress()
+	lot := sdk.NewInt64Coin("launch", 100)
+
+	msg := types.NewMsgCreateAuction(creator, "launch", sdk.NewInt64Coin("token", 2), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = 10
+	msg.Lot = &lot
+	msg.RelistPolicy = &types.RelistPolicy{MaxRelists: 1}
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	transfers := len(bank.Transfers)
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Len(t, bank.Transfers, transfers)
+
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	_, err = ms.PlaceBid(ctx, &types.MsgPlaceBid{
+		AuctionId: auction.RelistedAs,
+		Bidder:    bidder,
+		BidAmount: msg.StartingBid,
+		Quantity:  10,
+	})
+	require.NoError(t, err)
+
+	// the relisted auction delivers the lot escrowed by the original one
+	ctx = ctx.WithBlockHeight(21)
+	k.EndBlocker(ctx)
+	require.Contains(t, bank.Transfers, keepertest.Transfer{
+		From:   bank.Transfers[0].To,
+		To:     bidder,
+		Amount: sdk.NewCoins(lot),
+	})
+}
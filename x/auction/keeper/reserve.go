@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// RevealReserve reveals the hidden reserve price of an auction. The reserve
+// and salt must match the commitment made at creation. Reserves can be
+// revealed until the auction ends, an auction that ends without its reserve
+// revealed does not sell.
+func (k Keeper) RevealReserve(ctx sdk.Context, auctionID string, creator string, reserve sdk.Coin, salt string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Creator != creator {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the creator of auction %s", creator, auctionID)
+	}
+	switch auction.Status {
+	case types.AuctionStatus_AUCTION_STATUS_OPEN, types.AuctionStatus_AUCTION_STATUS_PAUSED, types.AuctionStatus_AUCTION_STATUS_UPCOMING:
+	default:
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+	if auction.ReserveCommitment == "" {
+		return errorsmod.Wrapf(types.ErrInvalidReserve, "auction %s has no hidden reserve", auctionID)
+	}
+	if auction.Reserve != nil {
+		return errorsmod.Wrapf(types.ErrInvalidReserve, "reserve of auction %s is already revealed", auctionID)
+	}
+	if reserve.Denom != auction.StartingBid.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidReserve, "auction %s is priced in %s", auctionID, auction.StartingBid.Denom)
+	}
+	if types.ReserveCommitment(reserve, salt) != auction.ReserveCommitment {
+		return errorsmod.Wrapf(types.ErrInvalidReserve, "reserve does not match the commitment of auction %s", auctionID)
+	}
+
+	auction.Reserve = &reserve
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"reveal_reserve",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("reserve", reserve.String()),
+		),
+	)
+
+	return nil
+}
+
+// refundReserveNotMet refunds the highest bid of an auction that ends below
+// its reserve.
+func (k Keeper) refundReserveNotMet(ctx sdk.Context, auction types.Auction) error {
+	highestBid := auction.Bids[len(auction.Bids)-1]
+	bidderAddress, err := sdk.AccAddressFromBech32(highestBid.Bidder)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, k.storageAddress, bidderAddress, sdk.NewCoins(*highestBid.BidAmount)); err != nil {
+		return err
+	}
+	if err := k.afterBidRefunded(ctx, auction.Id, bidderAddress, *highestBid.BidAmount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"reserve_not_met",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("highest_bid", highestBid.BidAmount.String()),
+		),
+	)
+
+	return nil
+}
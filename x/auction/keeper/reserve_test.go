@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func newReserveAuction(t *testing.T, ms types.MsgServer, ctx sdk.Context, creator string, reserve sdk.Coin) string {
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.ReserveCommitment = types.ReserveCommitment(reserve, "salt")
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	return res.AuctionId
+}
+
+func TestRevealReserve(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice := sample.AccAddress(), sample.AccAddress()
+	reserve := sdk.NewInt64Coin("token", 50)
+	auctionID := newReserveAuction(t, ms, ctx, creator, reserve)
+
+	// only the creator reveals, and only the committed reserve
+	_, err := ms.RevealReserve(ctx, types.NewMsgRevealReserve(alice, auctionID, reserve, "salt"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, sdk.NewInt64Coin("token", 40), "salt"))
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, reserve, "pepper"))
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, sdk.NewInt64Coin("stake", 50), "salt"))
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, auctionID, sdk.NewInt64Coin("token", 60)))
+	require.NoError(t, err)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, reserve, "salt"))
+	require.NoError(t, err)
+	auction, _ := k.GetAuction(ctx, auctionID)
+	require.Equal(t, reserve, *auction.Reserve)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, reserve, "salt"))
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+
+	// the highest bid meets the reserve and is paid to the creator
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	payout := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, creator, payout.To)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 60)), payout.Amount)
+	auction, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, alice, auction.Winner().Bidder)
+
+	// settled auctions cannot be revealed
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, auctionID, reserve, "salt"))
+	require.ErrorIs(t, err, types.ErrAuctionNotOpen)
+
+	// auctions without a commitment have no reserve to reveal
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, res.AuctionId, reserve, "salt"))
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+}
+
+func TestReserveNotMet(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice := sample.AccAddress(), sample.AccAddress()
+	reserve := sdk.NewInt64Coin("token", 70)
+
+	// one reserve is revealed above the highest bid, the other is never revealed
+	revealed := newReserveAuction(t, ms, ctx, creator, reserve)
+	hidden := newReserveAuction(t, ms, ctx, creator, reserve)
+	for _, auctionID := range []string{revealed, hidden} {
+		_, err := ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, auctionID, sdk.NewInt64Coin("token", 60)))
+		require.NoError(t, err)
+	}
+	_, err := ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, revealed, reserve, "salt"))
+	require.NoError(t, err)
+	transfers := len(bank.Transfers)
+
+	// the highest bids are refunded and the items go unsold
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Len(t, bank.Transfers, transfers+2)
+	for _, refund := range bank.Transfers[transfers:] {
+		require.Equal(t, alice, refund.To)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 60)), refund.Amount)
+	}
+	for _, auctionID := range []string{revealed, hidden} {
+		auction, _ := k.GetAuction(ctx, auctionID)
+		require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+		require.Nil(t, auction.Winner())
+	}
+}
+
+func TestDeferredPaymentReserve(t *testing.T) {
+	k, ctx, _ := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	reserve := sdk.NewInt64Coin("token", 25)
+	deposit := sdk.NewInt64Coin("token", 5)
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.Deposit = &deposit
+	msg.DeferredPayment = true
+	msg.ReserveCommitment = types.ReserveCommitment(reserve, "salt")
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	for _, bidder := range []string{alice, bob} {
+		_, err = ms.RegisterBidder(ctx, types.NewMsgRegisterBidder(bidder, res.AuctionId))
+		require.NoError(t, err)
+	}
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 30)))
+	require.NoError(t, err)
+	_, err = ms.RevealReserve(ctx, types.NewMsgRevealReserve(creator, res.AuctionId, reserve, "salt"))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT, auction.Status)
+	require.Equal(t, bob, auction.WinningBid.Bidder)
+
+	// bob does not pay, alice's bid is below the reserve and is not offered
+	ctx = ctx.WithBlockHeight(11 + int64(k.GetParams(ctx).SettlementWindow))
+	k.EndBlocker(ctx)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Nil(t, auction.WinningBid)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// settleReverse closes a reverse auction that reached its end height. The
+// lowest bid is paid to its seller from the escrowed budget and the rest of
+// the budget is refunded to the creator.
+func (k Keeper) settleReverse(ctx sdk.Context, auction types.Auction) error {
+	budget := *auction.StartingBid
+	winner := ""
+	amount := ""
+	if len(auction.Bids) > 0 {
+		// The last bid is the lowest one
+		lowestBid := auction.Bids[len(auction.Bids)-1]
+		sellerAddress, err := sdk.AccAddressFromBech32(lowestBid.Bidder)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoins(ctx, k.storageAddress, sellerAddress, sdk.NewCoins(*lowestBid.BidAmount))
+		if err != nil {
+			return err
+		}
+		budget = budget.Sub(*lowestBid.BidAmount)
+		winner = lowestBid.Bidder
+		amount = lowestBid.BidAmount.String()
+	}
+	if err := k.returnBudget(ctx, auction, budget); err != nil {
+		return err
+	}
+	if err := k.RefundDeposits(ctx, auction.Id); err != nil {
+		return err
+	}
+
+	k.RemoveAuctionEndQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_SETTLED
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"settle_auction",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("winner", winner),
+			sdk.NewAttribute("amount", amount),
+		),
+	)
+
+	if err := k.afterSettlement(ctx, auction); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterAuctionSettled(ctx, auction.Id)
+}
+
+// returnBudget returns the given part of the escrowed budget of a reverse
+// auction to the creator.
+func (k Keeper) returnBudget(ctx sdk.Context, auction types.Auction, amount sdk.Coin) error {
+	if !auction.IsReverse() || !amount.IsPositive() {
+		return nil
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, k.storageAddress, creatorAddress, sdk.NewCoins(amount))
+}
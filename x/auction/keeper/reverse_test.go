@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestReverseAuction(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	buyer, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	budget := sdk.NewInt64Coin("token", 100)
+
+	msg := types.NewMsgCreateAuction(buyer, "audit", budget, 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	require.NoError(t, msg.ValidateBasic())
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, buyer, bank.Transfers[0].From)
+	require.Equal(t, sdk.NewCoins(budget), bank.Transfers[0].Amount)
+	storage := bank.Transfers[0].To
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgPlaceBid
+		expErr error
+	}{
+		{
+			name:   "above budget",
+			input:  types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 101)),
+			expErr: types.ErrInvalidBidAmount,
+		},
+		{
+			name:  "at budget",
+			input: types.NewMsgPlaceBid(alice, res.AuctionId, budget),
+		},
+		{
+			name:  "underbid",
+			input: types.NewMsgPlaceBid(bob, res.AuctionId, sdk.NewInt64Coin("token", 70)),
+		},
+		{
+			name:   "above lowest bid",
+			input:  types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 80)),
+			expErr: types.ErrInvalidBidAmount,
+		},
+		{
+			name:   "zero",
+			input:  types.NewMsgPlaceBid(alice, res.AuctionId, sdk.NewInt64Coin("token", 0)),
+			expErr: types.ErrInvalidBidAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.PlaceBid(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+	// sellers do not escrow anything
+	require.Len(t, bank.Transfers, 1)
+
+	ctx = ctx.WithBlockHeight(11)
+	k.EndBlocker(ctx)
+	require.Equal(t, []keepertest.Transfer{
+		{From: storage, To: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 70))},
+		{From: storage, To: buyer, Amount: sdk.NewCoins(sdk.NewInt64Coin("token", 30))},
+	}, bank.Transfers[1:])
+
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+	require.Equal(t, bob, auction.Winner().Bidder)
+}
+
+func TestCancelReverseAuctionReturnsBudget(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	buyer := sample.AccAddress()
+	budget := sdk.NewInt64Coin("token", 100)
+
+	msg := types.NewMsgCreateAuction(buyer, "audit", budget, 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(buyer, res.AuctionId))
+	require.NoError(t, err)
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, buyer, refund.To)
+	require.Equal(t, sdk.NewCoins(budget), refund.Amount)
+}
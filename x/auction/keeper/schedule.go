@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// InsertStartQueue indexes an upcoming auction by its start time, or by its
+// start height when it has no start time.
+func (k Keeper) InsertStartQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if auction.StartTime != nil {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartTimeQueueKey))
+		store.Set(types.StartTimeQueueEntryKey(*auction.StartTime, auction.Id), []byte{})
+		return
+	}
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartHeightQueueKey))
+	store.Set(types.AuctionEndQueueEntryKey(auction.StartHeight, auction.Id), []byte{})
+}
+
+// RemoveStartQueue removes an auction from the start index.
+func (k Keeper) RemoveStartQueue(ctx sdk.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if auction.StartTime != nil {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartTimeQueueKey))
+		store.Delete(types.StartTimeQueueEntryKey(*auction.StartTime, auction.Id))
+		return
+	}
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartHeightQueueKey))
+	store.Delete(types.AuctionEndQueueEntryKey(auction.StartHeight, auction.Id))
+}
+
+// GetStartingAuctionIDs returns the IDs of the upcoming auctions whose start
+// height or start time was reached by the current block.
+func (k Keeper) GetStartingAuctionIDs(ctx sdk.Context) (auctionIDs []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	heightStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartHeightQueueKey))
+	heightIterator := heightStore.Iterator(nil, storetypes.PrefixEndBytes(types.AuctionEndQueueKeyPrefix(ctx.BlockHeight())))
+	defer heightIterator.Close()
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		// the key is the 8 byte start height followed by the auction ID
+		auctionIDs = append(auctionIDs, string(heightIterator.Key()[8:]))
+	}
+
+	timeStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StartTimeQueueKey))
+	timeIterator := timeStore.Iterator(nil, storetypes.PrefixEndBytes(types.StartTimeQueueKeyPrefix(ctx.BlockTime())))
+	defer timeIterator.Close()
+	for ; timeIterator.Valid(); timeIterator.Next() {
+		// the key is the 8 byte unix start time followed by the auction ID
+		auctionIDs = append(auctionIDs, string(timeIterator.Key()[8:]))
+	}
+
+	return
+}
+
+// ValidateStart checks that the start height or start time of a new auction
+// lies in the future, and the start height at most MaxDuration blocks ahead.
+func (k Keeper) ValidateStart(ctx sdk.Context, msg *types.MsgCreateAuction) error {
+	if msg.StartHeight != 0 && msg.StartHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidStart, "start height %d is not after the current height %d", msg.StartHeight, ctx.BlockHeight())
+	}
+	if msg.StartHeight != 0 && uint64(msg.StartHeight-ctx.BlockHeight()) > types.MaxDuration {
+		return errorsmod.Wrapf(types.ErrInvalidStart, "start height %d is more than %d blocks after the current height %d", msg.StartHeight, types.MaxDuration, ctx.BlockHeight())
+	}
+	if msg.StartTime != nil && !msg.StartTime.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidStart, "start time %s is not after the current block time %s", msg.StartTime, ctx.BlockTime())
+	}
+
+	return nil
+}
+
+// OpenAuction opens an upcoming auction for bidding. It stays open for its
+// duration from the current height.
+func (k Keeper) OpenAuction(ctx sdk.Context, auctionID string) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", auctionID)
+	}
+	if auction.Status != types.AuctionStatus_AUCTION_STATUS_UPCOMING {
+		return errorsmod.Wrapf(types.ErrAuctionNotOpen, "auction %s is %s", auctionID, auction.Status)
+	}
+
+	k.RemoveStartQueue(ctx, auction)
+	auction.Status = types.AuctionStatus_AUCTION_STATUS_OPEN
+	auction.EndHeight = ctx.BlockHeight() + int64(auction.Duration)
+	k.SetAuction(ctx, auction)
+	k.InsertAuctionEndQueue(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"open_auction",
+			sdk.NewAttribute("auction_id", auctionID),
+			sdk.NewAttribute("end_height", strconv.FormatInt(auction.EndHeight, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestScheduledStartHeight(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1)
+	creator, bidder := sample.AccAddress(), sample.AccAddress()
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.StartHeight = 1
+	_, err := ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStart)
+	msg.StartHeight = 1<<63 - 5
+	_, err = ms.CreateAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStart)
+
+	msg.StartHeight = 5
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_UPCOMING, auction.Status)
+	require.Equal(t, int64(15), auction.EndHeight)
+
+	// bids before the start are rejected
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrAuctionNotOpen)
+	ctx = ctx.WithBlockHeight(4)
+	k.BeginBlocker(ctx)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_UPCOMING, auction.Status)
+
+	ctx = ctx.WithBlockHeight(5)
+	k.BeginBlocker(ctx)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+	require.Equal(t, int64(15), auction.EndHeight)
+	require.Empty(t, k.GetStartingAuctionIDs(ctx))
+
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(15)
+	k.EndBlocker(ctx)
+	auction, _ = k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SETTLED, auction.Status)
+}
+
+func TestScheduledStartTime(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(1).WithBlockTime(now)
+
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	start := now.Add(time.Hour)
+	msg.StartTime = &start
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(-time.Second))
+	k.BeginBlocker(ctx)
+	require.False(t, k.IsAuctionOpen(ctx, res.AuctionId))
+
+	// the end height is counted from the block that opens the auction
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(start)
+	k.BeginBlocker(ctx)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_OPEN, auction.Status)
+	require.Equal(t, int64(13), auction.EndHeight)
+}
+
+func TestCancelUpcomingAuction(t *testing.T) {
+	k, ctx, bank := keepertest.AuctionKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(1)
+	creator := sample.AccAddress()
+	budget := sdk.NewInt64Coin("token", 100)
+
+	msg := types.NewMsgCreateAuction(creator, "audit", budget, 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	msg.StartHeight = 5
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = ms.CancelAuction(ctx, types.NewMsgCancelAuction(creator, res.AuctionId))
+	require.NoError(t, err)
+	refund := bank.Transfers[len(bank.Transfers)-1]
+	require.Equal(t, creator, refund.To)
+	require.Equal(t, sdk.NewCoins(budget), refund.Amount)
+
+	// the cancelled auction is not opened
+	ctx = ctx.WithBlockHeight(5)
+	require.Empty(t, k.GetStartingAuctionIDs(ctx))
+	k.BeginBlocker(ctx)
+	auction, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_CANCELLED, auction.Status)
+}
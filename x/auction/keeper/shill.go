@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"auction/x/auction/types"
+)
+
+// ValidateBidder rejects bids from the auction creator and, when the
+// reject_creator_grantees param is set, from addresses holding an authz grant
+// from the creator.
+func (k Keeper) ValidateBidder(ctx sdk.Context, auction types.Auction, bidder string) error {
+	if bidder == auction.Creator {
+		return errorsmod.Wrapf(types.ErrSelfBid, "%s created auction %s", bidder, auction.Id)
+	}
+
+	if !k.GetParams(ctx).RejectCreatorGrantees || k.authzKeeper == nil {
+		return nil
+	}
+
+	bidderAddress, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return err
+	}
+	grants, err := k.authzKeeper.GetAuthorizations(ctx, bidderAddress, creatorAddress)
+	if err != nil {
+		return err
+	}
+	if len(grants) > 0 {
+		return errorsmod.Wrapf(types.ErrCreatorGrantee, "%s holds %d grants from %s", bidder, len(grants), auction.Creator)
+	}
+
+	return nil
+}
+
+// GetCounterparties returns the addresses the creator traded with in auctions
+// settled at or after the given height: the winners of the creator's auctions
+// and the creators of the auctions the creator won.
+func (k Keeper) GetCounterparties(ctx sdk.Context, creator string, sinceHeight int64) map[string]struct{} {
+	counterparties := make(map[string]struct{})
+	for _, auction := range k.GetAllAuction(ctx) {
+		if auction.Status != types.AuctionStatus_AUCTION_STATUS_SETTLED || auction.EndHeight < sinceHeight {
+			continue
+		}
+
+		for _, winner := range auction.Winners() {
+			switch creator {
+			case auction.Creator:
+				counterparties[winner.Bidder] = struct{}{}
+			case winner.Bidder:
+				counterparties[auction.Creator] = struct{}{}
+			}
+		}
+	}
+
+	return counterparties
+}
+
+// IsLinkedToCreator reports whether the address holds an authz grant or a fee
+// allowance from the creator of the auction, or granted one to the creator.
+// Such an address is likely controlled by the creator.
+func (k Keeper) IsLinkedToCreator(ctx sdk.Context, auction types.Auction, address string) (bool, error) {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false, err
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(auction.Creator)
+	if err != nil {
+		return false, err
+	}
+
+	for _, pair := range [][2]sdk.AccAddress{{creatorAddress, accAddress}, {accAddress, creatorAddress}} {
+		granter, grantee := pair[0], pair[1]
+		if k.authzKeeper != nil {
+			grants, err := k.authzKeeper.GetAuthorizations(ctx, grantee, granter)
+			if err != nil {
+				return false, err
+			}
+			if len(grants) > 0 {
+				return true, nil
+			}
+		}
+		// the fee grant keeper reports a missing allowance as an error
+		if k.feegrantKeeper != nil {
+			if allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee); err == nil && allowance != nil {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"auction/testutil/sample"
+	"auction/x/auction/types"
+)
+
+func TestPlaceBidSelfBid(t *testing.T) {
+	_, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+	creator := sample.AccAddress()
+
+	res, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(creator, res.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.ErrorIs(t, err, types.ErrSelfBid)
+}
+
+func TestSuspiciousAuctionsQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+	creator, accomplice, stranger := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	// the accomplice wins an auction of the creator, then bids on the next one
+	past, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 1))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(accomplice, past.AuctionId, sdk.NewInt64Coin("token", 10)))
+	require.NoError(t, err)
+	sdkCtx = sdkCtx.WithBlockHeight(2)
+	k.EndBlocker(sdkCtx)
+
+	live, err := ms.CreateAuction(sdkCtx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin("token", 10), 10))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(stranger, live.AuctionId, sdk.NewInt64Coin("token", 10)))
+	require.NoError(t, err)
+	_, err = ms.PlaceBid(sdkCtx, types.NewMsgPlaceBid(accomplice, live.AuctionId, sdk.NewInt64Coin("token", 20)))
+	require.NoError(t, err)
+
+	res, err := k.SuspiciousAuctions(sdkCtx, &types.QuerySuspiciousAuctionsRequest{Creator: creator})
+	require.NoError(t, err)
+	require.Equal(t, []types.SuspiciousAuction{{
+		AuctionId:           live.AuctionId,
+		CounterpartyBidders: []string{accomplice},
+		OverlapPercent:      50,
+	}}, res.Auctions)
+
+	res, err = k.SuspiciousAuctions(sdkCtx, &types.QuerySuspiciousAuctionsRequest{Creator: creator, MinOverlapPercent: 60})
+	require.NoError(t, err)
+	require.Empty(t, res.Auctions)
+
+	// the settled auction falls out of a one block lookback
+	sdkCtx = sdkCtx.WithBlockHeight(5)
+	res, err = k.SuspiciousAuctions(sdkCtx, &types.QuerySuspiciousAuctionsRequest{Creator: creator, Lookback: 1})
+	require.NoError(t, err)
+	require.Empty(t, res.Auctions)
+
+	_, err = k.SuspiciousAuctions(sdkCtx, &types.QuerySuspiciousAuctionsRequest{Creator: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"auction/x/auction/types"
+)
+
+// TreasuryAddress returns the address creating treasury auctions. It holds
+// what treasury auctions return until it is sent back to the community pool.
+func TreasuryAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.TreasuryName)
+}
+
+// IsTreasuryAuction reports whether the auction sells a lot of the community
+// pool.
+func IsTreasuryAuction(auction types.Auction) bool {
+	return auction.Creator == TreasuryAddress().String()
+}
+
+// CreateTreasuryAuction moves the lot from the community pool to the treasury
+// address and sells it in a multi-unit auction created by the treasury. It
+// returns the ID of the new auction.
+func (k Keeper) CreateTreasuryAuction(ctx sdk.Context, msg *types.MsgCreateTreasuryAuction) (string, error) {
+	if k.distrKeeper == nil {
+		return "", types.ErrNoCommunityPool
+	}
+	if k.IsModulePaused(ctx) {
+		return "", errorsmod.Wrapf(types.ErrModulePaused, "auction creation is paused")
+	}
+
+	treasury := TreasuryAddress()
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(msg.Lot), treasury); err != nil {
+		return "", err
+	}
+
+	quantity := msg.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	lot := msg.Lot
+	create := types.NewMsgCreateAuction(treasury.String(), msg.Item, msg.StartingBid, msg.Duration)
+	create.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	create.Quantity = quantity
+	create.PricingRule = msg.PricingRule
+	create.Lot = &lot
+	if err := create.ValidateBasic(); err != nil {
+		return "", errorsmod.Wrap(types.ErrInvalidLot, err.Error())
+	}
+	res, err := k.AppendAuction(ctx, create)
+	if err != nil {
+		return "", err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"create_treasury_auction",
+			sdk.NewAttribute("auction_id", res.AuctionId),
+			sdk.NewAttribute("lot", lot.String()),
+		),
+	)
+
+	return res.AuctionId, nil
+}
+
+// returnToCommunityPool sends the proceeds and the unsold lot a closed
+// treasury auction returned to the treasury address back to the community
+// pool.
+func (k Keeper) returnToCommunityPool(ctx sdk.Context, auction types.Auction) error {
+	if !IsTreasuryAuction(auction) || k.distrKeeper == nil {
+		return nil
+	}
+
+	treasury := TreasuryAddress()
+	amount := k.bankKeeper.GetAllBalances(ctx, treasury)
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, amount, treasury); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"return_to_community_pool",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+
+	return nil
+}
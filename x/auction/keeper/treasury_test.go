@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+func TestCreateTreasuryAuction(t *testing.T) {
+	k, ctx, bank, distr := keepertest.AuctionKeeperWithDistribution(t)
+	ms := keeper.NewMsgServerImpl(k)
+	distrAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	bidder := sample.AccAddress()
+	distr.CommunityPool = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	bank.Balances = map[string]sdk.Coins{
+		distrAddress: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+		bidder:       sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+	}
+
+	msg := &types.MsgCreateTreasuryAuction{
+		Authority:   sample.AccAddress(),
+		Item:        "community pool atoms",
+		Lot:         sdk.NewInt64Coin("atom", 100),
+		StartingBid: sdk.NewInt64Coin("stake", 10),
+		Quantity:    4,
+	}
+	_, err := ms.CreateTreasuryAuction(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	msg.Authority = k.GetAuthority()
+	res, err := ms.CreateTreasuryAuction(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 900)), distr.CommunityPool)
+	auction, found := k.GetAuction(ctx, res.AuctionId)
+	require.True(t, found)
+	require.Equal(t, keeper.TreasuryAddress().String(), auction.Creator)
+	require.Equal(t, types.AuctionType_AUCTION_TYPE_MULTI_UNIT, auction.AuctionType)
+
+	// the proceeds and the unsold unit return to the community pool
+	bid := types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin("stake", 20))
+	bid.Quantity = 3
+	_, err = ms.PlaceBid(ctx, bid)
+	require.NoError(t, err)
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 925), sdk.NewInt64Coin("stake", 60)), distr.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 75), sdk.NewInt64Coin("stake", 440)), bank.Balances[bidder])
+	require.True(t, bank.Balances[keeper.TreasuryAddress().String()].IsZero())
+}
+
+func TestCreateTreasuryAuctionCancelled(t *testing.T) {
+	k, ctx, bank, distr := keepertest.AuctionKeeperWithDistribution(t)
+	ms := keeper.NewMsgServerImpl(k)
+	distr.CommunityPool = sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	bank.Balances = map[string]sdk.Coins{
+		authtypes.NewModuleAddress(distrtypes.ModuleName).String(): sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+	}
+
+	msg := &types.MsgCreateTreasuryAuction{
+		Authority:   k.GetAuthority(),
+		Item:        "community pool atoms",
+		Lot:         sdk.NewInt64Coin("atom", 100),
+		StartingBid: sdk.NewInt64Coin("stake", 10),
+	}
+	_, err := ms.CreateTreasuryAuction(ctx, msg)
+	require.ErrorIs(t, err, distrtypes.ErrBadDistribution)
+
+	msg.Lot = sdk.NewInt64Coin("atom", 50)
+	res, err := ms.CreateTreasuryAuction(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, distr.CommunityPool.IsZero())
+
+	// voiding the auction returns the lot
+	_, err = ms.AdminCancelAuction(ctx, &types.MsgAdminCancelAuction{Authority: k.GetAuthority(), AuctionId: res.AuctionId, Reason: "mistake"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), distr.CommunityPool)
+}
@@ -17,6 +17,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "SuspiciousAuctions",
+					Use:            "suspicious-auctions [creator]",
+					Short:          "Lists the live auctions of a creator whose bidders overlap with the creator's recent counterparties",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "AuctionSchedule",
+					Use:            "auction-schedule [schedule-id]",
+					Short:          "Shows a recurring auction schedule",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "schedule_id"}},
+				},
+				{
+					RpcMethod: "AuctionSchedules",
+					Use:       "auction-schedules",
+					Short:     "Lists the recurring auction schedules",
+				},
+				{
+					RpcMethod:      "AuctionRewards",
+					Use:            "auction-rewards [address]",
+					Short:          "Shows the incentive rebates accrued by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +51,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "PauseAuction",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResumeAuction",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AdminCancelAuction",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "FundIncentivePool",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateTreasuryAuction",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -9,6 +9,67 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if k.ShouldBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+
+	// Set all the auction
+	for _, elem := range genState.Auctions {
+		k.SetAuction(ctx, elem)
+		switch elem.Status {
+		case types.AuctionStatus_AUCTION_STATUS_OPEN:
+			k.InsertAuctionEndQueue(ctx, elem)
+		case types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT:
+			k.InsertPaymentQueue(ctx, elem)
+		case types.AuctionStatus_AUCTION_STATUS_UPCOMING:
+			k.InsertStartQueue(ctx, elem)
+		}
+	}
+
+	// Set all the bidder registrations
+	for _, elem := range genState.BidderRegistrations {
+		k.SetBidderRegistration(ctx, elem)
+	}
+
+	// Set all the lot bids, in the order they were placed
+	for _, elem := range genState.LotBids {
+		k.AppendLotBid(ctx, elem)
+	}
+
+	// Set all the auction schedules
+	for _, elem := range genState.AuctionSchedules {
+		k.SetAuctionSchedule(ctx, elem)
+		if elem.Status == types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+			k.InsertAuctionScheduleQueue(ctx, elem)
+		}
+	}
+	k.SetAuctionScheduleCount(ctx, genState.AuctionScheduleCount)
+
+	// Set the accrued incentive rebates
+	for _, elem := range genState.AuctionRewards {
+		k.SetAuctionReward(ctx, elem)
+	}
+	k.SetIncentiveEpoch(ctx, genState.IncentiveEpoch)
+
+	// Set the origins of the IBC bidders
+	for _, elem := range genState.IbcBidders {
+		k.SetIBCBidder(ctx, elem)
+	}
+
+	// Set auction count
+	k.SetAuctionCount(ctx, int(genState.AuctionCount))
+
+	// Set the module wide pause
+	k.SetModulePaused(ctx, genState.Paused)
+	k.SetHaltedSince(ctx, genState.HaltedSince)
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -19,6 +80,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.PortId = k.GetPort(ctx)
+
+	genesis.Auctions = k.GetAllAuction(ctx)
+	genesis.BidderRegistrations = k.GetAllBidderRegistration(ctx)
+	genesis.LotBids = k.GetAllLotBid(ctx)
+	genesis.AuctionSchedules = k.GetAllAuctionSchedule(ctx)
+	genesis.AuctionScheduleCount = k.GetAuctionScheduleCount(ctx)
+	genesis.AuctionRewards = k.GetAllAuctionReward(ctx)
+	genesis.IncentiveEpoch = k.GetIncentiveEpoch(ctx)
+	genesis.IbcBidders = k.GetAllIBCBidder(ctx)
+	genesis.AuctionCount = uint64(k.GetAuctionCount(ctx))
+	genesis.Paused = k.IsModulePaused(ctx)
+	genesis.HaltedSince = k.GetHaltedSince(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -14,7 +14,57 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		PortId: types.PortID,
 
+		Auctions: []types.Auction{
+			{
+				Id:     "auction-0",
+				Status: types.AuctionStatus_AUCTION_STATUS_OPEN,
+			},
+			{
+				Id:     "auction-1",
+				Status: types.AuctionStatus_AUCTION_STATUS_SETTLED,
+			},
+		},
+		AuctionCount: 2,
+		BidderRegistrations: []types.BidderRegistration{
+			{
+				AuctionId: "auction-0",
+				Bidder:    "bidder",
+			},
+		},
+		LotBids: []types.LotBid{
+			{
+				AuctionId: "auction-0",
+				LotId:     "lot-0",
+				Bid:       &types.Bid{Bidder: "alice"},
+			},
+			{
+				AuctionId: "auction-0",
+				LotId:     "lot-0",
+				Bid:       &types.Bid{Bidder: "bob"},
+			},
+		},
+		AuctionSchedules: []types.AuctionSchedule{
+			{
+				Id:         "schedule-0",
+				NextHeight: 5,
+				Status:     types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+			},
+			{
+				Id:     "schedule-1",
+				Status: types.ScheduleStatus_SCHEDULE_STATUS_COMPLETED,
+			},
+		},
+		AuctionScheduleCount: 2,
+		IbcBidders: []types.IBCBidder{
+			{
+				Address:   "ibc-bidder",
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+				Sender:    "remote-sender",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,5 +76,14 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.Auctions, got.Auctions)
+	require.Equal(t, genesisState.AuctionCount, got.AuctionCount)
+	require.ElementsMatch(t, genesisState.BidderRegistrations, got.BidderRegistrations)
+	require.Equal(t, genesisState.LotBids, got.LotBids)
+	require.ElementsMatch(t, genesisState.AuctionSchedules, got.AuctionSchedules)
+	require.Equal(t, genesisState.AuctionScheduleCount, got.AuctionScheduleCount)
+	require.Equal(t, []string{"schedule-0"}, k.GetDueAuctionScheduleIDs(ctx, 5))
+	require.ElementsMatch(t, genesisState.IbcBidders, got.IbcBidders)
+	require.Equal(t, genesisState.PortId, got.PortId)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,131 @@
+package auction
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the transfer stack to place the bids carried in the memo
+// of incoming ICS-20 transfers, see types.BidMemo. Any other packet is left to
+// the wrapped stack.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer stack.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket credits the transferred funds to the IBC bidder of the sender,
+// whatever the receiver of the transfer, and bids them on the auction of the
+// memo. A bid that fails is answered with an error acknowledgement, which
+// discards the transfer and refunds the sender on its chain.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	bidMemo, err := types.ParseBidMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if bidMemo == nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	bidder := keeper.IBCBidderAddress(packet.DestinationChannel, data.Sender)
+	data.Receiver = bidder.String()
+	packet.Data = data.GetBytes()
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := im.placeBid(ctx, packet, data, *bidMemo); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// placeBid bids the funds received by the IBC bidder of the transfer.
+func (im IBCMiddleware) placeBid(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, bidMemo types.PlaceBidMemo) error {
+	auction, found := im.keeper.GetAuction(ctx, bidMemo.AuctionId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidAuctionId, "auction %s does not exist", bidMemo.AuctionId)
+	}
+	// the bidder cannot sign the payment of a deferred-payment auction, and
+	// sellers of reverse auctions do not escrow their bids
+	if auction.DeferredPayment || auction.IsReverse() {
+		return errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s does not take bids over IBC", auction.Id)
+	}
+	// NFT lots that cannot be delivered wait for a claim the bidder cannot
+	// sign
+	if auction.NftLot != nil {
+		return errorsmod.Wrapf(types.ErrInvalidIBCBid, "auction %s sells an NFT lot", auction.Id)
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidIBCBid, "invalid amount %s", data.Amount)
+	}
+	funds := sdk.NewCoin(receivedDenom(packet, data.Denom), amount)
+	msg, err := bidMemo.NewMsgPlaceBid(data.Receiver, funds)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	im.keeper.SetIBCBidder(ctx, types.IBCBidder{
+		Address:   data.Receiver,
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Sender:    data.Sender,
+	})
+	if _, err := keeper.NewMsgServerImpl(im.keeper).PlaceBid(ctx, msg); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ibc_bid",
+			sdk.NewAttribute("auction_id", auction.Id),
+			sdk.NewAttribute("bidder", data.Receiver),
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("sender", data.Sender),
+			sdk.NewAttribute("amount", funds.String()),
+		),
+	)
+
+	return nil
+}
+
+// receivedDenom returns the denom the transfer module credits for a packet
+// denom on this chain.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the funds return to this chain, the transfer module removes the
+		// prefix it added when they left
+		unprefixed := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
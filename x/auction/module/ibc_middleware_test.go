@@ -0,0 +1,198 @@
+package auction_test
+
+import (
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "auction/testutil/keeper"
+	"auction/testutil/sample"
+	"auction/x/auction/keeper"
+	auction "auction/x/auction/module"
+	"auction/x/auction/types"
+)
+
+// mockTransferModule credits the funds of the transfers it receives to their
+// receiver, the way the transfer module mints vouchers.
+type mockTransferModule struct {
+	porttypes.IBCModule
+	bank     *keepertest.MockBankKeeper
+	received []transfertypes.FungibleTokenPacketData
+}
+
+func (m *mockTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	m.received = append(m.received, data)
+	amount, _ := math.NewIntFromString(data.Amount)
+	voucher := sdk.NewCoin(voucherDenom, amount)
+	m.bank.Balances[data.Receiver] = m.bank.Balances[data.Receiver].Add(voucher)
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// recvPacket passes the packet to the middleware and discards the transfer
+// when the acknowledgement is an error, as IBC core does.
+func recvPacket(ctx sdk.Context, middleware auction.IBCMiddleware, bank *keepertest.MockBankKeeper, packet channeltypes.Packet) bool {
+	balances := maps.Clone(bank.Balances)
+	cacheCtx, write := ctx.CacheContext()
+	if !middleware.OnRecvPacket(cacheCtx, packet, nil).Success() {
+		bank.Balances = balances
+		return false
+	}
+	write()
+	return true
+}
+
+var voucherDenom = transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+func transferPacket(sender string, amount string, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("uatom", amount, sender, sample.AccAddress(), memo)
+	return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-7", "transfer", "channel-0", clienttypes.ZeroHeight(), 0)
+}
+
+func TestIBCMiddlewarePlacesBid(t *testing.T) {
+	k, ctx, bank, transfer := keepertest.AuctionKeeperWithTransfer(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	ms := keeper.NewMsgServerImpl(k)
+	app := &mockTransferModule{bank: bank}
+	middleware := auction.NewIBCMiddleware(app, k)
+	creator := sample.AccAddress()
+	bidder := sample.AccAddress()
+	sender := "cosmos1remotesender"
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("token", 100)),
+		bidder:  sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 100)),
+	}
+
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin(voucherDenom, 10), 10))
+	require.NoError(t, err)
+
+	// transfers without an auction memo are left to the transfer module
+	packet := transferPacket(sender, "5", `{"forward":{}}`)
+	require.True(t, recvPacket(ctx, middleware, bank, packet))
+	require.NotEqual(t, keeper.IBCBidderAddress("channel-0", sender).String(), app.received[0].Receiver)
+
+	// a memo for the module that does not place a bid is rejected
+	packet = transferPacket(sender, "5", `{"auction":{"cancel":{}}}`)
+	require.False(t, recvPacket(ctx, middleware, bank, packet))
+
+	// a bid too low is rejected, the error acknowledgement refunds the sender
+	packet = transferPacket(sender, "5", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`"}}}`)
+	require.False(t, recvPacket(ctx, middleware, bank, packet))
+
+	packet = transferPacket(sender, "20", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`"}}}`)
+	require.True(t, recvPacket(ctx, middleware, bank, packet))
+	ibcBidder := keeper.IBCBidderAddress("channel-0", sender)
+	require.Equal(t, ibcBidder.String(), app.received[len(app.received)-1].Receiver)
+	origin, found := k.GetIBCBidder(ctx, ibcBidder.String())
+	require.True(t, found)
+	require.Equal(t, types.IBCBidder{Address: ibcBidder.String(), PortId: "transfer", ChannelId: "channel-0", Sender: sender}, origin)
+	got, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, ibcBidder.String(), got.Bids[0].Bidder)
+	require.True(t, bank.Balances[ibcBidder.String()].IsZero())
+
+	// the refund of the outbid IBC bidder goes back to the origin chain
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin(voucherDenom, 30)))
+	require.NoError(t, err)
+	require.Len(t, transfer.Sent, 1)
+	require.Equal(t, "channel-0", transfer.Sent[0].SourceChannel)
+	require.Equal(t, sender, transfer.Sent[0].Receiver)
+	require.Equal(t, sdk.NewInt64Coin(voucherDenom, 20), transfer.Sent[0].Token)
+	require.True(t, bank.Balances[ibcBidder.String()].IsZero())
+}
+
+func TestIBCMiddlewareReturnsWonLot(t *testing.T) {
+	k, ctx, bank, transfer := keepertest.AuctionKeeperWithTransfer(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	ms := keeper.NewMsgServerImpl(k)
+	middleware := auction.NewIBCMiddleware(&mockTransferModule{bank: bank}, k)
+	creator := sample.AccAddress()
+	sender := "cosmos1remotesender"
+	bank.Balances = map[string]sdk.Coins{
+		creator: sdk.NewCoins(sdk.NewInt64Coin("token", 100)),
+	}
+
+	msg := types.NewMsgCreateAuction(creator, "item", sdk.NewInt64Coin(voucherDenom, 10), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	msg.Quantity = 2
+	lot := sdk.NewInt64Coin("token", 100)
+	msg.Lot = &lot
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	// the transferred funds pay for the whole quantity
+	packet := transferPacket(sender, "45", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`","quantity":2}}}`)
+	require.False(t, recvPacket(ctx, middleware, bank, packet))
+	packet = transferPacket(sender, "40", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`","quantity":2}}}`)
+	require.True(t, recvPacket(ctx, middleware, bank, packet))
+	got, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Equal(t, sdk.NewInt64Coin(voucherDenom, 20), *got.Bids[0].BidAmount)
+
+	require.NoError(t, k.SettleAuction(ctx, res.AuctionId))
+	require.Len(t, transfer.Sent, 1)
+	require.Equal(t, sdk.NewInt64Coin("token", 100), transfer.Sent[0].Token)
+}
+
+func TestIBCMiddlewareKeepsFailedRefunds(t *testing.T) {
+	k, ctx, bank, transfer := keepertest.AuctionKeeperWithTransfer(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	ms := keeper.NewMsgServerImpl(k)
+	middleware := auction.NewIBCMiddleware(&mockTransferModule{bank: bank}, k)
+	bidder := sample.AccAddress()
+	sender := "cosmos1remotesender"
+	bank.Balances = map[string]sdk.Coins{
+		bidder: sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 100)),
+	}
+	res, err := ms.CreateAuction(ctx, types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin(voucherDenom, 10), 10))
+	require.NoError(t, err)
+
+	packet := transferPacket(sender, "20", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`"}}}`)
+	require.True(t, recvPacket(ctx, middleware, bank, packet))
+
+	// a closed channel does not prevent outbidding the IBC bidder
+	transfer.Err = errors.New("channel closed")
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin(voucherDenom, 30)))
+	require.NoError(t, err)
+	ibcBidder := keeper.IBCBidderAddress("channel-0", sender).String()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 20)), bank.Balances[ibcBidder])
+
+	// the next return sends the refund that was kept along
+	transfer.Err = nil
+	packet = transferPacket(sender, "40", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`"}}}`)
+	require.True(t, recvPacket(ctx, middleware, bank, packet))
+	_, err = ms.PlaceBid(ctx, types.NewMsgPlaceBid(bidder, res.AuctionId, sdk.NewInt64Coin(voucherDenom, 50)))
+	require.NoError(t, err)
+	require.Len(t, transfer.Sent, 1)
+	require.Equal(t, sdk.NewInt64Coin(voucherDenom, 60), transfer.Sent[0].Token)
+}
+
+func TestIBCMiddlewareRejectsNFTLot(t *testing.T) {
+	k, ctx, bank, nfts := keepertest.AuctionKeeperWithNFT(t)
+	ms := keeper.NewMsgServerImpl(k)
+	middleware := auction.NewIBCMiddleware(&mockTransferModule{bank: bank}, k)
+	creator := sample.AccAddress()
+	nfts.Mint("art", "mona-lisa", sdk.MustAccAddressFromBech32(creator))
+	bank.Balances = map[string]sdk.Coins{}
+
+	msg := types.NewMsgCreateAuction(creator, "painting", sdk.NewInt64Coin(voucherDenom, 10), 10)
+	msg.NftLot = &types.NFTLot{ClassId: "art", Id: "mona-lisa"}
+	res, err := ms.CreateAuction(ctx, msg)
+	require.NoError(t, err)
+
+	// an IBC bidder could not sign the claim of an NFT lot that failed to
+	// deliver
+	packet := transferPacket("cosmos1remotesender", "20", `{"auction":{"place_bid":{"auction_id":"`+res.AuctionId+`"}}}`)
+	require.False(t, recvPacket(ctx, middleware, bank, packet))
+	got, _ := k.GetAuction(ctx, res.AuctionId)
+	require.Empty(t, got.Bids)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	// this line is used by starport scaffolding # 1
@@ -121,7 +125,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -145,7 +151,9 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.BeginBlocker(sdkCtx)
 	return nil
 }
 
@@ -171,6 +179,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetAuctionHooks),
 	)
 }
 
@@ -182,8 +191,16 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
+	CircuitKeeper  circuitkeeper.Keeper     `optional:"true"`
+	AuthzKeeper    types.AuthzKeeper        `optional:"true"`
+	DistrKeeper    types.DistributionKeeper `optional:"true"`
+	FeegrantKeeper types.FeegrantKeeper     `optional:"true"`
+	NFTKeeper      types.NFTKeeper          `optional:"true"`
+
+	IBCKeeperFn        func() *ibckeeper.Keeper                   `optional:"true"`
+	CapabilityScopedFn func(string) capabilitykeeper.ScopedKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -200,13 +217,25 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 	storageAddress := sdk.MustAccAddressFromBech32("cosmos1nt2864p8390qm6tctx33e3zt8gh6aehpqv089g")
+	// the circuit keeper is optional, a zero value means x/circuit is not wired
+	var circuitKeeper types.CircuitKeeper
+	if in.CircuitKeeper.GetAuthority() != nil {
+		circuitKeeper = &in.CircuitKeeper
+	}
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
 		in.Logger,
 		authority.String(),
+		in.IBCKeeperFn,
+		in.CapabilityScopedFn,
 		in.BankKeeper,
 		in.AccountKeeper,
+		circuitKeeper,
+		in.AuthzKeeper,
+		in.DistrKeeper,
+		in.FeegrantKeeper,
+		in.NFTKeeper,
 		storageAddress,
 	)
 	m := NewAppModule(
@@ -218,3 +247,44 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{AuctionKeeper: k, Module: m}
 }
+
+// InvokeSetAuctionHooks sets the hooks provided by other modules on the
+// keeper, in the order given by the module config or else alphabetically by
+// module name.
+func InvokeSetAuctionHooks(
+	config *modulev1.Module,
+	keeper keeper.Keeper,
+	auctionHooks map[string]types.AuctionHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if config == nil || len(auctionHooks) == 0 {
+		return nil
+	}
+
+	modNames := make([]string, 0, len(auctionHooks))
+	for modName := range auctionHooks {
+		modNames = append(modNames, modName)
+	}
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	var multiHooks types.MultiAuctionHooks
+	for _, modName := range order {
+		hook, ok := auctionHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find auction hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
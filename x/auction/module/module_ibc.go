@@ -0,0 +1,365 @@
+package auction
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for the auction IBC application,
+// which lists auctions and places bids on the chain at the other end of its
+// channels and sends the results back. Channels of the ICS-721 version deliver
+// the NFT lots of the auctions to their winners instead.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	// Require portID is the portID module is bound to
+	boundPort := im.keeper.GetPort(ctx)
+	if boundPort != portID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	if err := validateChannelVersion(order, version); err != nil {
+		return "", err
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	// Require portID is the portID module is bound to
+	boundPort := im.keeper.GetPort(ctx)
+	if boundPort != portID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	if err := validateChannelVersion(order, counterpartyVersion); err != nil {
+		return "", err
+	}
+
+	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If module can already authenticate the capability then module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// Only claim channel capability passed back by IBC module if we do not already own it
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", err
+		}
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_,
+	counterpartyVersion string,
+) error {
+	version := types.Version
+	if im.keeper.IsNFTTransferChannel(ctx, portID, channelID) {
+		version = types.NFTTransferVersion
+	}
+	if counterpartyVersion != version {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for channels, the escrow of
+	// the remote bids in flight would be stuck
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. An error acknowledgement
+// makes IBC core discard the state written while receiving the packet.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if im.keeper.IsNFTTransferChannel(ctx, modulePacket.DestinationPort, modulePacket.DestinationChannel) {
+		return im.onRecvNFTTransferPacket(ctx, modulePacket)
+	}
+
+	var modulePacketData types.AuctionPacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AuctionPacketData_CreateRemoteAuctionPacket:
+		packetAck, err := im.keeper.OnRecvCreateRemoteAuctionPacket(ctx, modulePacket, *packet.CreateRemoteAuctionPacket)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		// Encode packet acknowledgment
+		packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+		}
+		return channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+	case *types.AuctionPacketData_PlaceRemoteBidPacket:
+		packetAck, err := im.keeper.OnRecvPlaceRemoteBidPacket(ctx, modulePacket, *packet.PlaceRemoteBidPacket)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		// Encode packet acknowledgment
+		packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+		}
+		return channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+	case *types.AuctionPacketData_AuctionResultPacket:
+		packetAck, err := im.keeper.OnRecvAuctionResultPacket(ctx, modulePacket, *packet.AuctionResultPacket)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		// Encode packet acknowledgment
+		packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+		}
+		return channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+	default:
+		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	if im.keeper.IsNFTTransferChannel(ctx, modulePacket.SourcePort, modulePacket.SourceChannel) {
+		data, err := unmarshalNFTTransferPacketData(modulePacket)
+		if err != nil {
+			return err
+		}
+		return im.keeper.OnAcknowledgementNFTTransferPacket(ctx, modulePacket, data, ack)
+	}
+
+	var modulePacketData types.AuctionPacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	var eventType string
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AuctionPacketData_CreateRemoteAuctionPacket:
+		err := im.keeper.OnAcknowledgementCreateRemoteAuctionPacket(ctx, modulePacket, *packet.CreateRemoteAuctionPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeCreateRemoteAuctionPacket
+	case *types.AuctionPacketData_PlaceRemoteBidPacket:
+		err := im.keeper.OnAcknowledgementPlaceRemoteBidPacket(ctx, modulePacket, *packet.PlaceRemoteBidPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypePlaceRemoteBidPacket
+	case *types.AuctionPacketData_AuctionResultPacket:
+		err := im.keeper.OnAcknowledgementAuctionResultPacket(ctx, modulePacket, *packet.AuctionResultPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeAuctionResultPacket
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAck, fmt.Sprintf("%v", ack)),
+		),
+	)
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if im.keeper.IsNFTTransferChannel(ctx, modulePacket.SourcePort, modulePacket.SourceChannel) {
+		data, err := unmarshalNFTTransferPacketData(modulePacket)
+		if err != nil {
+			return err
+		}
+		return im.keeper.OnTimeoutNFTTransferPacket(ctx, modulePacket, data)
+	}
+
+	var modulePacketData types.AuctionPacketData
+	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AuctionPacketData_CreateRemoteAuctionPacket:
+		err := im.keeper.OnTimeoutCreateRemoteAuctionPacket(ctx, modulePacket, *packet.CreateRemoteAuctionPacket)
+		if err != nil {
+			return err
+		}
+	case *types.AuctionPacketData_PlaceRemoteBidPacket:
+		err := im.keeper.OnTimeoutPlaceRemoteBidPacket(ctx, modulePacket, *packet.PlaceRemoteBidPacket)
+		if err != nil {
+			return err
+		}
+	case *types.AuctionPacketData_AuctionResultPacket:
+		err := im.keeper.OnTimeoutAuctionResultPacket(ctx, modulePacket, *packet.AuctionResultPacket)
+		if err != nil {
+			return err
+		}
+	default:
+		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+
+	return nil
+}
+
+// validateChannelVersion checks the version of a channel of the auction port.
+// ICS-721 channels are unordered like the ICS-721 applications they connect
+// to.
+func validateChannelVersion(order channeltypes.Order, version string) error {
+	switch version {
+	case types.Version:
+		return nil
+	case types.NFTTransferVersion:
+		if order != channeltypes.UNORDERED {
+			return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s or %s", version, types.Version, types.NFTTransferVersion)
+	}
+}
+
+// onRecvNFTTransferPacket handles the packets of ICS-721 channels, which can
+// only bring back NFT lots sent over them.
+func (im IBCModule) onRecvNFTTransferPacket(ctx sdk.Context, modulePacket channeltypes.Packet) ibcexported.Acknowledgement {
+	data, err := unmarshalNFTTransferPacketData(modulePacket)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if err := im.keeper.OnRecvNFTTransferPacket(ctx, modulePacket, data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func unmarshalNFTTransferPacketData(modulePacket channeltypes.Packet) (types.NonFungibleTokenPacketData, error) {
+	var data types.NonFungibleTokenPacketData
+	if err := json.Unmarshal(modulePacket.GetData(), &data); err != nil {
+		return data, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 packet data: %s", err.Error())
+	}
+	return data, nil
+}
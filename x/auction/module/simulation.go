@@ -1,8 +1,10 @@
 package auction
 
 import (
+	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -23,7 +25,31 @@ var (
 )
 
 const (
-// this line is used by starport scaffolding # simapp/module/const
+	opWeightMsgCreateAuction = "op_weight_msg_create_auction"
+	defaultWeightMsgCreateAuction int = 50
+
+	opWeightMsgPlaceBid = "op_weight_msg_place_bid"
+	defaultWeightMsgPlaceBid int = 100
+
+	opWeightMsgCancelAuction = "op_weight_msg_cancel_auction"
+	defaultWeightMsgCancelAuction int = 20
+
+	opWeightMsgRegisterBidder = "op_weight_msg_register_bidder"
+	defaultWeightMsgRegisterBidder int = 50
+
+	opWeightMsgCompletePurchase = "op_weight_msg_complete_purchase"
+	defaultWeightMsgCompletePurchase int = 50
+
+	opWeightMsgCreateAuctionSchedule = "op_weight_msg_create_auction_schedule"
+	defaultWeightMsgCreateAuctionSchedule int = 10
+
+	opWeightMsgCancelAuctionSchedule = "op_weight_msg_cancel_auction_schedule"
+	defaultWeightMsgCancelAuctionSchedule int = 5
+
+	opWeightMsgCreateTreasuryAuction = "op_weight_msg_create_treasury_auction"
+	defaultWeightMsgCreateTreasuryAuction int = 10
+
+	// this line is used by starport scaffolding # simapp/module/const
 )
 
 // GenerateGenesisState creates a randomized GenState of the module.
@@ -32,20 +58,130 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+
+	// Prefill open auctions without bids, as bids would need escrowed funds
+	// that the bank genesis does not know about.
+	auctions := make([]types.Auction, simState.Rand.Intn(10))
+	for i := range auctions {
+		startingBid := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(simState.Rand, 1, 1000)))
+		auctions[i] = types.Auction{
+			Creator:     accs[simState.Rand.Intn(len(accs))],
+			Item:        fmt.Sprintf("item-%s", simtypes.RandStringOfLength(simState.Rand, 10)),
+			StartingBid: &startingBid,
+			Id:          fmt.Sprintf("auction-%d", i),
+			Bids:        []*types.Bid{},
+			EndHeight:   int64(simtypes.RandIntBetween(simState.Rand, 1, 100)),
+			Status:      types.AuctionStatus_AUCTION_STATUS_OPEN,
+		}
+	}
+
 	auctionGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:       types.NewParams(
+			uint64(simtypes.RandIntBetween(simState.Rand, 1, 100)),
+			simState.Rand.Intn(2) == 0,
+			uint64(simtypes.RandIntBetween(simState.Rand, 1, 20)),
+			uint64(simState.Rand.Intn(10)),
+			sdk.DefaultBondDenom,
+			simState.Rand.Intn(2) == 0,
+			math.LegacyNewDecWithPrec(int64(simState.Rand.Intn(6)), 2),
+			uint64(simtypes.RandIntBetween(simState.Rand, 1, 50)),
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simState.Rand.Intn(1000)))),
+			types.DefaultBuybackMinPrices,
+		),
+		Auctions:     auctions,
+		AuctionCount: uint64(len(auctions)),
+		PortId:       types.PortID,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&auctionGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = auctionsimulation.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 
+	var weightMsgCreateAuction int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateAuction, &weightMsgCreateAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateAuction = defaultWeightMsgCreateAuction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateAuction,
+		auctionsimulation.SimulateMsgCreateAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgPlaceBid int
+	simState.AppParams.GetOrGenerate(opWeightMsgPlaceBid, &weightMsgPlaceBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceBid = defaultWeightMsgPlaceBid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlaceBid,
+		auctionsimulation.SimulateMsgPlaceBid(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCancelAuction int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelAuction, &weightMsgCancelAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelAuction = defaultWeightMsgCancelAuction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelAuction,
+		auctionsimulation.SimulateMsgCancelAuction(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRegisterBidder int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterBidder, &weightMsgRegisterBidder, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterBidder = defaultWeightMsgRegisterBidder
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterBidder,
+		auctionsimulation.SimulateMsgRegisterBidder(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCompletePurchase int
+	simState.AppParams.GetOrGenerate(opWeightMsgCompletePurchase, &weightMsgCompletePurchase, nil,
+		func(_ *rand.Rand) {
+			weightMsgCompletePurchase = defaultWeightMsgCompletePurchase
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCompletePurchase,
+		auctionsimulation.SimulateMsgCompletePurchase(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateAuctionSchedule int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateAuctionSchedule, &weightMsgCreateAuctionSchedule, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateAuctionSchedule = defaultWeightMsgCreateAuctionSchedule
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateAuctionSchedule,
+		auctionsimulation.SimulateMsgCreateAuctionSchedule(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCancelAuctionSchedule int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelAuctionSchedule, &weightMsgCancelAuctionSchedule, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelAuctionSchedule = defaultWeightMsgCancelAuctionSchedule
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelAuctionSchedule,
+		auctionsimulation.SimulateMsgCancelAuctionSchedule(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -54,6 +190,11 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
 	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCreateTreasuryAuction,
+			defaultWeightMsgCreateTreasuryAuction,
+			auctionsimulation.SimulateMsgCreateTreasuryAuction(am.keeper),
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
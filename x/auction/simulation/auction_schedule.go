@@ -0,0 +1,123 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgCreateAuctionSchedule generates a MsgCreateAuctionSchedule with
+// random values. Half of the schedules sell multi-unit lots funded from their
+// balance.
+func SimulateMsgCreateAuctionSchedule(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		startingBid := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000)))
+		template := types.AuctionTemplate{
+			Item:        fmt.Sprintf("item-%s", simtypes.RandStringOfLength(r, 10)),
+			StartingBid: &startingBid,
+			Duration:    uint64(simtypes.RandIntBetween(r, 1, 20)),
+		}
+		count := uint64(simtypes.RandIntBetween(r, 1, 5))
+
+		var balance *sdk.Coin
+		coinsSpent := sdk.NewCoins()
+		if r.Intn(2) == 0 {
+			template.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+			template.Quantity = uint64(simtypes.RandIntBetween(r, 1, 10))
+			lot := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(template.Quantity)*int64(simtypes.RandIntBetween(r, 1, 10)))
+			template.Lot = &lot
+			// fund a random number of rounds, the schedule completes early
+			// when the balance runs out
+			funded := sdk.NewCoin(lot.Denom, lot.Amount.MulRaw(int64(simtypes.RandIntBetween(r, 1, int(count)+1))))
+			if !bk.SpendableCoins(ctx, simAccount.Address).AmountOf(funded.Denom).GTE(funded.Amount) {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgCreateAuctionSchedule{}), "insufficient funds"), nil, nil
+			}
+			balance = &funded
+			coinsSpent = sdk.NewCoins(funded)
+		}
+
+		msg := types.NewMsgCreateAuctionSchedule(
+			simAccount.Address.String(),
+			template,
+			uint64(simtypes.RandIntBetween(r, 1, 10)),
+			count,
+			balance,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: coinsSpent,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgCancelAuctionSchedule generates a MsgCancelAuctionSchedule for a
+// random active schedule, signed by its creator.
+func SimulateMsgCancelAuctionSchedule(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelAuctionSchedule{})
+
+		var active []types.AuctionSchedule
+		for _, schedule := range k.GetAllAuctionSchedule(ctx) {
+			if schedule.Status == types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+				active = append(active, schedule)
+			}
+		}
+		if len(active) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active schedule"), nil, nil
+		}
+		schedule := active[r.Intn(len(active))]
+
+		simAccount, found := FindAccount(accs, schedule.Creator)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "creator not found"), nil, nil
+		}
+
+		msg := types.NewMsgCancelAuctionSchedule(simAccount.Address.String(), schedule.Id)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,60 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgCancelAuction generates a MsgCancelAuction for a random open
+// auction without bids, signed by its creator.
+func SimulateMsgCancelAuction(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelAuction{})
+
+		var cancellable []types.Auction
+		for _, auction := range k.GetAllAuction(ctx) {
+			if auction.Status == types.AuctionStatus_AUCTION_STATUS_OPEN && len(auction.Bids) == 0 {
+				cancellable = append(cancellable, auction)
+			}
+		}
+		if len(cancellable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no auction without bids"), nil, nil
+		}
+		auction := cancellable[r.Intn(len(cancellable))]
+
+		simAccount, found := FindAccount(accs, auction.Creator)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "creator not found"), nil, nil
+		}
+
+		msg := types.NewMsgCancelAuction(simAccount.Address.String(), auction.Id)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgCompletePurchase generates a MsgCompletePurchase for a random
+// auction awaiting payment, signed by its winning bidder.
+func SimulateMsgCompletePurchase(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCompletePurchase{})
+
+		var awaiting []types.Auction
+		for _, auction := range k.GetAllAuction(ctx) {
+			if auction.Status == types.AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT {
+				awaiting = append(awaiting, auction)
+			}
+		}
+		if len(awaiting) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no auction awaiting payment"), nil, nil
+		}
+		auction := awaiting[r.Intn(len(awaiting))]
+
+		simAccount, found := FindAccount(accs, auction.WinningBid.Bidder)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "winner not found"), nil, nil
+		}
+		price := *auction.WinningBid.BidAmount
+		if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(price.Denom).LT(price.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds to pay"), nil, nil
+		}
+
+		msg := types.NewMsgCompletePurchase(simAccount.Address.String(), auction.Id)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(price),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
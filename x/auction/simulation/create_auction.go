@@ -0,0 +1,104 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgCreateAuction generates a MsgCreateAuction with random values.
+func SimulateMsgCreateAuction(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		startingBid := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000)))
+		// keep auctions short so that they settle during the simulation
+		duration := uint64(simtypes.RandIntBetween(r, 1, 50))
+
+		msg := types.NewMsgCreateAuction(
+			simAccount.Address.String(),
+			fmt.Sprintf("item-%s", simtypes.RandStringOfLength(r, 10)),
+			startingBid,
+			duration,
+		)
+		// schedule the start of a fifth of the auctions
+		if r.Intn(5) == 0 {
+			msg.StartHeight = ctx.BlockHeight() + int64(simtypes.RandIntBetween(r, 1, 10))
+		}
+		// require a participation deposit from bidders in a third of the auctions
+		if r.Intn(3) == 0 {
+			deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 100)))
+			msg.Deposit = &deposit
+			msg.DeferredPayment = r.Intn(2) == 0
+		}
+
+		// sell a lot split in identical units in a quarter of the auctions, buy
+		// from the lowest bidder or sell a bundle of lots in some others
+		coinsSpent := sdk.NewCoins()
+		switch {
+		case msg.DeferredPayment:
+		case r.Intn(4) == 0:
+			msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+			msg.Quantity = uint64(simtypes.RandIntBetween(r, 1, 20))
+			if r.Intn(2) == 0 {
+				msg.PricingRule = types.PricingRule_PRICING_RULE_PAY_AS_BID
+			}
+			lot := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(msg.Quantity)*int64(simtypes.RandIntBetween(r, 1, 100)))
+			if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(lot.Denom).GTE(lot.Amount) {
+				msg.Lot = &lot
+				coinsSpent = sdk.NewCoins(lot)
+			}
+		case r.Intn(3) == 0:
+			if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(startingBid.Denom).GTE(startingBid.Amount) {
+				msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+				coinsSpent = sdk.NewCoins(startingBid)
+			}
+		case r.Intn(2) == 0:
+			msg.AuctionType = types.AuctionType_AUCTION_TYPE_BUNDLE
+			for i := 0; i < simtypes.RandIntBetween(r, 2, 5); i++ {
+				msg.Lots = append(msg.Lots, &types.AuctionLot{
+					Item: fmt.Sprintf("lot-%s", simtypes.RandStringOfLength(r, 10)),
+				})
+			}
+		}
+
+		// relist a fifth of the auctions when unsold, lowering the starting bid
+		// when the policy allows it
+		if r.Intn(5) == 0 {
+			msg.RelistPolicy = &types.RelistPolicy{MaxRelists: uint32(simtypes.RandIntBetween(r, 1, 4))}
+			maxDecrement := startingBid.Amount.QuoRaw(int64(msg.RelistPolicy.MaxRelists) + 1)
+			if msg.AuctionType != types.AuctionType_AUCTION_TYPE_REVERSE && maxDecrement.IsPositive() {
+				decrement := sdk.NewCoin(startingBid.Denom, maxDecrement)
+				msg.RelistPolicy.Decrement = &decrement
+			}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: coinsSpent,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,128 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"auction/x/auction/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding auction type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	auctionPrefix := types.KeyPrefix(types.AuctionKey)
+	auctionCountKey := append(types.KeyPrefix(types.AuctionKey), []byte("count")...)
+	endQueuePrefix := types.KeyPrefix(types.AuctionEndQueueKey)
+	paymentQueuePrefix := types.KeyPrefix(types.PaymentQueueKey)
+	startHeightQueuePrefix := types.KeyPrefix(types.StartHeightQueueKey)
+	startTimeQueuePrefix := types.KeyPrefix(types.StartTimeQueueKey)
+	registrationPrefix := types.KeyPrefix(types.BidderRegistrationKey)
+	lotBidPrefix := types.KeyPrefix(types.LotBidKey)
+	schedulePrefix := types.KeyPrefix(types.AuctionScheduleKey)
+	scheduleQueuePrefix := types.KeyPrefix(types.AuctionScheduleQueueKey)
+	rewardPrefix := types.KeyPrefix(types.AuctionRewardKey)
+
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key, auctionCountKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.AuctionScheduleCountKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.IncentiveEpochKey):
+			var epochA, epochB types.IncentiveEpoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.HasPrefix(kvA.Key, auctionPrefix):
+			var auctionA, auctionB types.Auction
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", auctionA, auctionB)
+
+		case bytes.HasPrefix(kvA.Key, endQueuePrefix):
+			entryA := kvA.Key[len(endQueuePrefix):]
+			entryB := kvB.Key[len(endQueuePrefix):]
+			return fmt.Sprintf(
+				"end height %d: %s\nend height %d: %s",
+				binary.BigEndian.Uint64(entryA[:8]), entryA[8:],
+				binary.BigEndian.Uint64(entryB[:8]), entryB[8:],
+			)
+
+		case bytes.HasPrefix(kvA.Key, paymentQueuePrefix):
+			entryA := kvA.Key[len(paymentQueuePrefix):]
+			entryB := kvB.Key[len(paymentQueuePrefix):]
+			return fmt.Sprintf(
+				"payment deadline %d: %s\npayment deadline %d: %s",
+				binary.BigEndian.Uint64(entryA[:8]), entryA[8:],
+				binary.BigEndian.Uint64(entryB[:8]), entryB[8:],
+			)
+
+		case bytes.HasPrefix(kvA.Key, startHeightQueuePrefix):
+			entryA := kvA.Key[len(startHeightQueuePrefix):]
+			entryB := kvB.Key[len(startHeightQueuePrefix):]
+			return fmt.Sprintf(
+				"start height %d: %s\nstart height %d: %s",
+				binary.BigEndian.Uint64(entryA[:8]), entryA[8:],
+				binary.BigEndian.Uint64(entryB[:8]), entryB[8:],
+			)
+
+		case bytes.HasPrefix(kvA.Key, startTimeQueuePrefix):
+			entryA := kvA.Key[len(startTimeQueuePrefix):]
+			entryB := kvB.Key[len(startTimeQueuePrefix):]
+			return fmt.Sprintf(
+				"start time %d: %s\nstart time %d: %s",
+				binary.BigEndian.Uint64(entryA[:8]), entryA[8:],
+				binary.BigEndian.Uint64(entryB[:8]), entryB[8:],
+			)
+
+		case bytes.HasPrefix(kvA.Key, registrationPrefix):
+			var registrationA, registrationB types.BidderRegistration
+			cdc.MustUnmarshal(kvA.Value, &registrationA)
+			cdc.MustUnmarshal(kvB.Value, &registrationB)
+			return fmt.Sprintf("%v\n%v", registrationA, registrationB)
+
+		case bytes.HasPrefix(kvA.Key, lotBidPrefix):
+			var lotBidA, lotBidB types.LotBid
+			cdc.MustUnmarshal(kvA.Value, &lotBidA)
+			cdc.MustUnmarshal(kvB.Value, &lotBidB)
+			return fmt.Sprintf("%v\n%v", lotBidA, lotBidB)
+
+		case bytes.HasPrefix(kvA.Key, schedulePrefix):
+			var scheduleA, scheduleB types.AuctionSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.HasPrefix(kvA.Key, scheduleQueuePrefix):
+			entryA := kvA.Key[len(scheduleQueuePrefix):]
+			entryB := kvB.Key[len(scheduleQueuePrefix):]
+			return fmt.Sprintf(
+				"next height %d: %s\nnext height %d: %s",
+				binary.BigEndian.Uint64(entryA[:8]), entryA[8:],
+				binary.BigEndian.Uint64(entryB[:8]), entryB[8:],
+			)
+
+		case bytes.HasPrefix(kvA.Key, rewardPrefix):
+			var rewardA, rewardB types.AuctionReward
+			cdc.MustUnmarshal(kvA.Value, &rewardA)
+			cdc.MustUnmarshal(kvB.Value, &rewardB)
+			return fmt.Sprintf("%v\n%v", rewardA, rewardB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"auction/x/auction/simulation"
+	"auction/x/auction/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	startingBid := sdk.NewInt64Coin("token", 10)
+	auction := types.Auction{
+		Creator:     "creator",
+		Item:        "item",
+		StartingBid: &startingBid,
+		Id:          "auction-0",
+		EndHeight:   42,
+		Status:      types.AuctionStatus_AUCTION_STATUS_OPEN,
+	}
+	registration := types.BidderRegistration{
+		AuctionId: auction.Id,
+		Bidder:    "bidder",
+		Deposit:   &startingBid,
+	}
+	lotBid := types.LotBid{
+		AuctionId: auction.Id,
+		LotId:     "lot-0",
+		Bid:       &types.Bid{Bidder: "bidder", BidAmount: &startingBid},
+	}
+	schedule := types.AuctionSchedule{
+		Id:       "schedule-0",
+		Creator:  "creator",
+		Template: types.AuctionTemplate{Item: "item", StartingBid: &startingBid, Duration: 10},
+		Interval: 5,
+		Count:    3,
+		Status:   types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}
+	reward := types.AuctionReward{
+		Address: "creator",
+		Amount:  sdk.NewCoins(startingBid),
+	}
+	epoch := types.IncentiveEpoch{Epoch: 3, Accrued: sdk.NewCoins(startingBid)}
+	params := types.DefaultParams()
+	queueKey := append(types.KeyPrefix(types.AuctionEndQueueKey), types.AuctionEndQueueEntryKey(42, "auction-0")...)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: append(types.KeyPrefix(types.AuctionKey), []byte("count")...), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: append(types.KeyPrefix(types.AuctionKey), []byte(auction.Id)...), Value: cdc.MustMarshal(&auction)},
+			{Key: queueKey, Value: []byte{}},
+			{Key: append(types.KeyPrefix(types.PaymentQueueKey), types.AuctionEndQueueEntryKey(42, "auction-0")...), Value: []byte{}},
+			{Key: append(types.KeyPrefix(types.StartHeightQueueKey), types.AuctionEndQueueEntryKey(42, "auction-0")...), Value: []byte{}},
+			{Key: append(types.KeyPrefix(types.StartTimeQueueKey), types.StartTimeQueueEntryKey(time.Unix(42, 0), "auction-0")...), Value: []byte{}},
+			{Key: append(types.KeyPrefix(types.BidderRegistrationKey), types.BidderRegistrationEntryKey(auction.Id, "bidder")...), Value: cdc.MustMarshal(&registration)},
+			{Key: append(types.KeyPrefix(types.LotBidKey), types.LotBidEntryKey(auction.Id, "lot-0", 0)...), Value: cdc.MustMarshal(&lotBid)},
+			{Key: types.AuctionScheduleCountKey, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: append(types.KeyPrefix(types.AuctionScheduleKey), []byte(schedule.Id)...), Value: cdc.MustMarshal(&schedule)},
+			{Key: append(types.KeyPrefix(types.AuctionScheduleQueueKey), types.AuctionEndQueueEntryKey(42, "schedule-0")...), Value: []byte{}},
+			{Key: append(types.KeyPrefix(types.AuctionRewardKey), []byte(reward.Address)...), Value: cdc.MustMarshal(&reward)},
+			{Key: types.IncentiveEpochKey, Value: cdc.MustMarshal(&epoch)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"AuctionCount", "1\n1"},
+		{"Auction", fmt.Sprintf("%v\n%v", auction, auction)},
+		{"AuctionEndQueue", "end height 42: auction-0\nend height 42: auction-0"},
+		{"PaymentQueue", "payment deadline 42: auction-0\npayment deadline 42: auction-0"},
+		{"StartHeightQueue", "start height 42: auction-0\nstart height 42: auction-0"},
+		{"StartTimeQueue", "start time 42: auction-0\nstart time 42: auction-0"},
+		{"BidderRegistration", fmt.Sprintf("%v\n%v", registration, registration)},
+		{"LotBid", fmt.Sprintf("%v\n%v", lotBid, lotBid)},
+		{"AuctionScheduleCount", "1\n1"},
+		{"AuctionSchedule", fmt.Sprintf("%v\n%v", schedule, schedule)},
+		{"AuctionScheduleQueue", "next height 42: schedule-0\nnext height 42: schedule-0"},
+		{"AuctionReward", fmt.Sprintf("%v\n%v", reward, reward)},
+		{"IncentiveEpoch", fmt.Sprintf("%v\n%v", epoch, epoch)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgPlaceBid generates a MsgPlaceBid that outbids the current highest
+// bid of a random open auction.
+func SimulateMsgPlaceBid(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgPlaceBid{})
+
+		var openAuctions []types.Auction
+		for _, auction := range k.GetAllAuction(ctx) {
+			if k.IsAuctionOpen(ctx, auction.Id) {
+				openAuctions = append(openAuctions, auction)
+			}
+		}
+		if len(openAuctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open auction"), nil, nil
+		}
+		auction := openAuctions[r.Intn(len(openAuctions))]
+
+		minBid := *auction.StartingBid
+		if len(auction.Bids) > 0 {
+			minBid = *auction.Bids[len(auction.Bids)-1].BidAmount
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if err := k.ValidateBidder(ctx, auction, simAccount.Address.String()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		if auction.RequiresDeposit() {
+			if _, found := k.GetBidderRegistration(ctx, auction.Id, simAccount.Address.String()); !found {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "bidder not registered"), nil, nil
+			}
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(minBid.Denom)
+		if auction.IsMultiUnit() {
+			return simulateMultiUnitBid(r, app, ctx, ak, bk, txGen, simAccount, auction, spendable)
+		}
+		if auction.IsReverse() {
+			// underbid the lowest bid, sellers do not escrow anything
+			amount, err := simtypes.RandPositiveInt(r, minBid.Amount)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate bid amount"), nil, err
+			}
+			msg := types.NewMsgPlaceBid(simAccount.Address.String(), auction.Id, sdk.NewCoin(minBid.Denom, amount))
+			return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+				R:             r,
+				App:           app,
+				TxGen:         txGen,
+				Msg:           msg,
+				Context:       ctx,
+				SimAccount:    simAccount,
+				AccountKeeper: ak,
+				Bankkeeper:    bk,
+				ModuleName:    types.ModuleName,
+			})
+		}
+		// bid on a single lot of bundle auctions half of the time
+		lotID := ""
+		if auction.IsBundle() && r.Intn(2) == 0 {
+			lotID = auction.Lots[r.Intn(len(auction.Lots))].Id
+			minBid = sdk.NewInt64Coin(minBid.Denom, 1)
+			if bids := k.GetLotBids(ctx, auction.Id, lotID); len(bids) > 0 {
+				minBid = *bids[len(bids)-1].Bid.BidAmount
+			}
+		}
+		if spendable.LT(minBid.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds to outbid"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable.Sub(minBid.Amount).AddRaw(1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate bid amount"), nil, err
+		}
+		bidAmount := sdk.NewCoin(minBid.Denom, minBid.Amount.Add(amount).SubRaw(1))
+
+		msg := types.NewMsgPlaceBid(simAccount.Address.String(), auction.Id, bidAmount)
+		msg.LotId = lotID
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(bidAmount),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// simulateMultiUnitBid delivers a bid for a random quantity of units at a
+// random price per unit the account can afford.
+func simulateMultiUnitBid(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	simAccount simtypes.Account,
+	auction types.Auction,
+	spendable math.Int,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(&types.MsgPlaceBid{})
+
+	quantity := uint64(simtypes.RandIntBetween(r, 1, int(auction.Quantity)+1))
+	maxPrice := spendable.Quo(math.NewIntFromUint64(quantity))
+	if maxPrice.LT(auction.StartingBid.Amount) {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds to bid"), nil, nil
+	}
+	amount, err := simtypes.RandPositiveInt(r, maxPrice.Sub(auction.StartingBid.Amount).AddRaw(1))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate bid amount"), nil, err
+	}
+	price := sdk.NewCoin(auction.StartingBid.Denom, auction.StartingBid.Amount.Add(amount).SubRaw(1))
+
+	msg := types.NewMsgPlaceBid(simAccount.Address.String(), auction.Id, price)
+	msg.Quantity = quantity
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Msg:             msg,
+		CoinsSpentInMsg: sdk.NewCoins(auction.BidEscrow(&types.Bid{BidAmount: &price, Quantity: quantity})),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
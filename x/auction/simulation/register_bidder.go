@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgRegisterBidder generates a MsgRegisterBidder for a random open
+// auction that requires a deposit.
+func SimulateMsgRegisterBidder(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterBidder{})
+
+		var depositAuctions []types.Auction
+		for _, auction := range k.GetAllAuction(ctx) {
+			if auction.RequiresDeposit() && k.IsAuctionOpen(ctx, auction.Id) {
+				depositAuctions = append(depositAuctions, auction)
+			}
+		}
+		if len(depositAuctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open auction requiring a deposit"), nil, nil
+		}
+		auction := depositAuctions[r.Intn(len(depositAuctions))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if err := k.ValidateBidder(ctx, auction, simAccount.Address.String()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		if _, found := k.GetBidderRegistration(ctx, auction.Id, simAccount.Address.String()); found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bidder already registered"), nil, nil
+		}
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(auction.Deposit.Denom).LT(auction.Deposit.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for deposit"), nil, nil
+		}
+
+		msg := types.NewMsgRegisterBidder(simAccount.Address.String(), auction.Id)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(*auction.Deposit),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"auction/x/auction/keeper"
+	"auction/x/auction/types"
+)
+
+// SimulateMsgCreateTreasuryAuction generates a MsgCreateTreasuryAuction
+// selling a small lot of bond denom from the community pool, for a gov
+// proposal. Lots larger than the community pool fail when executed.
+func SimulateMsgCreateTreasuryAuction(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+		quantity := uint64(simtypes.RandIntBetween(r, 1, 5))
+		lot := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(quantity)*int64(simtypes.RandIntBetween(r, 1, 100)))
+
+		return &types.MsgCreateTreasuryAuction{
+			Authority:   k.GetAuthority(),
+			Item:        fmt.Sprintf("treasury-%s", simtypes.RandStringOfLength(r, 10)),
+			Lot:         lot,
+			StartingBid: sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 10))),
+			Quantity:    quantity,
+			PricingRule: types.PricingRule(r.Intn(len(types.PricingRule_name))),
+			Duration:    uint64(simtypes.RandIntBetween(r, 1, 20)),
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &BidAuthorization{}
+
+// NewBidAuthorization creates a new BidAuthorization object.
+func NewBidAuthorization(spendLimit sdk.Coins) *BidAuthorization {
+	return &BidAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BidAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgPlaceBid{})
+}
+
+// Accept implements Authorization.Accept. The escrow of the bid is taken from
+// the spend limit and recorded against the auction. The authorization is kept
+// once the limit is spent, as refunded escrow is credited back. The escrow is
+// the bid amount times the quantity, which PlaceBid only accepts on
+// multi-unit auctions. Creators are not known from the message,
+// allowed_creators is enforced when the bid is placed.
+func (a BidAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	bid, ok := msg.(*MsgPlaceBid)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if bid.BidAmount == nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrap("missing bid amount")
+	}
+
+	if a.Expiration != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("bid authorization expired at %s", a.Expiration)
+	}
+	if len(a.AllowedAuctionIds) > 0 && !slices.Contains(a.AllowedAuctionIds, bid.AuctionId) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot bid on auction %s", bid.AuctionId)
+	}
+
+	escrow := *bid.BidAmount
+	if bid.Quantity > 0 {
+		escrow.Amount = escrow.Amount.Mul(math.NewIntFromUint64(bid.Quantity))
+	}
+	if len(a.MaxBid) > 0 && escrow.Amount.GT(a.MaxBid.AmountOf(escrow.Denom)) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("bid of %s exceeds the maximum bid %s", escrow, a.MaxBid)
+	}
+	limitLeft, isNegative := a.SpendLimit.SafeSub(escrow)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("bid of %s exceeds the spend limit %s", escrow, a.SpendLimit)
+	}
+
+	updated := a
+	updated.SpendLimit = limitLeft
+	updated.Escrowed = append([]BidEscrow{}, a.Escrowed...)
+	updated.addEscrow(bid.AuctionId, escrow)
+
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BidAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", a.SpendLimit)
+	}
+	if a.SpendLimit.IsZero() && len(a.Escrowed) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be zero")
+	}
+	if !a.MaxBid.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid max bid %s", a.MaxBid)
+	}
+	for _, creator := range a.AllowedCreators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed creator %s: %s", creator, err)
+		}
+	}
+	for _, escrow := range a.Escrowed {
+		if !escrow.Amount.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid escrow %s for auction %s", escrow.Amount, escrow.AuctionId)
+		}
+	}
+	return nil
+}
+
+// AllowsCreator reports whether the grantee may bid on the auctions of the
+// given creator.
+func (a BidAuthorization) AllowsCreator(creator string) bool {
+	return len(a.AllowedCreators) == 0 || slices.Contains(a.AllowedCreators, creator)
+}
+
+// EscrowOf returns the amount escrowed in the given auction by the bids
+// funded through the authorization.
+func (a BidAuthorization) EscrowOf(auctionID string) (sdk.Coin, bool) {
+	for _, escrow := range a.Escrowed {
+		if escrow.AuctionId == auctionID {
+			return escrow.Amount, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// ReleaseEscrow removes up to the given amount from the escrow recorded
+// against the auction and returns the amount removed.
+func (a *BidAuthorization) ReleaseEscrow(auctionID string, amount sdk.Coin) sdk.Coin {
+	for i, escrow := range a.Escrowed {
+		if escrow.AuctionId != auctionID || escrow.Amount.Denom != amount.Denom {
+			continue
+		}
+		released := sdk.NewCoin(amount.Denom, math.MinInt(amount.Amount, escrow.Amount.Amount))
+		a.Escrowed[i].Amount = escrow.Amount.Sub(released)
+		if a.Escrowed[i].Amount.IsZero() {
+			a.Escrowed = append(a.Escrowed[:i], a.Escrowed[i+1:]...)
+		}
+		return released
+	}
+	return sdk.NewCoin(amount.Denom, math.ZeroInt())
+}
+
+// addEscrow records the escrow of a bid against its auction.
+func (a *BidAuthorization) addEscrow(auctionID string, amount sdk.Coin) {
+	for i, escrow := range a.Escrowed {
+		if escrow.AuctionId == auctionID && escrow.Amount.Denom == amount.Denom {
+			a.Escrowed[i].Amount = escrow.Amount.Add(amount)
+			return
+		}
+	}
+	a.Escrowed = append(a.Escrowed, BidEscrow{AuctionId: auctionID, Amount: amount})
+}
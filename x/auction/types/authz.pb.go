@@ -0,0 +1,886 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: auction/auction/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BidAuthorization allows the grantee to bid on behalf of the granter. Unlike
+// a generic authorization, it tracks the bids it funded while they are in
+// escrow and credits refunded escrow back to its spend limit.
+type BidAuthorization struct {
+	// spend_limit is the amount the grantee can still escrow in bids.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// max_bid caps the amount escrowed by a single bid, per denom. Bids are not
+	// capped when empty.
+	MaxBid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_bid,json=maxBid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_bid"`
+	// allowed_auction_ids restricts bidding to the given auctions when set.
+	AllowedAuctionIds []string `protobuf:"bytes,3,rep,name=allowed_auction_ids,json=allowedAuctionIds,proto3" json:"allowed_auction_ids,omitempty"`
+	// allowed_creators restricts bidding to the auctions of the given creators
+	// when set.
+	AllowedCreators []string `protobuf:"bytes,4,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// expiration is the time after which bids are no longer accepted.
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// escrowed are the bids funded through the authorization that are still in
+	// escrow, per auction.
+	Escrowed []BidEscrow `protobuf:"bytes,6,rep,name=escrowed,proto3" json:"escrowed"`
+}
+
+func (m *BidAuthorization) Reset()         { *m = BidAuthorization{} }
+func (m *BidAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BidAuthorization) ProtoMessage()    {}
+func (*BidAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438178ef144145c6, []int{0}
+}
+func (m *BidAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidAuthorization.Merge(m, src)
+}
+func (m *BidAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidAuthorization proto.InternalMessageInfo
+
+func (m *BidAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *BidAuthorization) GetMaxBid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxBid
+	}
+	return nil
+}
+
+func (m *BidAuthorization) GetAllowedAuctionIds() []string {
+	if m != nil {
+		return m.AllowedAuctionIds
+	}
+	return nil
+}
+
+func (m *BidAuthorization) GetAllowedCreators() []string {
+	if m != nil {
+		return m.AllowedCreators
+	}
+	return nil
+}
+
+func (m *BidAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *BidAuthorization) GetEscrowed() []BidEscrow {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+// BidEscrow is the amount escrowed in an auction by the bids funded through a
+// BidAuthorization.
+type BidEscrow struct {
+	AuctionId string     `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *BidEscrow) Reset()         { *m = BidEscrow{} }
+func (m *BidEscrow) String() string { return proto.CompactTextString(m) }
+func (*BidEscrow) ProtoMessage()    {}
+func (*BidEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438178ef144145c6, []int{1}
+}
+func (m *BidEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidEscrow.Merge(m, src)
+}
+func (m *BidEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidEscrow proto.InternalMessageInfo
+
+func (m *BidEscrow) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *BidEscrow) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*BidAuthorization)(nil), "auction.auction.BidAuthorization")
+	proto.RegisterType((*BidEscrow)(nil), "auction.auction.BidEscrow")
+}
+
+func init() { proto.RegisterFile("auction/auction/authz.proto", fileDescriptor_438178ef144145c6) }
+
+var fileDescriptor_438178ef144145c6 = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0xce, 0x35, 0xf9, 0xf2, 0x91, 0xcb, 0xd0, 0xd6, 0x54, 0xe2, 0x1a, 0x84, 0x63, 0x65, 0xb2,
+	0x2a, 0xe5, 0x4c, 0x8a, 0x58, 0x10, 0x03, 0x71, 0xc4, 0x80, 0xc4, 0x64, 0x98, 0x58, 0xac, 0xb3,
+	0xef, 0x70, 0x4e, 0xc4, 0x3e, 0xe3, 0x3b, 0x43, 0xe8, 0x4f, 0x60, 0xea, 0xcf, 0x40, 0x9d, 0x3a,
+	0xf4, 0x47, 0x54, 0x4c, 0x15, 0x13, 0x13, 0x45, 0xc9, 0xd0, 0xbf, 0x81, 0x7c, 0x3e, 0xa7, 0xa5,
+	0x03, 0x88, 0xc5, 0xe7, 0x7b, 0xdf, 0xf7, 0xf1, 0xf3, 0xe8, 0x79, 0x5e, 0xc3, 0xfb, 0xa4, 0x8c,
+	0x15, 0x17, 0x99, 0x77, 0x7d, 0xaa, 0xf9, 0x11, 0xce, 0x0b, 0xa1, 0x84, 0xb5, 0x6d, 0x8a, 0xd8,
+	0x9c, 0x83, 0x5d, 0x92, 0xf2, 0x4c, 0x78, 0xfa, 0x59, 0xcf, 0x0c, 0xec, 0x58, 0xc8, 0x54, 0x48,
+	0x2f, 0x22, 0x92, 0x79, 0x1f, 0x26, 0x11, 0x53, 0x64, 0xe2, 0xc5, 0x82, 0x67, 0xa6, 0xbf, 0x5f,
+	0xf7, 0x43, 0x7d, 0xf3, 0xea, 0x8b, 0x69, 0xed, 0x25, 0x22, 0x11, 0x75, 0xbd, 0x7a, 0x33, 0xd5,
+	0x61, 0x22, 0x44, 0xb2, 0x60, 0x9e, 0xbe, 0x45, 0xe5, 0x5b, 0x4f, 0xf1, 0x94, 0x49, 0x45, 0xd2,
+	0xbc, 0x1e, 0x18, 0x9d, 0x74, 0xe0, 0x8e, 0xcf, 0xe9, 0xb4, 0x54, 0x73, 0x51, 0xf0, 0x23, 0x52,
+	0x29, 0xb3, 0xde, 0xc3, 0xbe, 0xcc, 0x59, 0x46, 0xc3, 0x05, 0x4f, 0xb9, 0x42, 0xc0, 0x69, 0xbb,
+	0xfd, 0xc3, 0x7d, 0x6c, 0xf8, 0x2a, 0x71, 0xd8, 0x88, 0xc3, 0x33, 0xc1, 0x33, 0xff, 0xf1, 0xf9,
+	0x8f, 0x61, 0xeb, 0xe4, 0x72, 0xe8, 0x26, 0x5c, 0xcd, 0xcb, 0x08, 0xc7, 0x22, 0x35, 0xe2, 0xcc,
+	0x31, 0x96, 0xf4, 0x9d, 0xa7, 0x3e, 0xe5, 0x4c, 0x6a, 0x80, 0xfc, 0x72, 0x75, 0x7a, 0x00, 0x02,
+	0xa8, 0x49, 0x5e, 0x56, 0x1c, 0x16, 0x85, 0xff, 0xa7, 0x64, 0x19, 0x46, 0x9c, 0xa2, 0xad, 0xbf,
+	0xd1, 0x3d, 0xfc, 0x57, 0xba, 0xa0, 0x9b, 0x92, 0xa5, 0xcf, 0xa9, 0x85, 0xe1, 0x5d, 0xb2, 0x58,
+	0x88, 0x8f, 0x8c, 0x86, 0x26, 0x85, 0x90, 0x53, 0x89, 0xda, 0x4e, 0xdb, 0xed, 0x05, 0xbb, 0xa6,
+	0x35, 0xad, 0x3b, 0x2f, 0xa8, 0xb4, 0x66, 0x70, 0xa7, 0x99, 0x8f, 0x0b, 0x46, 0x94, 0x28, 0x24,
+	0xea, 0x54, 0xc3, 0x3e, 0xfa, 0x76, 0x36, 0xde, 0x33, 0x0a, 0xa7, 0x94, 0x16, 0x4c, 0xca, 0x57,
+	0xaa, 0xe0, 0x59, 0x12, 0x6c, 0x1b, 0xc4, 0xcc, 0x00, 0xac, 0x67, 0x10, 0xb2, 0x65, 0xce, 0x0b,
+	0xed, 0x2d, 0xfa, 0xcf, 0x01, 0x6e, 0xff, 0x70, 0x80, 0xeb, 0x60, 0x70, 0x13, 0x0c, 0x7e, 0xdd,
+	0x04, 0xe3, 0x77, 0x8e, 0x2f, 0x87, 0x20, 0xb8, 0x81, 0xb1, 0xa6, 0xf0, 0x0e, 0x93, 0x71, 0x51,
+	0x7d, 0x15, 0x75, 0xb5, 0x3b, 0x03, 0x7c, 0x6b, 0x9b, 0xb0, 0xcf, 0xe9, 0x73, 0x3d, 0xe3, 0xf7,
+	0x2a, 0x7b, 0x6a, 0x87, 0x37, 0xb0, 0x27, 0xb3, 0xaf, 0x67, 0xe3, 0x91, 0xd1, 0x5b, 0x6f, 0x65,
+	0x63, 0xe9, 0x6f, 0xd1, 0x7f, 0xbe, 0x3a, 0x3d, 0x40, 0xcd, 0xf6, 0xde, 0xde, 0x8b, 0xd1, 0x1c,
+	0xf6, 0x36, 0x34, 0xd6, 0x03, 0x08, 0xaf, 0x3d, 0x44, 0xc0, 0x01, 0x6e, 0x2f, 0xe8, 0x91, 0xc6,
+	0x3b, 0xeb, 0x29, 0xec, 0x92, 0x54, 0x94, 0x99, 0x42, 0x5b, 0x0e, 0xf8, 0x73, 0x9e, 0x37, 0x04,
+	0x1b, 0x8c, 0x3f, 0x39, 0x5f, 0xd9, 0xe0, 0x62, 0x65, 0x83, 0x9f, 0x2b, 0x1b, 0x1c, 0xaf, 0xed,
+	0xd6, 0xc5, 0xda, 0x6e, 0x7d, 0x5f, 0xdb, 0xad, 0x37, 0xf7, 0x1a, 0x75, 0xcb, 0xcd, 0x5f, 0xa6,
+	0x93, 0x8e, 0xba, 0xda, 0xca, 0x47, 0xbf, 0x06, 0x00, 0xc3, 0x3e, 0x2c, 0x2d, 0x85, 0x03, 0x00,
+	0x00,
+}
+
+func (m *BidAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AllowedCreators) > 0 {
+		for iNdEx := len(m.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedCreators[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedAuctionIds) > 0 {
+		for iNdEx := len(m.AllowedAuctionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAuctionIds[iNdEx])
+			copy(dAtA[i:], m.AllowedAuctionIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedAuctionIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxBid) > 0 {
+		for iNdEx := len(m.MaxBid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxBid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BidAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.MaxBid) > 0 {
+		for _, e := range m.MaxBid {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedAuctionIds) > 0 {
+		for _, s := range m.AllowedAuctionIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedCreators) > 0 {
+		for _, s := range m.AllowedCreators {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BidEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BidAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBid = append(m.MaxBid, types.Coin{})
+			if err := m.MaxBid[len(m.MaxBid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAuctionIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAuctionIds = append(m.AllowedAuctionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCreators = append(m.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, BidEscrow{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
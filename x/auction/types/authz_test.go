@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"auction/testutil/sample"
+	"auction/x/auction/types"
+)
+
+func TestBidAuthorizationAccept(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Time: now})
+	bidder := sample.AccAddress()
+
+	authorization := types.NewBidAuthorization(sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	authorization.MaxBid = sdk.NewCoins(sdk.NewInt64Coin("token", 60))
+	authorization.AllowedAuctionIds = []string{"auction-0", "auction-1"}
+
+	_, err := authorization.Accept(ctx, types.NewMsgCancelAuction(bidder, "auction-0"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	_, err = authorization.Accept(ctx, types.NewMsgPlaceBid(bidder, "auction-2", sdk.NewInt64Coin("token", 10)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the maximum applies to the whole escrow of a multi-unit bid
+	msg := types.NewMsgPlaceBid(bidder, "auction-1", sdk.NewInt64Coin("token", 25))
+	msg.Quantity = 3
+	_, err = authorization.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Quantity = 2
+	res, err := authorization.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	updated := res.Updated.(*types.BidAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 50)), updated.SpendLimit)
+	escrow, found := updated.EscrowOf("auction-1")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("token", 50), escrow)
+	require.Empty(t, authorization.Escrowed)
+
+	// the authorization is kept once spent, refunds are credited back
+	res, err = updated.Accept(ctx, types.NewMsgPlaceBid(bidder, "auction-0", sdk.NewInt64Coin("token", 50)))
+	require.NoError(t, err)
+	updated = res.Updated.(*types.BidAuthorization)
+	require.True(t, updated.SpendLimit.IsZero())
+	require.Len(t, updated.Escrowed, 2)
+
+	_, err = updated.Accept(ctx, types.NewMsgPlaceBid(bidder, "auction-0", sdk.NewInt64Coin("token", 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	released := updated.ReleaseEscrow("auction-1", sdk.NewInt64Coin("token", 80))
+	require.Equal(t, sdk.NewInt64Coin("token", 50), released)
+	_, found = updated.EscrowOf("auction-1")
+	require.False(t, found)
+
+	expiration := now
+	authorization.Expiration = &expiration
+	_, err = authorization.Accept(ctx, types.NewMsgPlaceBid(bidder, "auction-0", sdk.NewInt64Coin("token", 10)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestBidAuthorizationValidateBasic(t *testing.T) {
+	authorization := types.NewBidAuthorization(sdk.NewCoins())
+	require.ErrorIs(t, authorization.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+
+	authorization.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("token", 100))
+	authorization.AllowedCreators = []string{"creator"}
+	require.ErrorIs(t, authorization.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	authorization.AllowedCreators = []string{sample.AccAddress()}
+	require.NoError(t, authorization.ValidateBasic())
+	require.True(t, authorization.AllowsCreator(authorization.AllowedCreators[0]))
+	require.False(t, authorization.AllowsCreator(sample.AccAddress()))
+}
@@ -1,12 +1,18 @@
 package types
 
 import (
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	// this line is used by starport scaffolding # 1
 )
 
+// ModuleCdc encodes the acknowledgements of the auction IBC application.
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// this line is used by starport scaffolding # 3
 
@@ -14,6 +20,28 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCreateAuction{},
 		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgPauseAuction{},
+		&MsgResumeAuction{},
+		&MsgAdminCancelAuction{},
+		&MsgRegisterBidder{},
+		&MsgCompletePurchase{},
+		&MsgCreateAuctionSchedule{},
+		&MsgCancelAuctionSchedule{},
+		&MsgFundIncentivePool{},
+		&MsgClaimAuctionRewards{},
+		&MsgCreateTreasuryAuction{},
+		&MsgSendCreateRemoteAuction{},
+		&MsgSendPlaceRemoteBid{},
+		&MsgGrantBidFeeAllowance{},
+		&MsgClaimNFTLot{},
+		&MsgRevealReserve{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&BidAuthorization{},
+	)
+	registry.RegisterImplementations((*feegrant.FeeAllowanceI)(nil),
+		&BidFeeAllowance{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
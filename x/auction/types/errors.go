@@ -8,8 +8,39 @@ import (
 
 // x/auction module sentinel errors
 var (
-	ErrInvalidSigner    = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample           = sdkerrors.Register(ModuleName, 1101, "sample error")
-	ErrInvalidAuctionId = sdkerrors.Register(ModuleName, 1102, "invalid auction ID")
-	ErrInvalidBidAmount = sdkerrors.Register(ModuleName, 1103, "invalid bid amount")
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample               = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidAuctionId     = sdkerrors.Register(ModuleName, 1102, "invalid auction ID")
+	ErrInvalidBidAmount     = sdkerrors.Register(ModuleName, 1103, "invalid bid amount")
+	ErrAuctionNotOpen       = sdkerrors.Register(ModuleName, 1104, "auction is not open")
+	ErrUnauthorized         = sdkerrors.Register(ModuleName, 1105, "unauthorized")
+	ErrAuctionHasBids       = sdkerrors.Register(ModuleName, 1106, "auction has bids")
+	ErrAuctionPaused        = sdkerrors.Register(ModuleName, 1107, "auction is paused")
+	ErrModulePaused         = sdkerrors.Register(ModuleName, 1108, "auction module is paused")
+	ErrModuleNotPaused      = sdkerrors.Register(ModuleName, 1109, "auction module is not paused")
+	ErrInvalidCancelReason  = sdkerrors.Register(ModuleName, 1110, "invalid cancel reason")
+	ErrSelfBid              = sdkerrors.Register(ModuleName, 1111, "creator cannot bid on own auction")
+	ErrCreatorGrantee       = sdkerrors.Register(ModuleName, 1112, "bidder holds an authz grant from the creator")
+	ErrBidderNotRegistered  = sdkerrors.Register(ModuleName, 1113, "bidder did not lock the auction deposit")
+	ErrAlreadyRegistered    = sdkerrors.Register(ModuleName, 1114, "bidder is already registered")
+	ErrDepositNotRequired   = sdkerrors.Register(ModuleName, 1115, "auction does not require a deposit")
+	ErrNotAwaitingPayment   = sdkerrors.Register(ModuleName, 1116, "auction is not awaiting payment")
+	ErrNotWinner            = sdkerrors.Register(ModuleName, 1117, "not the winning bidder")
+	ErrInvalidBidQuantity   = sdkerrors.Register(ModuleName, 1118, "invalid bid quantity")
+	ErrInvalidLot           = sdkerrors.Register(ModuleName, 1119, "invalid lot")
+	ErrInvalidStart         = sdkerrors.Register(ModuleName, 1120, "invalid auction start")
+	ErrInvalidScheduleId    = sdkerrors.Register(ModuleName, 1121, "invalid schedule ID")
+	ErrScheduleNotActive    = sdkerrors.Register(ModuleName, 1122, "schedule is not active")
+	ErrInvalidScheduleEnd   = sdkerrors.Register(ModuleName, 1123, "invalid schedule end height")
+	ErrInvalidLiquidation   = sdkerrors.Register(ModuleName, 1124, "invalid liquidation")
+	ErrNoRewards            = sdkerrors.Register(ModuleName, 1125, "no rewards to claim")
+	ErrNoCommunityPool      = sdkerrors.Register(ModuleName, 1126, "community pool is not available")
+	ErrNoFeegrant           = sdkerrors.Register(ModuleName, 1127, "fee allowances are not available")
+	ErrInvalidIBCBid        = sdkerrors.Register(ModuleName, 1128, "invalid bid over IBC")
+	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1129, "invalid packet timeout")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1130, "invalid version")
+	ErrInvalidNFTLot        = sdkerrors.Register(ModuleName, 1131, "invalid NFT lot")
+	ErrNoNFT                = sdkerrors.Register(ModuleName, 1132, "NFT lots are not available")
+	ErrNFTLotNotClaimable   = sdkerrors.Register(ModuleName, 1133, "NFT lot is not claimable")
+	ErrInvalidReserve       = sdkerrors.Register(ModuleName, 1134, "invalid reserve")
 )
@@ -0,0 +1,12 @@
+package types
+
+// IBC events
+const (
+	EventTypeCreateRemoteAuctionPacket = "create_remote_auction_packet"
+	EventTypePlaceRemoteBidPacket      = "place_remote_bid_packet"
+	EventTypeAuctionResultPacket       = "auction_result_packet"
+
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAck        = "acknowledgement"
+	AttributeKeyAckError   = "error"
+)
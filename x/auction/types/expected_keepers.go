@@ -2,8 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -18,9 +23,68 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
 	// Methods imported from bank should be defined here
 }
 
+// CircuitKeeper defines the expected interface for the Circuit module.
+type CircuitKeeper interface {
+	IsAllowed(ctx context.Context, msgURL string) (bool, error)
+}
+
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// AuthzKeeper defines the expected interface for the Authz module.
+type AuthzKeeper interface {
+	GetAuthorizations(ctx context.Context, grantee, granter sdk.AccAddress) ([]authz.Authorization, error)
+	GranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error)
+	SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
+// FeegrantKeeper defines the expected interface for the Feegrant module.
+type FeegrantKeeper interface {
+	GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// NFTKeeper defines the expected interface for the NFT module.
+type NFTKeeper interface {
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx context.Context, classID string, nftID string) sdk.AccAddress
+	Transfer(ctx context.Context, classID string, nftID string, receiver sdk.AccAddress) error
+}
+
+// TransferKeeper defines the expected interface for the IBC transfer module.
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// AuctionHooks event hooks for auction activity (noalias)
+type AuctionHooks interface {
+	AfterAuctionCreated(ctx context.Context, auctionID string) error                                      // Must be called when an auction is created
+	AfterBidPlaced(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error   // Must be called when a bid is placed
+	AfterBidRefunded(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error // Must be called when escrowed bid funds are returned
+	AfterAuctionSettled(ctx context.Context, auctionID string) error                                      // Must be called when an auction is settled
+	AfterAuctionCancelled(ctx context.Context, auctionID string) error                                    // Must be called when an auction is cancelled
+}
+
+// LiquidationHooks is implemented by the modules starting liquidation auctions
+// to learn about debts the auctions did not cover.
+type LiquidationHooks interface {
+	AfterLiquidationShortfall(ctx context.Context, auctionID string, beneficiary sdk.AccAddress, shortfall sdk.Coin) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ feegrant.FeeAllowanceI = &BidFeeAllowance{}
+
+// NewBidFeeAllowance creates a new BidFeeAllowance object.
+func NewBidFeeAllowance(auctionID string, spendLimit sdk.Coins) *BidFeeAllowance {
+	return &BidFeeAllowance{
+		AuctionId:  auctionID,
+		SpendLimit: spendLimit,
+	}
+}
+
+// Accept implements FeeAllowanceI.Accept. Only transactions made of bids on
+// the auction of the allowance are paid for.
+func (a *BidFeeAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.Expiration != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*a.Expiration) {
+		return true, errorsmod.Wrapf(feegrant.ErrFeeLimitExpired, "auction %s is closed", a.AuctionId)
+	}
+	for _, msg := range msgs {
+		bid, ok := msg.(*MsgPlaceBid)
+		if !ok || bid.AuctionId != a.AuctionId {
+			return false, errorsmod.Wrapf(feegrant.ErrMessageNotAllowed, "only bids on auction %s are allowed", a.AuctionId)
+		}
+	}
+
+	left, isNegative := a.SpendLimit.SafeSub(fee...)
+	if isNegative {
+		return false, errorsmod.Wrapf(feegrant.ErrFeeLimitExceeded, "fee %s exceeds the spend limit %s", fee, a.SpendLimit)
+	}
+	a.SpendLimit = left
+
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic.
+func (a BidFeeAllowance) ValidateBasic() error {
+	if a.AuctionId == "" {
+		return errorsmod.Wrap(ErrInvalidAuctionId, "auction ID cannot be empty")
+	}
+	if !a.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", a.SpendLimit)
+	}
+	if a.SpendLimit.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be empty")
+	}
+	return nil
+}
+
+// ExpiresAt implements FeeAllowanceI.ExpiresAt.
+func (a BidFeeAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: auction/auction/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BidFeeAllowance is granted by the fee sponsor of an auction to its bidders.
+// It only pays the fees of transactions made of bids on that auction.
+type BidFeeAllowance struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// spend_limit is the amount of fees the allowance can still pay. The
+	// allowance is only bounded by the sponsor balance when empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiration is set when the auction closes, the allowance is removed the
+	// next time it is used.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *BidFeeAllowance) Reset()         { *m = BidFeeAllowance{} }
+func (m *BidFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*BidFeeAllowance) ProtoMessage()    {}
+func (*BidFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b7195dfff39d62a, []int{0}
+}
+func (m *BidFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidFeeAllowance.Merge(m, src)
+}
+func (m *BidFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidFeeAllowance proto.InternalMessageInfo
+
+func (m *BidFeeAllowance) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *BidFeeAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *BidFeeAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BidFeeAllowance)(nil), "auction.auction.BidFeeAllowance")
+}
+
+func init() { proto.RegisterFile("auction/auction/feegrant.proto", fileDescriptor_4b7195dfff39d62a) }
+
+var fileDescriptor_4b7195dfff39d62a = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x93, 0xf6, 0x72, 0xa1, 0xd3, 0x45, 0xb9, 0xe1, 0xc2, 0x6d, 0x0b, 0x77, 0x52, 0x04,
+	0x21, 0x08, 0x9d, 0xb1, 0x75, 0xe7, 0x4a, 0x23, 0x0a, 0x05, 0x57, 0xc5, 0x95, 0x9b, 0x32, 0x49,
+	0xa6, 0x71, 0x30, 0xc9, 0x09, 0x9d, 0xa9, 0xd6, 0x57, 0x70, 0xd5, 0xb7, 0x10, 0x5c, 0xb9, 0xf0,
+	0x21, 0x8a, 0xab, 0x2e, 0x5d, 0x59, 0x69, 0x17, 0xbe, 0x86, 0x24, 0x99, 0x94, 0xe2, 0x26, 0x27,
+	0xe7, 0x3b, 0x7f, 0xf2, 0xfb, 0x72, 0x10, 0x66, 0x53, 0x5f, 0x09, 0x48, 0x68, 0x19, 0xc7, 0x9c,
+	0x87, 0x13, 0x96, 0x28, 0x92, 0x4e, 0x40, 0x81, 0xd5, 0xd0, 0x3a, 0xd1, 0xb1, 0xfd, 0x87, 0xc5,
+	0x22, 0x01, 0x9a, 0x3f, 0x8b, 0x9e, 0x36, 0xf6, 0x41, 0xc6, 0x20, 0xa9, 0xc7, 0x24, 0xa7, 0x77,
+	0x3d, 0x8f, 0x2b, 0xd6, 0xa3, 0x3e, 0x88, 0x44, 0xd7, 0x5b, 0x45, 0x7d, 0x94, 0x67, 0xb4, 0x48,
+	0x74, 0xe9, 0x6f, 0x08, 0x21, 0x14, 0x7a, 0xf6, 0xa6, 0x55, 0x3b, 0x04, 0x08, 0x23, 0x4e, 0xf3,
+	0xcc, 0x9b, 0x8e, 0xa9, 0x12, 0x31, 0x97, 0x8a, 0xc5, 0x69, 0xd1, 0xb0, 0xf7, 0x54, 0x41, 0x0d,
+	0x57, 0x04, 0x17, 0x9c, 0x9f, 0x46, 0x11, 0xdc, 0xb3, 0xc4, 0xe7, 0xd6, 0x7f, 0x84, 0x34, 0xe3,
+	0x48, 0x04, 0x4d, 0xb3, 0x63, 0x3a, 0xb5, 0x61, 0x4d, 0x2b, 0x83, 0xc0, 0x8a, 0x50, 0x5d, 0xa6,
+	0x3c, 0x09, 0x46, 0x91, 0x88, 0x85, 0x6a, 0x56, 0x3a, 0x55, 0xa7, 0xde, 0x6f, 0x11, 0x4d, 0x93,
+	0xa1, 0x13, 0x8d, 0x4e, 0xce, 0x40, 0x24, 0xee, 0xe1, 0xe2, 0xc3, 0x36, 0x9e, 0x57, 0xb6, 0x13,
+	0x0a, 0x75, 0x33, 0xf5, 0x88, 0x0f, 0xb1, 0x46, 0xd7, 0xa1, 0x2b, 0x83, 0x5b, 0xaa, 0x1e, 0x52,
+	0x2e, 0xf3, 0x01, 0x39, 0x44, 0xf9, 0xfe, 0xcb, 0x6c, 0xbd, 0x75, 0x82, 0x10, 0x9f, 0xa5, 0x62,
+	0xc2, 0xb2, 0xaf, 0x37, 0xab, 0x1d, 0xd3, 0xa9, 0xf7, 0xdb, 0xa4, 0xb0, 0x45, 0x4a, 0x5b, 0xe4,
+	0xaa, 0xb4, 0xe5, 0xfe, 0x9a, 0xaf, 0x6c, 0x73, 0xb8, 0x33, 0x73, 0x7c, 0xfe, 0xf6, 0xda, 0xdd,
+	0xd7, 0x74, 0xdb, 0x9b, 0x94, 0x84, 0xbb, 0xc6, 0x07, 0x8f, 0x5f, 0x2f, 0x07, 0xff, 0xca, 0xf3,
+	0xfd, 0xf8, 0x2b, 0x6e, 0x6f, 0xb1, 0xc6, 0xe6, 0x72, 0x8d, 0xcd, 0xcf, 0x35, 0x36, 0xe7, 0x1b,
+	0x6c, 0x2c, 0x37, 0xd8, 0x78, 0xdf, 0x60, 0xe3, 0x7a, 0x3b, 0x32, 0xdb, 0xde, 0x3e, 0x77, 0xe3,
+	0xfd, 0xce, 0xf9, 0x8e, 0xbe, 0x07, 0x00, 0x13, 0x67, 0x81, 0xb2, 0x1b, 0x02, 0x00, 0x00,
+}
+
+func (m *BidFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeegrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BidFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BidFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +14,13 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		Auctions:            []Auction{},
+		BidderRegistrations: []BidderRegistration{},
+		LotBids:             []LotBid{},
+		AuctionSchedules:    []AuctionSchedule{},
+		AuctionRewards:      []AuctionReward{},
+		IbcBidders:          []IBCBidder{},
+		PortId:              PortID,
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,6 +29,93 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated ID in auction
+	auctionIdMap := make(map[string]struct{})
+	lotMap := make(map[string]struct{})
+	for _, elem := range gs.Auctions {
+		if _, ok := auctionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auction: %s", elem.Id)
+		}
+		auctionIdMap[elem.Id] = struct{}{}
+		if elem.Duration > MaxDuration {
+			return fmt.Errorf("duration of auction %s exceeds %d blocks", elem.Id, MaxDuration)
+		}
+		for _, lot := range elem.Lots {
+			lotMap[string(LotBidLotKeyPrefix(elem.Id, lot.Id))] = struct{}{}
+		}
+	}
+	if gs.HaltedSince < 0 {
+		return fmt.Errorf("negative halted since height %d", gs.HaltedSince)
+	}
+	if uint64(len(gs.Auctions)) > gs.AuctionCount {
+		return fmt.Errorf("auction count %d is lower than the number of auctions %d", gs.AuctionCount, len(gs.Auctions))
+	}
+	// Check for duplicated or dangling bidder registrations
+	registrationMap := make(map[string]struct{})
+	for _, elem := range gs.BidderRegistrations {
+		key := string(BidderRegistrationEntryKey(elem.AuctionId, elem.Bidder))
+		if _, ok := registrationMap[key]; ok {
+			return fmt.Errorf("duplicated registration of %s for auction %s", elem.Bidder, elem.AuctionId)
+		}
+		if _, ok := auctionIdMap[elem.AuctionId]; !ok {
+			return fmt.Errorf("registration of %s for unknown auction %s", elem.Bidder, elem.AuctionId)
+		}
+		if elem.Deposit == nil || !elem.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit for registration of %s to auction %s", elem.Bidder, elem.AuctionId)
+		}
+		registrationMap[key] = struct{}{}
+	}
+	// Check for lot bids on unknown lots
+	for _, elem := range gs.LotBids {
+		if _, ok := lotMap[string(LotBidLotKeyPrefix(elem.AuctionId, elem.LotId))]; !ok {
+			return fmt.Errorf("bid on unknown lot %s of auction %s", elem.LotId, elem.AuctionId)
+		}
+		if elem.Bid == nil || elem.Bid.BidAmount == nil || !elem.Bid.BidAmount.IsValid() {
+			return fmt.Errorf("invalid bid on lot %s of auction %s", elem.LotId, elem.AuctionId)
+		}
+	}
+	// Check for duplicated schedule IDs
+	scheduleIdMap := make(map[string]struct{})
+	for _, elem := range gs.AuctionSchedules {
+		if _, ok := scheduleIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auction schedule: %s", elem.Id)
+		}
+		scheduleIdMap[elem.Id] = struct{}{}
+		if elem.Interval > MaxDuration {
+			return fmt.Errorf("interval of auction schedule %s exceeds %d blocks", elem.Id, MaxDuration)
+		}
+	}
+	if uint64(len(gs.AuctionSchedules)) > gs.AuctionScheduleCount {
+		return fmt.Errorf("auction schedule count %d is lower than the number of schedules %d", gs.AuctionScheduleCount, len(gs.AuctionSchedules))
+	}
+	// Check for duplicated or empty rewards
+	rewardMap := make(map[string]struct{})
+	for _, elem := range gs.AuctionRewards {
+		if _, ok := rewardMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated rewards for address: %s", elem.Address)
+		}
+		if !elem.Amount.IsValid() || elem.Amount.IsZero() {
+			return fmt.Errorf("invalid rewards for address %s: %s", elem.Address, elem.Amount)
+		}
+		rewardMap[elem.Address] = struct{}{}
+	}
+	if !gs.IncentiveEpoch.Accrued.IsValid() {
+		return fmt.Errorf("invalid incentive epoch accrued rewards: %s", gs.IncentiveEpoch.Accrued)
+	}
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	// Check for duplicated IBC bidders
+	ibcBidderMap := make(map[string]struct{})
+	for _, elem := range gs.IbcBidders {
+		if _, ok := ibcBidderMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated IBC bidder: %s", elem.Address)
+		}
+		if elem.ChannelId == "" || elem.Sender == "" {
+			return fmt.Errorf("IBC bidder %s has no origin", elem.Address)
+		}
+		ibcBidderMap[elem.Address] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
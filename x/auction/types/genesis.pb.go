@@ -28,6 +28,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// auctions defines all the auctions stored by the module.
+	Auctions []Auction `protobuf:"bytes,2,rep,name=auctions,proto3" json:"auctions"`
+	// auction_count is the number of auctions created so far and is used to
+	// derive the next auction ID.
+	AuctionCount uint64 `protobuf:"varint,3,opt,name=auction_count,json=auctionCount,proto3" json:"auction_count,omitempty"`
+	// paused reports whether the module authority paused the whole module.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// halted_since is the height at which bidding was halted module wide, either
+	// by the authority or by the circuit breaker. Zero when bidding is not halted.
+	HaltedSince int64 `protobuf:"varint,5,opt,name=halted_since,json=haltedSince,proto3" json:"halted_since,omitempty"`
+	// bidder_registrations defines the deposits locked by registered bidders.
+	BidderRegistrations []BidderRegistration `protobuf:"bytes,6,rep,name=bidder_registrations,json=bidderRegistrations,proto3" json:"bidder_registrations"`
+	// lot_bids defines the bids placed on single lots of bundle auctions, in
+	// the order they were placed.
+	LotBids []LotBid `protobuf:"bytes,7,rep,name=lot_bids,json=lotBids,proto3" json:"lot_bids"`
+	// auction_schedules defines the recurring auction schedules.
+	AuctionSchedules []AuctionSchedule `protobuf:"bytes,8,rep,name=auction_schedules,json=auctionSchedules,proto3" json:"auction_schedules"`
+	// auction_schedule_count is the number of schedules created so far and is
+	// used to derive the next schedule ID.
+	AuctionScheduleCount uint64 `protobuf:"varint,9,opt,name=auction_schedule_count,json=auctionScheduleCount,proto3" json:"auction_schedule_count,omitempty"`
+	// auction_rewards defines the incentive rebates accrued and not claimed yet.
+	AuctionRewards []AuctionReward `protobuf:"bytes,10,rep,name=auction_rewards,json=auctionRewards,proto3" json:"auction_rewards"`
+	// incentive_epoch tracks the rebates accrued in the current incentive epoch.
+	IncentiveEpoch IncentiveEpoch `protobuf:"bytes,11,opt,name=incentive_epoch,json=incentiveEpoch,proto3" json:"incentive_epoch"`
+	// ibc_bidders defines the origins of the accounts bidding over ICS-20.
+	IbcBidders []IBCBidder `protobuf:"bytes,12,rep,name=ibc_bidders,json=ibcBidders,proto3" json:"ibc_bidders"`
+	// port_id is the port the auction IBC application binds to.
+	PortId string `protobuf:"bytes,13,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +98,90 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionCount() uint64 {
+	if m != nil {
+		return m.AuctionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetHaltedSince() int64 {
+	if m != nil {
+		return m.HaltedSince
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBidderRegistrations() []BidderRegistration {
+	if m != nil {
+		return m.BidderRegistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLotBids() []LotBid {
+	if m != nil {
+		return m.LotBids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionSchedules() []AuctionSchedule {
+	if m != nil {
+		return m.AuctionSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionScheduleCount() uint64 {
+	if m != nil {
+		return m.AuctionScheduleCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuctionRewards() []AuctionReward {
+	if m != nil {
+		return m.AuctionRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentiveEpoch() IncentiveEpoch {
+	if m != nil {
+		return m.IncentiveEpoch
+	}
+	return IncentiveEpoch{}
+}
+
+func (m *GenesisState) GetIbcBidders() []IBCBidder {
+	if m != nil {
+		return m.IbcBidders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "auction.auction.GenesisState")
 }
@@ -77,19 +189,39 @@ func init() {
 func init() { proto.RegisterFile("auction/auction/genesis.proto", fileDescriptor_21c67da9e6fdeb9d) }
 
 var fileDescriptor_21c67da9e6fdeb9d = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2c, 0x4d, 0x2e,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x87, 0xd1, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45,
-	0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x50, 0x61, 0x3d, 0x28, 0x2d, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97,
-	0xaf, 0x0f, 0x26, 0x21, 0x6a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b,
-	0x2a, 0x2a, 0x83, 0x6e, 0x70, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x5c, 0x25, 0x2f, 0x2e, 0x1e,
-	0x77, 0x88, 0x45, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x56, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x71, 0x3d, 0x34, 0x8b, 0xf5, 0x02, 0xc0, 0xd2, 0x4e, 0x9c, 0x27,
-	0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xc3, 0xc9, 0xf0, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xc4, 0x61, 0x76, 0x57, 0xc0, 0x5d, 0x51, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x95, 0x57,
-	0x14, 0x4d, 0xfe, 0x00, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xe9, 0xc8, 0x5a, 0xa7, 0x63, 0xcc, 0x54, 0xab, 0xa9, 0x20, 0x0b, 0xec, 0x12, 0x71,
+	0x68, 0xc5, 0xe0, 0x84, 0x84, 0x10, 0x99, 0x00, 0x4d, 0xe2, 0x80, 0xd2, 0x0b, 0xe2, 0x12, 0x39,
+	0xb1, 0xd5, 0x5a, 0xea, 0xe2, 0x28, 0x76, 0x61, 0xfc, 0x0b, 0xfe, 0x05, 0x1c, 0xf9, 0x19, 0x3b,
+	0xee, 0xc8, 0x09, 0xa1, 0xf6, 0xc0, 0xdf, 0x40, 0xb1, 0x1d, 0x48, 0x13, 0x76, 0x89, 0xed, 0xf7,
+	0xde, 0xf7, 0xf2, 0xf4, 0xd9, 0x1f, 0xbc, 0x4f, 0x56, 0xa9, 0xe2, 0x22, 0x9b, 0x56, 0xeb, 0x9c,
+	0x65, 0x4c, 0x72, 0x39, 0xc9, 0x0b, 0xa1, 0x04, 0xda, 0xb7, 0xf0, 0xc4, 0xae, 0xe3, 0x03, 0x72,
+	0xce, 0x33, 0x31, 0xd5, 0x5f, 0xa3, 0x19, 0x0f, 0xe7, 0x62, 0x2e, 0xf4, 0x76, 0x5a, 0xee, 0x2c,
+	0x7a, 0xb7, 0x69, 0xcc, 0x93, 0xd4, 0x52, 0xf7, 0x9a, 0x54, 0x4e, 0x0a, 0x72, 0x6e, 0x7f, 0x39,
+	0xc6, 0x4d, 0x56, 0x5d, 0x18, 0xe6, 0xe1, 0x57, 0x07, 0x0e, 0xde, 0x98, 0x78, 0x33, 0x45, 0x14,
+	0x43, 0xcf, 0xa0, 0x63, 0x4a, 0x31, 0xf0, 0x41, 0xe0, 0x9e, 0x8c, 0x26, 0x8d, 0xb8, 0x93, 0x77,
+	0x9a, 0x0e, 0xfb, 0x97, 0x3f, 0x8f, 0x3a, 0xdf, 0x7e, 0x7f, 0x7f, 0x04, 0x22, 0x5b, 0x81, 0x5e,
+	0xc0, 0x9e, 0x15, 0x49, 0x7c, 0xc3, 0xef, 0x06, 0xee, 0x09, 0x6e, 0x55, 0xbf, 0x34, 0x6b, 0xbd,
+	0xfc, 0x6f, 0x11, 0x3a, 0x86, 0x7b, 0x76, 0x1f, 0xa7, 0x62, 0x95, 0x29, 0xdc, 0xf5, 0x41, 0xb0,
+	0x13, 0x0d, 0x2c, 0x78, 0x5a, 0x62, 0xe8, 0xb0, 0x4c, 0xb8, 0x92, 0x8c, 0xe2, 0x1d, 0x1f, 0x04,
+	0xbd, 0xc8, 0x9e, 0xd0, 0x03, 0x38, 0x58, 0x90, 0xa5, 0x62, 0x34, 0x96, 0x3c, 0x4b, 0x19, 0xbe,
+	0xe9, 0x83, 0xa0, 0x1b, 0xb9, 0x06, 0x9b, 0x95, 0x10, 0x22, 0x70, 0x98, 0x70, 0x4a, 0x59, 0x11,
+	0x17, 0x6c, 0xce, 0xa5, 0x2a, 0x88, 0x09, 0xeb, 0xe8, 0xb0, 0xc7, 0xad, 0xb0, 0xa1, 0x16, 0x47,
+	0x35, 0x6d, 0x3d, 0xf7, 0x9d, 0xa4, 0x45, 0x4b, 0xf4, 0x1c, 0xf6, 0x96, 0x42, 0xc5, 0x09, 0xa7,
+	0x12, 0xef, 0xfa, 0xdd, 0xff, 0x76, 0xf0, 0xad, 0x50, 0x21, 0xa7, 0x75, 0xab, 0xdd, 0xa5, 0x86,
+	0x24, 0x7a, 0x0f, 0x0f, 0xaa, 0x0e, 0xc8, 0x74, 0xc1, 0xe8, 0x6a, 0xc9, 0x24, 0xee, 0x69, 0x1f,
+	0xff, 0xba, 0x5e, 0xce, 0xac, 0xb0, 0x6e, 0x78, 0x9b, 0x6c, 0x73, 0x12, 0x3d, 0x85, 0x87, 0x4d,
+	0x67, 0xdb, 0xe4, 0xbe, 0x6e, 0xf2, 0xb0, 0x51, 0x61, 0x9a, 0x1d, 0xc1, 0xea, 0xb9, 0xc6, 0x05,
+	0xfb, 0x44, 0x0a, 0x2a, 0x31, 0xd4, 0x69, 0xbc, 0xeb, 0xd2, 0x44, 0x5a, 0x56, 0xcf, 0x72, 0x8b,
+	0xd4, 0x19, 0x89, 0x66, 0x70, 0xbf, 0xbc, 0x8d, 0x4c, 0xf1, 0x8f, 0x2c, 0x66, 0xb9, 0x48, 0x17,
+	0xd8, 0xd5, 0x6f, 0xed, 0xa8, 0xe5, 0x79, 0x56, 0xe9, 0x5e, 0x95, 0xb2, 0x2d, 0x53, 0xbe, 0x45,
+	0xa1, 0xd7, 0xd0, 0xe5, 0x49, 0x1a, 0x9b, 0x2b, 0x91, 0x78, 0xa0, 0x43, 0x8e, 0xdb, 0x86, 0xe1,
+	0xa9, 0xb9, 0xd4, 0xba, 0x17, 0xe4, 0x49, 0x6a, 0x50, 0x89, 0x46, 0x70, 0x37, 0x17, 0x85, 0x8a,
+	0x39, 0xc5, 0x7b, 0x3e, 0x08, 0xfa, 0x91, 0x53, 0x1e, 0xcf, 0x68, 0xf8, 0xf8, 0x72, 0xed, 0x81,
+	0xab, 0xb5, 0x07, 0x7e, 0xad, 0x3d, 0xf0, 0x65, 0xe3, 0x75, 0xae, 0x36, 0x5e, 0xe7, 0xc7, 0xc6,
+	0xeb, 0x7c, 0x18, 0x55, 0x53, 0x75, 0xf1, 0x6f, 0xbe, 0x3e, 0xe7, 0x4c, 0x26, 0x8e, 0x9e, 0xb1,
+	0x27, 0x7f, 0x06, 0x00, 0x03, 0xbd, 0xf7, 0xe1, 0x11, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +244,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.IbcBidders) > 0 {
+		for iNdEx := len(m.IbcBidders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcBidders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.IncentiveEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.AuctionRewards) > 0 {
+		for iNdEx := len(m.AuctionRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AuctionScheduleCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionScheduleCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AuctionSchedules) > 0 {
+		for iNdEx := len(m.AuctionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LotBids) > 0 {
+		for iNdEx := len(m.LotBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LotBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BidderRegistrations) > 0 {
+		for iNdEx := len(m.BidderRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidderRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.HaltedSince != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HaltedSince))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuctionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +402,60 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuctionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionCount))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.HaltedSince != 0 {
+		n += 1 + sovGenesis(uint64(m.HaltedSince))
+	}
+	if len(m.BidderRegistrations) > 0 {
+		for _, e := range m.BidderRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LotBids) > 0 {
+		for _, e := range m.LotBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionSchedules) > 0 {
+		for _, e := range m.AuctionSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuctionScheduleCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionScheduleCount))
+	}
+	if len(m.AuctionRewards) > 0 {
+		for _, e := range m.AuctionRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.IncentiveEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.IbcBidders) > 0 {
+		for _, e := range m.IbcBidders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -215,6 +527,352 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionCount", wireType)
+			}
+			m.AuctionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedSince", wireType)
+			}
+			m.HaltedSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderRegistrations = append(m.BidderRegistrations, BidderRegistration{})
+			if err := m.BidderRegistrations[len(m.BidderRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotBids = append(m.LotBids, LotBid{})
+			if err := m.LotBids[len(m.LotBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionSchedules = append(m.AuctionSchedules, AuctionSchedule{})
+			if err := m.AuctionSchedules[len(m.AuctionSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionScheduleCount", wireType)
+			}
+			m.AuctionScheduleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionScheduleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionRewards = append(m.AuctionRewards, AuctionReward{})
+			if err := m.AuctionRewards[len(m.AuctionRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBidders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcBidders = append(m.IbcBidders, IBCBidder{})
+			if err := m.IbcBidders[len(m.IbcBidders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
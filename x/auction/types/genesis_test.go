@@ -5,10 +5,13 @@ import (
 
 	"auction/x/auction/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	deposit := sdk.NewInt64Coin("token", 10)
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -22,11 +25,158 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc:     "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
+				PortId: types.PortID,
+				Auctions: []types.Auction{
+					{
+						Id: "auction-0",
+					},
+					{
+						Id: "auction-1",
+					},
+				},
+				AuctionCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated auction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Auctions: []types.Auction{
+					{
+						Id: "auction-0",
+					},
+					{
+						Id: "auction-0",
+					},
+				},
+				AuctionCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "auction count lower than auctions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Auctions: []types.Auction{
+					{
+						Id: "auction-0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "registration for unknown auction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Auctions: []types.Auction{
+					{
+						Id: "auction-0",
+					},
+				},
+				AuctionCount: 1,
+				BidderRegistrations: []types.BidderRegistration{
+					{
+						AuctionId: "auction-1",
+						Bidder:    "bidder",
+						Deposit:   &deposit,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated registration",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Auctions: []types.Auction{
+					{
+						Id: "auction-0",
+					},
+				},
+				AuctionCount: 1,
+				BidderRegistrations: []types.BidderRegistration{
+					{
+						AuctionId: "auction-0",
+						Bidder:    "bidder",
+						Deposit:   &deposit,
+					},
+					{
+						AuctionId: "auction-0",
+						Bidder:    "bidder",
+						Deposit:   &deposit,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "bid on unknown lot",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Auctions: []types.Auction{
+					{
+						Id:   "auction-0",
+						Lots: []*types.AuctionLot{{Id: "lot-0", Item: "item"}},
+					},
+				},
+				AuctionCount: 1,
+				LotBids: []types.LotBid{
+					{
+						AuctionId: "auction-0",
+						LotId:     "lot-1",
+						Bid:       &types.Bid{Bidder: "bidder", BidAmount: &deposit},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auction schedule",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuctionSchedules: []types.AuctionSchedule{
+					{Id: "schedule-0"},
+					{Id: "schedule-0"},
+				},
+				AuctionScheduleCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "auction schedule count too low",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuctionSchedules: []types.AuctionSchedule{
+					{Id: "schedule-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auction rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuctionRewards: []types.AuctionReward{
+					{Address: "cosmos1", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+					{Address: "cosmos1", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty auction rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuctionRewards: []types.AuctionReward{
+					{Address: "cosmos1"},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
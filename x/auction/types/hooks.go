@@ -0,0 +1,67 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple auction hooks, all hook functions are run in array sequence
+var _ AuctionHooks = &MultiAuctionHooks{}
+
+type MultiAuctionHooks []AuctionHooks
+
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+func (h MultiAuctionHooks) AfterAuctionCreated(ctx context.Context, auctionID string) error {
+	for i := range h {
+		if err := h[i].AfterAuctionCreated(ctx, auctionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuctionHooks) AfterBidPlaced(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterBidPlaced(ctx, auctionID, bidder, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuctionHooks) AfterBidRefunded(ctx context.Context, auctionID string, bidder sdk.AccAddress, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterBidRefunded(ctx, auctionID, bidder, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuctionHooks) AfterAuctionSettled(ctx context.Context, auctionID string) error {
+	for i := range h {
+		if err := h[i].AfterAuctionSettled(ctx, auctionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuctionHooks) AfterAuctionCancelled(ctx context.Context, auctionID string) error {
+	for i := range h {
+		if err := h[i].AfterAuctionCancelled(ctx, auctionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuctionHooksWrapper is a wrapper for modules to inject AuctionHooks using depinject.
+type AuctionHooksWrapper struct{ AuctionHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AuctionHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IBCRefundTimeout is how long the refunds sent back to the origin chain of an
+// IBC bidder wait to be relayed before they time out.
+const IBCRefundTimeout = 10 * time.Minute
+
+// BidMemo is the memo of an ICS-20 transfer placing a bid with the
+// transferred funds:
+//
+//	{"auction":{"place_bid":{"auction_id":"auction-0"}}}
+type BidMemo struct {
+	Auction *AuctionMemo `json:"auction"`
+}
+
+// AuctionMemo holds the action an ICS-20 memo asks the auction module for.
+type AuctionMemo struct {
+	PlaceBid *PlaceBidMemo `json:"place_bid"`
+}
+
+// PlaceBidMemo places a bid of the transferred funds. On multi-unit auctions
+// the funds are the price of the whole quantity, which they must split into
+// evenly.
+type PlaceBidMemo struct {
+	AuctionId string `json:"auction_id"`
+	Quantity  uint64 `json:"quantity,omitempty"`
+	LotId     string `json:"lot_id,omitempty"`
+}
+
+// ParseBidMemo returns the bid an ICS-20 memo places, or nil when the memo is
+// not meant for the auction module.
+func ParseBidMemo(memo string) (*PlaceBidMemo, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+	if _, ok := fields[ModuleName]; !ok {
+		return nil, nil
+	}
+
+	var bidMemo BidMemo
+	if err := json.Unmarshal([]byte(memo), &bidMemo); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidIBCBid, "invalid memo: %s", err)
+	}
+	if bidMemo.Auction == nil || bidMemo.Auction.PlaceBid == nil {
+		return nil, errorsmod.Wrap(ErrInvalidIBCBid, "memo does not place a bid")
+	}
+	if bidMemo.Auction.PlaceBid.AuctionId == "" {
+		return nil, errorsmod.Wrap(ErrInvalidIBCBid, "memo does not name an auction")
+	}
+
+	return bidMemo.Auction.PlaceBid, nil
+}
+
+// NewMsgPlaceBid returns the bid the memo places from the bidder with the
+// transferred funds.
+func (m PlaceBidMemo) NewMsgPlaceBid(bidder string, funds sdk.Coin) (*MsgPlaceBid, error) {
+	msg := NewMsgPlaceBid(bidder, m.AuctionId, funds)
+	msg.Quantity = m.Quantity
+	msg.LotId = m.LotId
+	if m.Quantity > 1 {
+		quantity := math.NewIntFromUint64(m.Quantity)
+		if !funds.Amount.Mod(quantity).IsZero() {
+			return nil, errorsmod.Wrapf(ErrInvalidIBCBid, "%s does not split evenly into %d units", funds, m.Quantity)
+		}
+		msg.BidAmount = &sdk.Coin{Denom: funds.Denom, Amount: funds.Amount.Quo(quantity)}
+	}
+
+	return msg, nil
+}
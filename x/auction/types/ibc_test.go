@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"auction/testutil/sample"
+	"auction/x/auction/types"
+)
+
+func TestParseBidMemo(t *testing.T) {
+	for _, memo := range []string{"", "a note", `{"wasm":{}}`, `["auction"]`} {
+		bid, err := types.ParseBidMemo(memo)
+		require.NoError(t, err, memo)
+		require.Nil(t, bid, memo)
+	}
+
+	for _, memo := range []string{`{"auction":{}}`, `{"auction":{"place_bid":{}}}`, `{"auction":{"place_bid":{"auction_id":7}}}`} {
+		_, err := types.ParseBidMemo(memo)
+		require.ErrorIs(t, err, types.ErrInvalidIBCBid, memo)
+	}
+
+	bid, err := types.ParseBidMemo(`{"auction":{"place_bid":{"auction_id":"auction-0","quantity":3}}}`)
+	require.NoError(t, err)
+	require.Equal(t, types.PlaceBidMemo{AuctionId: "auction-0", Quantity: 3}, *bid)
+
+	_, err = bid.NewMsgPlaceBid(sample.AccAddress(), sdk.NewInt64Coin("token", 10))
+	require.ErrorContains(t, err, "does not split evenly")
+	msg, err := bid.NewMsgPlaceBid(sample.AccAddress(), sdk.NewInt64Coin("token", 12))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("token", 4), *msg.BidAmount)
+}
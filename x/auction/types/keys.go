@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "auction"
@@ -16,14 +22,160 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_auction"
 
+	// Version defines the current version the IBC module supports
+	Version = "auction-1"
+
+	// NFTTransferVersion defines the version of the ICS-721 channels the
+	// module delivers NFT lots over
+	NFTTransferVersion = "ics721-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "auction"
+
 	// AuctionKey defines the key to store auctions
 	AuctionKey = "auction-"
+
+	// AuctionEndQueueKey defines the key to index open auctions by end height
+	AuctionEndQueueKey = "queue-end-"
+
+	// PaymentQueueKey defines the key to index deferred-payment auctions by
+	// payment deadline
+	PaymentQueueKey = "queue-payment-"
+
+	// StartHeightQueueKey defines the key to index upcoming auctions by start
+	// height
+	StartHeightQueueKey = "queue-start-height-"
+
+	// StartTimeQueueKey defines the key to index upcoming auctions by start
+	// time
+	StartTimeQueueKey = "queue-start-time-"
+
+	// BidderRegistrationKey defines the key to store bidder registrations
+	BidderRegistrationKey = "registration-"
+
+	// AuctionScheduleKey defines the key to store recurring auction schedules
+	AuctionScheduleKey = "schedule-"
+
+	// AuctionScheduleQueueKey defines the key to index active schedules by the
+	// height of their next auction
+	AuctionScheduleQueueKey = "queue-schedule-"
+
+	// LotBidKey defines the key to store the bids on single lots of bundle
+	// auctions
+	LotBidKey = "lot-bid-"
+
+	// AuctionRewardKey defines the key to store the incentive rebates accrued
+	// per address
+	AuctionRewardKey = "reward-"
+
+	// IBCBidderKey defines the key to store the origins of the accounts
+	// bidding over ICS-20
+	IBCBidderKey = "ibc-bidder-"
+
+	// IncentivePoolName is the name the incentive pool address is derived from
+	IncentivePoolName = "auction_incentive_pool"
+
+	// AuctionRewardsName is the name the address holding accrued rebates until
+	// they are claimed is derived from
+	AuctionRewardsName = "auction_rewards"
+
+	// FeeSponsorName is the name the fee sponsor address of an auction is
+	// derived from, followed by the auction ID
+	FeeSponsorName = "auction_fee_sponsor"
+
+	// TreasuryName is the name the address creating treasury auctions on
+	// behalf of the community pool is derived from
+	TreasuryName = "auction_treasury"
+
+	// IBCBidderName is the name the address bidding on behalf of a remote
+	// sender is derived from, followed by the channel and the sender
+	IBCBidderName = "auction_ibc_bidder"
+
+	// RemoteEscrowName is the name the address escrowing the remote bids sent
+	// over an auction channel is derived from, followed by the port and the
+	// channel
+	RemoteEscrowName = "auction_remote_escrow"
+
+	// NFTEscrowName is the name the address escrowing the NFT lots sent over
+	// an ICS-721 channel is derived from, followed by the port and the channel
+	NFTEscrowName = "auction_nft_escrow"
 )
 
 var (
 	ParamsKey = []byte("p_auction")
+
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte("port")
+
+	// ModulePausedKey is set while the module authority pauses the whole module
+	ModulePausedKey = []byte("paused")
+
+	// HaltedSinceKey stores the height at which bidding was halted module wide
+	HaltedSinceKey = []byte("halted_since")
+
+	// AuctionScheduleCountKey stores the number of schedules created so far
+	AuctionScheduleCountKey = []byte("schedule_count")
+
+	// IncentiveEpochKey stores the rebates accrued in the current incentive
+	// epoch
+	IncentiveEpochKey = []byte("incentive_epoch")
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// AuctionEndQueueKeyPrefix returns the end queue prefix for all auctions ending
+// at the given height.
+func AuctionEndQueueKeyPrefix(endHeight int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(endHeight))
+}
+
+// AuctionEndQueueEntryKey returns the end queue key of a single auction,
+// relative to the AuctionEndQueueKey prefix. The payment queue uses the same
+// layout, keyed by payment deadline.
+func AuctionEndQueueEntryKey(endHeight int64, auctionID string) []byte {
+	return append(AuctionEndQueueKeyPrefix(endHeight), []byte(auctionID)...)
+}
+
+// StartTimeQueueKeyPrefix returns the start time queue prefix for all auctions
+// starting at the given unix time.
+func StartTimeQueueKeyPrefix(startTime time.Time) []byte {
+	return sdk.Uint64ToBigEndian(uint64(startTime.Unix()))
+}
+
+// StartTimeQueueEntryKey returns the start time queue key of a single auction,
+// relative to the StartTimeQueueKey prefix. The start height queue uses the
+// AuctionEndQueueEntryKey layout, keyed by start height.
+func StartTimeQueueEntryKey(startTime time.Time, auctionID string) []byte {
+	return append(StartTimeQueueKeyPrefix(startTime), []byte(auctionID)...)
+}
+
+// BidderRegistrationKeyPrefix returns the registration prefix for all bidders
+// of the given auction.
+func BidderRegistrationKeyPrefix(auctionID string) []byte {
+	return []byte(auctionID + "/")
+}
+
+// BidderRegistrationEntryKey returns the key of a single bidder registration,
+// relative to the BidderRegistrationKey prefix.
+func BidderRegistrationEntryKey(auctionID string, bidder string) []byte {
+	return append(BidderRegistrationKeyPrefix(auctionID), []byte(bidder)...)
+}
+
+// LotBidKeyPrefix returns the lot bid prefix for all bids on the lots of the
+// given auction.
+func LotBidKeyPrefix(auctionID string) []byte {
+	return []byte(auctionID + "/")
+}
+
+// LotBidLotKeyPrefix returns the lot bid prefix for all bids on a single lot.
+func LotBidLotKeyPrefix(auctionID string, lotID string) []byte {
+	return append(LotBidKeyPrefix(auctionID), []byte(lotID+"/")...)
+}
+
+// LotBidEntryKey returns the key of the index-th bid on a lot, relative to the
+// LotBidKey prefix.
+func LotBidEntryKey(auctionID string, lotID string, index uint64) []byte {
+	return append(LotBidLotKeyPrefix(auctionID, lotID), sdk.Uint64ToBigEndian(index)...)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCancelReasonLength is the maximum length of the reason stored on an
+// auction voided by the module authority.
+const MaxCancelReasonLength = 256
+
+var _ sdk.Msg = &MsgAdminCancelAuction{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAdminCancelAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.AuctionId == "" {
+		return errorsmod.Wrap(ErrInvalidAuctionId, "auction ID cannot be empty")
+	}
+	if m.Reason == "" || len(m.Reason) > MaxCancelReasonLength {
+		return errorsmod.Wrapf(ErrInvalidCancelReason, "reason must be between 1 and %d characters", MaxCancelReasonLength)
+	}
+
+	return nil
+}
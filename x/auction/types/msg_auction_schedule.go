@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateAuctionSchedule{}
+
+// NewMsgCreateAuctionSchedule creates a new MsgCreateAuctionSchedule instance
+func NewMsgCreateAuctionSchedule(creator string, template AuctionTemplate, interval uint64, count uint64, balance *sdk.Coin) *MsgCreateAuctionSchedule {
+	return &MsgCreateAuctionSchedule{
+		Creator:  creator,
+		Template: template,
+		Interval: interval,
+		Count:    count,
+		Balance:  balance,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgCreateAuctionSchedule) ValidateBasic() error {
+	if err := msg.Template.MsgCreateAuction(msg.Creator).ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if msg.Interval == 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if msg.Interval > MaxDuration {
+		return fmt.Errorf("interval cannot exceed %d blocks", MaxDuration)
+	}
+	if msg.EndHeight < 0 {
+		return fmt.Errorf("end height cannot be negative")
+	}
+	if msg.Count == 0 && msg.EndHeight == 0 {
+		return fmt.Errorf("either count or end height must be set")
+	}
+
+	escrow := msg.Template.RoundEscrow()
+	balance := sdk.NewCoins()
+	if msg.Balance != nil {
+		if !msg.Balance.IsValid() {
+			return fmt.Errorf("invalid balance")
+		}
+		balance = balance.Add(*msg.Balance)
+	}
+	if !escrow.IsZero() && !balance.IsAllGTE(escrow) {
+		return fmt.Errorf("balance %s does not fund a single auction escrowing %s", balance, escrow)
+	}
+	if escrow.IsZero() && !balance.IsZero() {
+		return fmt.Errorf("template auctions do not escrow any coin")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelAuctionSchedule{}
+
+// NewMsgCancelAuctionSchedule creates a new MsgCancelAuctionSchedule instance
+func NewMsgCancelAuctionSchedule(creator string, scheduleID string) *MsgCancelAuctionSchedule {
+	return &MsgCancelAuctionSchedule{
+		Creator:    creator,
+		ScheduleId: scheduleID,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgCancelAuctionSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.ScheduleId == "" {
+		return fmt.Errorf("schedule ID cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgGrantBidFeeAllowance{}
+
+// NewMsgGrantBidFeeAllowance creates a new MsgGrantBidFeeAllowance instance
+func NewMsgGrantBidFeeAllowance(creator string, auctionID string, bidders []string) *MsgGrantBidFeeAllowance {
+	return &MsgGrantBidFeeAllowance{
+		Creator:   creator,
+		AuctionId: auctionID,
+		Bidders:   bidders,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgGrantBidFeeAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
+	if len(msg.Bidders) == 0 {
+		return fmt.Errorf("bidders cannot be empty")
+	}
+	for _, bidder := range msg.Bidders {
+		if _, err := sdk.AccAddressFromBech32(bidder); err != nil {
+			return fmt.Errorf("invalid bidder address %s: %w", bidder, err)
+		}
+	}
+	return nil
+}
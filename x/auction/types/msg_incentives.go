@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgFundIncentivePool{}
+	_ sdk.Msg = &MsgClaimAuctionRewards{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFundIncentivePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+
+	return nil
+}
+
+func NewMsgClaimAuctionRewards(claimant string) *MsgClaimAuctionRewards {
+	return &MsgClaimAuctionRewards{
+		Claimant: claimant,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgClaimAuctionRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return fmt.Errorf("invalid claimant address: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgClaimNFTLot{}
+
+// NewMsgClaimNFTLot creates a new MsgClaimNFTLot instance
+func NewMsgClaimNFTLot(claimant string, auctionID string) *MsgClaimNFTLot {
+	return &MsgClaimNFTLot{
+		Claimant:  claimant,
+		AuctionId: auctionID,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgClaimNFTLot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return fmt.Errorf("invalid claimant address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
+	return nil
+}
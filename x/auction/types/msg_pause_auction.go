@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgPauseAuction{}
+	_ sdk.Msg = &MsgResumeAuction{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgPauseAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResumeAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
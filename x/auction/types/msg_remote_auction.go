@@ -0,0 +1,94 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgSendCreateRemoteAuction{}
+	_ sdk.Msg = &MsgSendPlaceRemoteBid{}
+)
+
+// NewMsgSendCreateRemoteAuction creates a new MsgSendCreateRemoteAuction
+// object.
+func NewMsgSendCreateRemoteAuction(creator, port, channelID string, timeoutTimestamp uint64, item string, startingBid sdk.Coin, duration uint64) *MsgSendCreateRemoteAuction {
+	return &MsgSendCreateRemoteAuction{
+		Creator:          creator,
+		Port:             port,
+		ChannelId:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Item:             item,
+		StartingBid:      startingBid,
+		Duration:         duration,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendCreateRemoteAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validatePacketRoute(m.Port, m.ChannelId, m.TimeoutTimestamp); err != nil {
+		return err
+	}
+	if m.Item == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item cannot be empty")
+	}
+	if !m.StartingBid.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid starting bid %s", m.StartingBid)
+	}
+	if m.Duration > MaxDuration {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration cannot exceed %d blocks", MaxDuration)
+	}
+
+	return nil
+}
+
+// NewMsgSendPlaceRemoteBid creates a new MsgSendPlaceRemoteBid object.
+func NewMsgSendPlaceRemoteBid(bidder, port, channelID string, timeoutTimestamp uint64, auctionID string, bidAmount sdk.Coin) *MsgSendPlaceRemoteBid {
+	return &MsgSendPlaceRemoteBid{
+		Bidder:           bidder,
+		Port:             port,
+		ChannelId:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		AuctionId:        auctionID,
+		BidAmount:        bidAmount,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendPlaceRemoteBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if err := validatePacketRoute(m.Port, m.ChannelId, m.TimeoutTimestamp); err != nil {
+		return err
+	}
+	if m.AuctionId == "" {
+		return errorsmod.Wrap(ErrInvalidAuctionId, "auction ID cannot be empty")
+	}
+	if !m.BidAmount.IsValid() || !m.BidAmount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bid amount %s", m.BidAmount)
+	}
+
+	return nil
+}
+
+// validatePacketRoute checks the channel end and the timeout a packet is sent
+// with.
+func validatePacketRoute(port, channelID string, timeoutTimestamp uint64) error {
+	if err := host.PortIdentifierValidator(port); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel: %s", err)
+	}
+	if timeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "timeout timestamp cannot be zero")
+	}
+
+	return nil
+}
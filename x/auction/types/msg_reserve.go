@@ -0,0 +1,59 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRevealReserve{}
+
+// ReserveCommitment returns the commitment hiding a reserve price: the hex
+// encoded SHA-256 hash of "{reserve}:{salt}".
+func ReserveCommitment(reserve sdk.Coin, salt string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", reserve, salt)))
+	return hex.EncodeToString(hash[:])
+}
+
+// validateReserveCommitment checks that the commitment is a hex encoded
+// SHA-256 hash.
+func validateReserveCommitment(commitment string) error {
+	bz, err := hex.DecodeString(commitment)
+	if err != nil {
+		return fmt.Errorf("invalid reserve commitment: %w", err)
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("reserve commitment must be a %d byte hash", sha256.Size)
+	}
+	return nil
+}
+
+// NewMsgRevealReserve creates a new MsgRevealReserve instance
+func NewMsgRevealReserve(creator string, auctionID string, reserve sdk.Coin, salt string) *MsgRevealReserve {
+	return &MsgRevealReserve{
+		Creator:   creator,
+		AuctionId: auctionID,
+		Reserve:   reserve,
+		Salt:      salt,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgRevealReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
+	if !msg.Reserve.IsValid() || !msg.Reserve.IsPositive() {
+		return fmt.Errorf("invalid reserve")
+	}
+	// an empty salt would let anyone find a reserve from its commitment
+	if msg.Salt == "" {
+		return fmt.Errorf("salt cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateTreasuryAuction{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateTreasuryAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.Item == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item cannot be empty")
+	}
+	if !m.Lot.IsValid() || !m.Lot.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid lot %s", m.Lot)
+	}
+	if !m.StartingBid.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid starting bid %s", m.StartingBid)
+	}
+	if m.Duration > MaxDuration {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration cannot exceed %d blocks", MaxDuration)
+	}
+	if _, ok := PricingRule_name[int32(m.PricingRule)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pricing rule %s", m.PricingRule)
+	}
+	if m.Quantity > 0 && !m.Lot.Amount.Mod(math.NewIntFromUint64(m.Quantity)).IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lot %s does not split evenly into %d units", m.Lot, m.Quantity)
+	}
+
+	return nil
+}
@@ -3,18 +3,21 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // Ensure MsgCreateAuction implements sdk.Msg interface
 var _ sdk.Msg = &MsgCreateAuction{}
 
 // NewMsgCreateAuction creates a new MsgCreateAuction instance
-func NewMsgCreateAuction(creator string, item string, startingBid sdk.Coin) *MsgCreateAuction {
+func NewMsgCreateAuction(creator string, item string, startingBid sdk.Coin, duration uint64) *MsgCreateAuction {
 	return &MsgCreateAuction{
 		Creator:     creator,
 		Item:        item,
 		StartingBid: &startingBid,
+		Duration:    duration,
 	}
 }
 
@@ -26,9 +29,108 @@ func (msg *MsgCreateAuction) ValidateBasic() error {
 	if msg.Item == "" {
 		return fmt.Errorf("item cannot be empty")
 	}
-	if !msg.StartingBid.IsValid() {
+	if msg.StartingBid == nil || !msg.StartingBid.IsValid() {
 		return fmt.Errorf("invalid starting bid")
 	}
+	if msg.Deposit != nil && !msg.Deposit.IsValid() {
+		return fmt.Errorf("invalid deposit")
+	}
+	if msg.DeferredPayment && (msg.Deposit == nil || !msg.Deposit.IsPositive()) {
+		return fmt.Errorf("deferred payment requires a deposit")
+	}
+	if msg.AuctionType != AuctionType_AUCTION_TYPE_MULTI_UNIT &&
+		(msg.Quantity != 0 || msg.Lot != nil || msg.PricingRule != PricingRule_PRICING_RULE_UNIFORM) {
+		return fmt.Errorf("quantity, lot and pricing rule are only supported by multi-unit auctions")
+	}
+	if msg.Duration > MaxDuration {
+		return fmt.Errorf("duration cannot exceed %d blocks", MaxDuration)
+	}
+	if msg.StartHeight < 0 {
+		return fmt.Errorf("start height cannot be negative")
+	}
+	if msg.StartHeight != 0 && msg.StartTime != nil {
+		return fmt.Errorf("start height and start time are mutually exclusive")
+	}
+	if err := msg.RelistPolicy.Validate(*msg.StartingBid, msg.AuctionType); err != nil {
+		return err
+	}
+	if !msg.FeeAllowance.IsValid() {
+		return fmt.Errorf("invalid fee allowance")
+	}
+	if !msg.FeeAllowancePerBidder.IsValid() {
+		return fmt.Errorf("invalid fee allowance per bidder")
+	}
+	if len(msg.FeeAllowancePerBidder) > 0 && len(msg.FeeAllowance) == 0 {
+		return fmt.Errorf("fee allowance per bidder requires a fee allowance")
+	}
+	// without a cap every bidder could spend the whole fee allowance
+	if len(msg.FeeAllowance) > 0 && len(msg.FeeAllowancePerBidder) == 0 {
+		return fmt.Errorf("fee allowance requires a fee allowance per bidder")
+	}
+	if msg.AuctionType != AuctionType_AUCTION_TYPE_BUNDLE && len(msg.Lots) > 0 {
+		return fmt.Errorf("lots are only supported by bundle auctions")
+	}
+	if msg.NftLot != nil {
+		if msg.AuctionType != AuctionType_AUCTION_TYPE_ENGLISH {
+			return fmt.Errorf("NFT lots are only supported by english auctions")
+		}
+		if msg.NftLot.ClassId == "" || msg.NftLot.Id == "" {
+			return fmt.Errorf("NFT lot class ID and ID cannot be empty")
+		}
+	}
+	if msg.ReserveCommitment != "" {
+		if msg.AuctionType != AuctionType_AUCTION_TYPE_ENGLISH {
+			return fmt.Errorf("hidden reserves are only supported by english auctions")
+		}
+		if err := validateReserveCommitment(msg.ReserveCommitment); err != nil {
+			return err
+		}
+	}
+	switch msg.AuctionType {
+	case AuctionType_AUCTION_TYPE_ENGLISH:
+	case AuctionType_AUCTION_TYPE_MULTI_UNIT:
+		if msg.Quantity == 0 {
+			return fmt.Errorf("quantity must be positive")
+		}
+		if msg.DeferredPayment {
+			return fmt.Errorf("multi-unit auctions do not support deferred payment")
+		}
+		if _, ok := PricingRule_name[int32(msg.PricingRule)]; !ok {
+			return fmt.Errorf("unknown pricing rule %s", msg.PricingRule)
+		}
+		if msg.Lot != nil {
+			if !msg.Lot.IsValid() || !msg.Lot.IsPositive() {
+				return fmt.Errorf("invalid lot")
+			}
+			if !msg.Lot.Amount.Mod(math.NewIntFromUint64(msg.Quantity)).IsZero() {
+				return fmt.Errorf("lot %s does not split evenly into %d units", msg.Lot, msg.Quantity)
+			}
+		}
+	case AuctionType_AUCTION_TYPE_REVERSE:
+		if msg.DeferredPayment {
+			return fmt.Errorf("reverse auctions do not support deferred payment")
+		}
+		if !msg.StartingBid.IsPositive() {
+			return fmt.Errorf("reverse auctions require a positive budget")
+		}
+	case AuctionType_AUCTION_TYPE_BUNDLE:
+		if msg.DeferredPayment {
+			return fmt.Errorf("bundle auctions do not support deferred payment")
+		}
+		if len(msg.Lots) < 2 {
+			return fmt.Errorf("bundle auctions require at least 2 lots")
+		}
+		for i, lot := range msg.Lots {
+			if lot == nil || lot.Item == "" {
+				return fmt.Errorf("lot %d item cannot be empty", i)
+			}
+			if lot.Coin != nil && (!lot.Coin.IsValid() || !lot.Coin.IsPositive()) {
+				return fmt.Errorf("invalid lot %d coin", i)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown auction type %s", msg.AuctionType)
+	}
 	return nil
 }
 
@@ -52,8 +154,82 @@ func (msg *MsgPlaceBid) ValidateBasic() error {
 	if msg.AuctionId == "" {
 		return fmt.Errorf("auction ID cannot be empty")
 	}
-	if !msg.BidAmount.IsValid() {
+	if msg.BidAmount == nil || !msg.BidAmount.IsValid() {
 		return fmt.Errorf("invalid bid amount")
 	}
+	if msg.NftDestination != nil {
+		if err := host.ChannelIdentifierValidator(msg.NftDestination.ChannelId); err != nil {
+			return fmt.Errorf("invalid NFT destination channel: %w", err)
+		}
+		if msg.NftDestination.Receiver == "" {
+			return fmt.Errorf("NFT destination receiver cannot be empty")
+		}
+	}
+	return nil
+}
+
+// Ensure MsgCancelAuction implements sdk.Msg interface
+var _ sdk.Msg = &MsgCancelAuction{}
+
+// NewMsgCancelAuction creates a new MsgCancelAuction instance
+func NewMsgCancelAuction(creator string, auctionID string) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		Creator:   creator,
+		AuctionId: auctionID,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgCancelAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
+	return nil
+}
+
+// Ensure MsgRegisterBidder implements sdk.Msg interface
+var _ sdk.Msg = &MsgRegisterBidder{}
+
+// NewMsgRegisterBidder creates a new MsgRegisterBidder instance
+func NewMsgRegisterBidder(bidder string, auctionID string) *MsgRegisterBidder {
+	return &MsgRegisterBidder{
+		Bidder:    bidder,
+		AuctionId: auctionID,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgRegisterBidder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return fmt.Errorf("invalid bidder address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
+	return nil
+}
+
+// Ensure MsgCompletePurchase implements sdk.Msg interface
+var _ sdk.Msg = &MsgCompletePurchase{}
+
+// NewMsgCompletePurchase creates a new MsgCompletePurchase instance
+func NewMsgCompletePurchase(buyer string, auctionID string) *MsgCompletePurchase {
+	return &MsgCompletePurchase{
+		Buyer:     buyer,
+		AuctionId: auctionID,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (msg *MsgCompletePurchase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if msg.AuctionId == "" {
+		return fmt.Errorf("auction ID cannot be empty")
+	}
 	return nil
 }
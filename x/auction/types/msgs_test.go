@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"auction/testutil/sample"
+	"auction/x/auction/types"
+)
+
+func TestMsgCreateAuctionMultiUnitValidateBasic(t *testing.T) {
+	lot := sdk.NewInt64Coin("launch", 1001)
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 2), 10)
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_MULTI_UNIT
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Quantity = 10
+	msg.Lot = &lot
+	require.ErrorContains(t, msg.ValidateBasic(), "does not split evenly")
+
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_ENGLISH
+	require.ErrorContains(t, msg.ValidateBasic(), "only supported by multi-unit auctions")
+}
+
+func TestMsgCreateAuctionRelistPolicyValidateBasic(t *testing.T) {
+	decrement := sdk.NewInt64Coin("token", 5)
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.RelistPolicy = &types.RelistPolicy{}
+	require.ErrorContains(t, msg.ValidateBasic(), "at least one relist")
+
+	msg.RelistPolicy = &types.RelistPolicy{MaxRelists: 2, Decrement: &decrement}
+	require.ErrorContains(t, msg.ValidateBasic(), "exceeds the starting bid")
+
+	msg.RelistPolicy.MaxRelists = 1
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	require.ErrorContains(t, msg.ValidateBasic(), "do not support a relist decrement")
+}
+
+func TestMsgCreateAuctionFeeAllowanceValidateBasic(t *testing.T) {
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.FeeAllowancePerBidder = sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+	require.ErrorContains(t, msg.ValidateBasic(), "requires a fee allowance")
+
+	msg.FeeAllowance = sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid fee allowance")
+
+	msg.FeeAllowance = sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.FeeAllowancePerBidder = nil
+	require.ErrorContains(t, msg.ValidateBasic(), "requires a fee allowance per bidder")
+}
+
+func TestMsgCreateAuctionDurationValidateBasic(t *testing.T) {
+	// a duration wrapping to a negative end height would settle the auction
+	// in the next block
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 1<<63)
+	require.ErrorContains(t, msg.ValidateBasic(), "duration cannot exceed")
+
+	msg.Duration = types.MaxDuration
+	require.NoError(t, msg.ValidateBasic())
+
+	schedule := types.NewMsgCreateAuctionSchedule(msg.Creator, types.AuctionTemplate{Item: "item", StartingBid: msg.StartingBid, Duration: 10}, 1<<63, 3, nil)
+	require.ErrorContains(t, schedule.ValidateBasic(), "interval cannot exceed")
+
+	schedule.Interval = types.MaxDuration
+	require.NoError(t, schedule.ValidateBasic())
+}
+
+func TestMsgCreateAuctionNFTLotValidateBasic(t *testing.T) {
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "painting", sdk.NewInt64Coin("token", 10), 10)
+	msg.NftLot = &types.NFTLot{ClassId: "art"}
+	require.ErrorContains(t, msg.ValidateBasic(), "class ID and ID cannot be empty")
+
+	msg.NftLot.Id = "mona-lisa"
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	require.ErrorContains(t, msg.ValidateBasic(), "only supported by english auctions")
+}
+
+func TestMsgCreateAuctionReserveValidateBasic(t *testing.T) {
+	msg := types.NewMsgCreateAuction(sample.AccAddress(), "item", sdk.NewInt64Coin("token", 10), 10)
+	msg.ReserveCommitment = "not a hash"
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid reserve commitment")
+
+	msg.ReserveCommitment = "abcd"
+	require.ErrorContains(t, msg.ValidateBasic(), "32 byte hash")
+
+	msg.ReserveCommitment = types.ReserveCommitment(sdk.NewInt64Coin("token", 50), "salt")
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.AuctionType = types.AuctionType_AUCTION_TYPE_REVERSE
+	require.ErrorContains(t, msg.ValidateBasic(), "only supported by english auctions")
+
+	reveal := types.NewMsgRevealReserve(msg.Creator, "auction-0", sdk.NewInt64Coin("token", 50), "")
+	require.ErrorContains(t, reveal.ValidateBasic(), "salt cannot be empty")
+
+	reveal.Salt = "salt"
+	require.NoError(t, reveal.ValidateBasic())
+}
+
+func TestMsgPlaceBidNFTDestinationValidateBasic(t *testing.T) {
+	msg := types.NewMsgPlaceBid(sample.AccAddress(), "auction-0", sdk.NewInt64Coin("token", 10))
+	msg.NftDestination = &types.NFTDestination{ChannelId: "not a channel", Receiver: "receiver"}
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid NFT destination channel")
+
+	msg.NftDestination = &types.NFTDestination{ChannelId: "channel-0"}
+	require.ErrorContains(t, msg.ValidateBasic(), "receiver cannot be empty")
+
+	msg.NftDestination.Receiver = "receiver"
+	require.NoError(t, msg.ValidateBasic())
+}
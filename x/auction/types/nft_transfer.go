@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NFTTransferTimeout is how long the NFT lots sent to the winners over
+// ICS-721 wait to be relayed before they time out and become claimable by the
+// winners.
+const NFTTransferTimeout = 10 * time.Minute
+
+// NonFungibleTokenPacketData is the packet data of ICS-721 transfers. The
+// module only sends NFT lots to the winners of its auctions and takes back the
+// NFTs it sent earlier, it does not mint vouchers for foreign NFTs.
+type NonFungibleTokenPacketData struct {
+	ClassId   string   `json:"classId"`
+	ClassUri  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIds  []string `json:"tokenIds"`
+	TokenUris []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// NFTLotMemo is the memo of the ICS-721 transfers delivering NFT lots, it
+// names the auction the NFT was won in:
+//
+//	{"auction":{"nft_lot":{"auction_id":"auction-0"}}}
+type NFTLotMemo struct {
+	Auction struct {
+		NFTLot struct {
+			AuctionId string `json:"auction_id"`
+		} `json:"nft_lot"`
+	} `json:"auction"`
+}
+
+// NewNFTLotMemo returns the memo of the transfer delivering the NFT lot of an
+// auction.
+func NewNFTLotMemo(auctionID string) string {
+	var memo NFTLotMemo
+	memo.Auction.NFTLot.AuctionId = auctionID
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// ParseNFTLotMemo returns the auction whose NFT lot a transfer delivers, or
+// false when the memo does not name one.
+func ParseNFTLotMemo(memo string) (string, bool) {
+	var nftLotMemo NFTLotMemo
+	if err := json.Unmarshal([]byte(memo), &nftLotMemo); err != nil {
+		return "", false
+	}
+	auctionID := nftLotMemo.Auction.NFTLot.AuctionId
+	return auctionID, auctionID != ""
+}
+
+// NFTClassTrace returns the class ID an NFT class of the chain at the other
+// end of an ICS-721 channel gets on this chain.
+func NFTClassTrace(portID, channelID, classID string) string {
+	return fmt.Sprintf("%s/%s/%s", portID, channelID, classID)
+}
+
+// ParseNFTClassTrace returns the class ID on this chain of an NFT coming back
+// over an ICS-721 channel, or false when the NFT did not leave this chain
+// over the channel.
+func ParseNFTClassTrace(portID, channelID, classID string) (string, bool) {
+	return strings.CutPrefix(classID, NFTClassTrace(portID, channelID, ""))
+}
+
+// GetBytes is a helper for serialising
+func (p NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic is used for validating the packet
+func (p NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(p.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidNFTLot, "class ID cannot be empty")
+	}
+	if len(p.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidNFTLot, "token IDs cannot be empty")
+	}
+	if len(p.TokenUris) > 0 && len(p.TokenUris) != len(p.TokenIds) {
+		return errorsmod.Wrap(ErrInvalidNFTLot, "token URIs do not match the token IDs")
+	}
+	if strings.TrimSpace(p.Sender) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender cannot be empty")
+	}
+	if strings.TrimSpace(p.Receiver) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be empty")
+	}
+
+	return nil
+}
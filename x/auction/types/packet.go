@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VoucherDenom returns the denom of the vouchers minted for a denom of the
+// chain at the other end of an auction channel.
+func VoucherDenom(portID, channelID, denom string) string {
+	return fmt.Sprintf("%s/%s/%s", portID, channelID, denom)
+}
+
+// IsVoucherDenom reports whether the denom is a voucher of an auction channel
+// of the port.
+func IsVoucherDenom(portID, denom string) bool {
+	return strings.HasPrefix(denom, portID+"/")
+}
+
+// ParseVoucherDenom returns the denom on the other end of an auction channel
+// a voucher stands for, or false when the denom is not a voucher of the
+// channel.
+func ParseVoucherDenom(portID, channelID, voucher string) (string, bool) {
+	return strings.CutPrefix(voucher, VoucherDenom(portID, channelID, ""))
+}
+
+// GetBytes is a helper for serialising
+func (p CreateRemoteAuctionPacketData) GetBytes() ([]byte, error) {
+	var modulePacket AuctionPacketData
+
+	modulePacket.Packet = &AuctionPacketData_CreateRemoteAuctionPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// ValidateBasic is used for validating the packet
+func (p CreateRemoteAuctionPacketData) ValidateBasic() error {
+	if p.Creator == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "creator cannot be empty")
+	}
+	if p.Item == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item cannot be empty")
+	}
+	if !p.StartingBid.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid starting bid %s", p.StartingBid)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p PlaceRemoteBidPacketData) GetBytes() ([]byte, error) {
+	var modulePacket AuctionPacketData
+
+	modulePacket.Packet = &AuctionPacketData_PlaceRemoteBidPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// ValidateBasic is used for validating the packet
+func (p PlaceRemoteBidPacketData) ValidateBasic() error {
+	if p.Bidder == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "bidder cannot be empty")
+	}
+	if p.AuctionId == "" {
+		return errorsmod.Wrap(ErrInvalidAuctionId, "auction ID cannot be empty")
+	}
+	if !p.BidAmount.IsValid() || !p.BidAmount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bid amount %s", p.BidAmount)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p AuctionResultPacketData) GetBytes() ([]byte, error) {
+	var modulePacket AuctionPacketData
+
+	modulePacket.Packet = &AuctionPacketData_AuctionResultPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// ValidateBasic is used for validating the packet
+func (p AuctionResultPacketData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Receiver); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if !p.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", p.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,2132 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: auction/auction/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemoteAuctionOutcome tells a remote participant why an auction result is
+// sent.
+type RemoteAuctionOutcome int32
+
+const (
+	RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_UNSPECIFIED RemoteAuctionOutcome = 0
+	// REFUNDED returns a bid that was outbid or cancelled.
+	RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_REFUNDED RemoteAuctionOutcome = 1
+	// WON reports the auction won by a remote bidder.
+	RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_WON RemoteAuctionOutcome = 2
+	// SOLD pays the proceeds of a remote auction to its creator.
+	RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_SOLD RemoteAuctionOutcome = 3
+)
+
+var RemoteAuctionOutcome_name = map[int32]string{
+	0: "REMOTE_AUCTION_OUTCOME_UNSPECIFIED",
+	1: "REMOTE_AUCTION_OUTCOME_REFUNDED",
+	2: "REMOTE_AUCTION_OUTCOME_WON",
+	3: "REMOTE_AUCTION_OUTCOME_SOLD",
+}
+
+var RemoteAuctionOutcome_value = map[string]int32{
+	"REMOTE_AUCTION_OUTCOME_UNSPECIFIED": 0,
+	"REMOTE_AUCTION_OUTCOME_REFUNDED":    1,
+	"REMOTE_AUCTION_OUTCOME_WON":         2,
+	"REMOTE_AUCTION_OUTCOME_SOLD":        3,
+}
+
+func (x RemoteAuctionOutcome) String() string {
+	return proto.EnumName(RemoteAuctionOutcome_name, int32(x))
+}
+
+func (RemoteAuctionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{0}
+}
+
+// AuctionPacketData is the data of the packets sent over auction channels.
+type AuctionPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*AuctionPacketData_NoData
+	//	*AuctionPacketData_CreateRemoteAuctionPacket
+	//	*AuctionPacketData_PlaceRemoteBidPacket
+	//	*AuctionPacketData_AuctionResultPacket
+	Packet isAuctionPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *AuctionPacketData) Reset()         { *m = AuctionPacketData{} }
+func (m *AuctionPacketData) String() string { return proto.CompactTextString(m) }
+func (*AuctionPacketData) ProtoMessage()    {}
+func (*AuctionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{0}
+}
+func (m *AuctionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionPacketData.Merge(m, src)
+}
+func (m *AuctionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionPacketData proto.InternalMessageInfo
+
+type isAuctionPacketData_Packet interface {
+	isAuctionPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type AuctionPacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=no_data,json=noData,proto3,oneof" json:"no_data,omitempty"`
+}
+type AuctionPacketData_CreateRemoteAuctionPacket struct {
+	CreateRemoteAuctionPacket *CreateRemoteAuctionPacketData `protobuf:"bytes,2,opt,name=create_remote_auction_packet,json=createRemoteAuctionPacket,proto3,oneof" json:"create_remote_auction_packet,omitempty"`
+}
+type AuctionPacketData_PlaceRemoteBidPacket struct {
+	PlaceRemoteBidPacket *PlaceRemoteBidPacketData `protobuf:"bytes,3,opt,name=place_remote_bid_packet,json=placeRemoteBidPacket,proto3,oneof" json:"place_remote_bid_packet,omitempty"`
+}
+type AuctionPacketData_AuctionResultPacket struct {
+	AuctionResultPacket *AuctionResultPacketData `protobuf:"bytes,4,opt,name=auction_result_packet,json=auctionResultPacket,proto3,oneof" json:"auction_result_packet,omitempty"`
+}
+
+func (*AuctionPacketData_NoData) isAuctionPacketData_Packet()                    {}
+func (*AuctionPacketData_CreateRemoteAuctionPacket) isAuctionPacketData_Packet() {}
+func (*AuctionPacketData_PlaceRemoteBidPacket) isAuctionPacketData_Packet()      {}
+func (*AuctionPacketData_AuctionResultPacket) isAuctionPacketData_Packet()       {}
+
+func (m *AuctionPacketData) GetPacket() isAuctionPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *AuctionPacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*AuctionPacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *AuctionPacketData) GetCreateRemoteAuctionPacket() *CreateRemoteAuctionPacketData {
+	if x, ok := m.GetPacket().(*AuctionPacketData_CreateRemoteAuctionPacket); ok {
+		return x.CreateRemoteAuctionPacket
+	}
+	return nil
+}
+
+func (m *AuctionPacketData) GetPlaceRemoteBidPacket() *PlaceRemoteBidPacketData {
+	if x, ok := m.GetPacket().(*AuctionPacketData_PlaceRemoteBidPacket); ok {
+		return x.PlaceRemoteBidPacket
+	}
+	return nil
+}
+
+func (m *AuctionPacketData) GetAuctionResultPacket() *AuctionResultPacketData {
+	if x, ok := m.GetPacket().(*AuctionPacketData_AuctionResultPacket); ok {
+		return x.AuctionResultPacket
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AuctionPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AuctionPacketData_NoData)(nil),
+		(*AuctionPacketData_CreateRemoteAuctionPacket)(nil),
+		(*AuctionPacketData_PlaceRemoteBidPacket)(nil),
+		(*AuctionPacketData_AuctionResultPacket)(nil),
+	}
+}
+
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// CreateRemoteAuctionPacketData lists an auction on the receiving chain on
+// behalf of a creator on the sending chain. The auction takes bids in the
+// vouchers the receiving chain mints for the starting bid denom.
+type CreateRemoteAuctionPacketData struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Item    string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// starting_bid is denominated on the sending chain.
+	StartingBid types.Coin `protobuf:"bytes,3,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid"`
+	// duration is the number of blocks the auction stays open. Zero falls back
+	// to the default_duration param of the receiving chain.
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *CreateRemoteAuctionPacketData) Reset()         { *m = CreateRemoteAuctionPacketData{} }
+func (m *CreateRemoteAuctionPacketData) String() string { return proto.CompactTextString(m) }
+func (*CreateRemoteAuctionPacketData) ProtoMessage()    {}
+func (*CreateRemoteAuctionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{2}
+}
+func (m *CreateRemoteAuctionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRemoteAuctionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRemoteAuctionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateRemoteAuctionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRemoteAuctionPacketData.Merge(m, src)
+}
+func (m *CreateRemoteAuctionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRemoteAuctionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRemoteAuctionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRemoteAuctionPacketData proto.InternalMessageInfo
+
+func (m *CreateRemoteAuctionPacketData) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CreateRemoteAuctionPacketData) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *CreateRemoteAuctionPacketData) GetStartingBid() types.Coin {
+	if m != nil {
+		return m.StartingBid
+	}
+	return types.Coin{}
+}
+
+func (m *CreateRemoteAuctionPacketData) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// CreateRemoteAuctionPacketAck defines a struct for the packet
+// acknowledgment.
+type CreateRemoteAuctionPacketAck struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *CreateRemoteAuctionPacketAck) Reset()         { *m = CreateRemoteAuctionPacketAck{} }
+func (m *CreateRemoteAuctionPacketAck) String() string { return proto.CompactTextString(m) }
+func (*CreateRemoteAuctionPacketAck) ProtoMessage()    {}
+func (*CreateRemoteAuctionPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{3}
+}
+func (m *CreateRemoteAuctionPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRemoteAuctionPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRemoteAuctionPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateRemoteAuctionPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRemoteAuctionPacketAck.Merge(m, src)
+}
+func (m *CreateRemoteAuctionPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRemoteAuctionPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRemoteAuctionPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRemoteAuctionPacketAck proto.InternalMessageInfo
+
+func (m *CreateRemoteAuctionPacketAck) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// PlaceRemoteBidPacketData bids on an auction of the receiving chain on behalf
+// of a bidder on the sending chain. The bid amount is escrowed on the sending
+// chain and minted as vouchers on the receiving chain.
+type PlaceRemoteBidPacketData struct {
+	Bidder    string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bid_amount is denominated on the sending chain.
+	BidAmount types.Coin `protobuf:"bytes,3,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount"`
+}
+
+func (m *PlaceRemoteBidPacketData) Reset()         { *m = PlaceRemoteBidPacketData{} }
+func (m *PlaceRemoteBidPacketData) String() string { return proto.CompactTextString(m) }
+func (*PlaceRemoteBidPacketData) ProtoMessage()    {}
+func (*PlaceRemoteBidPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{4}
+}
+func (m *PlaceRemoteBidPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaceRemoteBidPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaceRemoteBidPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaceRemoteBidPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceRemoteBidPacketData.Merge(m, src)
+}
+func (m *PlaceRemoteBidPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaceRemoteBidPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceRemoteBidPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceRemoteBidPacketData proto.InternalMessageInfo
+
+func (m *PlaceRemoteBidPacketData) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *PlaceRemoteBidPacketData) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *PlaceRemoteBidPacketData) GetBidAmount() types.Coin {
+	if m != nil {
+		return m.BidAmount
+	}
+	return types.Coin{}
+}
+
+// PlaceRemoteBidPacketAck defines a struct for the packet acknowledgment.
+type PlaceRemoteBidPacketAck struct {
+}
+
+func (m *PlaceRemoteBidPacketAck) Reset()         { *m = PlaceRemoteBidPacketAck{} }
+func (m *PlaceRemoteBidPacketAck) String() string { return proto.CompactTextString(m) }
+func (*PlaceRemoteBidPacketAck) ProtoMessage()    {}
+func (*PlaceRemoteBidPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{5}
+}
+func (m *PlaceRemoteBidPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaceRemoteBidPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaceRemoteBidPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaceRemoteBidPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceRemoteBidPacketAck.Merge(m, src)
+}
+func (m *PlaceRemoteBidPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaceRemoteBidPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceRemoteBidPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceRemoteBidPacketAck proto.InternalMessageInfo
+
+// AuctionResultPacketData returns to a remote participant the vouchers its
+// account received on the sending chain. The receiving chain releases the
+// amount from the escrow of the channel.
+type AuctionResultPacketData struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// receiver is the remote participant on the receiving chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// amount is denominated on the receiving chain, it is zero when nothing is
+	// returned.
+	Amount  types.Coin           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Outcome RemoteAuctionOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=auction.auction.RemoteAuctionOutcome" json:"outcome,omitempty"`
+}
+
+func (m *AuctionResultPacketData) Reset()         { *m = AuctionResultPacketData{} }
+func (m *AuctionResultPacketData) String() string { return proto.CompactTextString(m) }
+func (*AuctionResultPacketData) ProtoMessage()    {}
+func (*AuctionResultPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{6}
+}
+func (m *AuctionResultPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionResultPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionResultPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionResultPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionResultPacketData.Merge(m, src)
+}
+func (m *AuctionResultPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionResultPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionResultPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionResultPacketData proto.InternalMessageInfo
+
+func (m *AuctionResultPacketData) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *AuctionResultPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *AuctionResultPacketData) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *AuctionResultPacketData) GetOutcome() RemoteAuctionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return RemoteAuctionOutcome_REMOTE_AUCTION_OUTCOME_UNSPECIFIED
+}
+
+// AuctionResultPacketAck defines a struct for the packet acknowledgment.
+type AuctionResultPacketAck struct {
+}
+
+func (m *AuctionResultPacketAck) Reset()         { *m = AuctionResultPacketAck{} }
+func (m *AuctionResultPacketAck) String() string { return proto.CompactTextString(m) }
+func (*AuctionResultPacketAck) ProtoMessage()    {}
+func (*AuctionResultPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49c4ba95ed5fe9, []int{7}
+}
+func (m *AuctionResultPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionResultPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionResultPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionResultPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionResultPacketAck.Merge(m, src)
+}
+func (m *AuctionResultPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionResultPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionResultPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionResultPacketAck proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("auction.auction.RemoteAuctionOutcome", RemoteAuctionOutcome_name, RemoteAuctionOutcome_value)
+	proto.RegisterType((*AuctionPacketData)(nil), "auction.auction.AuctionPacketData")
+	proto.RegisterType((*NoData)(nil), "auction.auction.NoData")
+	proto.RegisterType((*CreateRemoteAuctionPacketData)(nil), "auction.auction.CreateRemoteAuctionPacketData")
+	proto.RegisterType((*CreateRemoteAuctionPacketAck)(nil), "auction.auction.CreateRemoteAuctionPacketAck")
+	proto.RegisterType((*PlaceRemoteBidPacketData)(nil), "auction.auction.PlaceRemoteBidPacketData")
+	proto.RegisterType((*PlaceRemoteBidPacketAck)(nil), "auction.auction.PlaceRemoteBidPacketAck")
+	proto.RegisterType((*AuctionResultPacketData)(nil), "auction.auction.AuctionResultPacketData")
+	proto.RegisterType((*AuctionResultPacketAck)(nil), "auction.auction.AuctionResultPacketAck")
+}
+
+func init() { proto.RegisterFile("auction/auction/packet.proto", fileDescriptor_4a49c4ba95ed5fe9) }
+
+var fileDescriptor_4a49c4ba95ed5fe9 = []byte{
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0xdb, 0x2a, 0x6d, 0xa6, 0x08, 0xd2, 0xa5, 0x34, 0x69, 0x68, 0x5d, 0x64, 0x04, 0x2a,
+	0x3d, 0x38, 0x6a, 0xb9, 0x82, 0x50, 0x7e, 0x5c, 0x1a, 0x89, 0xc6, 0x95, 0xdb, 0x08, 0x89, 0x03,
+	0xd6, 0x7a, 0xbd, 0xaa, 0x56, 0x69, 0xbc, 0xc1, 0xde, 0x54, 0xf0, 0x06, 0x1c, 0x39, 0xf1, 0x04,
+	0x1c, 0x38, 0x22, 0x9e, 0xa2, 0xc7, 0x4a, 0x5c, 0x38, 0x21, 0xd4, 0x1e, 0x78, 0x0d, 0xe4, 0xf5,
+	0x3a, 0x52, 0xeb, 0x18, 0x04, 0x17, 0x7b, 0x7f, 0xbe, 0x99, 0xef, 0x9b, 0x6f, 0x46, 0x0b, 0x6b,
+	0x78, 0x4c, 0x04, 0xe3, 0x61, 0x23, 0xfb, 0x8f, 0x30, 0x19, 0x50, 0x61, 0x8d, 0x22, 0x2e, 0x38,
+	0xba, 0xa5, 0x4e, 0x2d, 0xf5, 0xaf, 0x2f, 0xe1, 0x21, 0x0b, 0x79, 0x43, 0x7e, 0x53, 0x4c, 0xdd,
+	0x20, 0x3c, 0x1e, 0xf2, 0xb8, 0xe1, 0xe3, 0x98, 0x36, 0x4e, 0xb7, 0x7d, 0x2a, 0xf0, 0x76, 0x83,
+	0x70, 0x16, 0xaa, 0xfb, 0xe5, 0x63, 0x7e, 0xcc, 0xe5, 0xb2, 0x91, 0xac, 0xd2, 0x53, 0xf3, 0xfd,
+	0x2c, 0x2c, 0x35, 0xd3, 0xa4, 0x07, 0x92, 0xb1, 0x83, 0x05, 0x46, 0x3b, 0x30, 0x1f, 0x72, 0x2f,
+	0xc0, 0x02, 0xd7, 0xf4, 0x7b, 0xfa, 0xe6, 0xe2, 0x4e, 0xd5, 0xba, 0xa6, 0xc0, 0xea, 0xf1, 0x04,
+	0xb9, 0xa7, 0xb9, 0xa5, 0x50, 0xae, 0xd0, 0x1b, 0x58, 0x23, 0x11, 0xc5, 0x82, 0x7a, 0x11, 0x1d,
+	0x72, 0x41, 0x3d, 0x85, 0xf4, 0xd2, 0x4a, 0x6a, 0x33, 0x32, 0x91, 0x95, 0x4b, 0xd4, 0x96, 0x41,
+	0xae, 0x8c, 0xc9, 0x29, 0xd9, 0xd3, 0xdc, 0x55, 0x52, 0x04, 0x40, 0x3e, 0x54, 0x47, 0x27, 0x98,
+	0x4c, 0x18, 0x7d, 0x16, 0x64, 0x6c, 0xb3, 0x92, 0xed, 0x51, 0x8e, 0xed, 0x20, 0xc1, 0xa7, 0xb9,
+	0x5a, 0x2c, 0xb8, 0x42, 0xb4, 0x3c, 0x9a, 0x72, 0x87, 0x5e, 0xc3, 0x9d, 0xac, 0x90, 0x88, 0xc6,
+	0xe3, 0x13, 0x91, 0x31, 0xcc, 0x49, 0x86, 0xcd, 0x1c, 0x83, 0x92, 0xe8, 0x4a, 0xf0, 0x15, 0x82,
+	0xdb, 0x38, 0x7f, 0xd5, 0x5a, 0x80, 0x52, 0x9a, 0xd0, 0x5c, 0x80, 0x52, 0x6a, 0xaa, 0xf9, 0x55,
+	0x87, 0xf5, 0x3f, 0xda, 0x82, 0x6a, 0x30, 0x2f, 0x6d, 0xe1, 0x91, 0x6c, 0x50, 0xd9, 0xcd, 0xb6,
+	0x08, 0xc1, 0x1c, 0x13, 0x74, 0x28, 0xed, 0x2e, 0xbb, 0x72, 0x8d, 0x9e, 0xc3, 0x8d, 0x58, 0xe0,
+	0x48, 0xb0, 0xf0, 0x38, 0xf1, 0x48, 0x99, 0xb3, 0x6a, 0xa5, 0x13, 0x63, 0x25, 0x13, 0x63, 0xa9,
+	0x89, 0xb1, 0xda, 0x9c, 0x85, 0xad, 0xf2, 0xd9, 0x8f, 0x0d, 0xed, 0xf3, 0xaf, 0x2f, 0x5b, 0xba,
+	0xbb, 0x98, 0x45, 0xb6, 0x58, 0x80, 0xea, 0xb0, 0x10, 0x8c, 0x23, 0x9c, 0x88, 0x91, 0xf5, 0xcf,
+	0xb9, 0x93, 0xbd, 0xf9, 0x14, 0xd6, 0x0a, 0x35, 0x37, 0xc9, 0x00, 0xad, 0x03, 0x64, 0x46, 0xb2,
+	0x40, 0xa9, 0x2e, 0xab, 0x93, 0x6e, 0x60, 0x7e, 0xd4, 0xa1, 0x56, 0xd4, 0x1c, 0xb4, 0x02, 0x25,
+	0x9f, 0x05, 0x01, 0xcd, 0xaa, 0x55, 0xbb, 0x6b, 0x39, 0x67, 0xae, 0xe5, 0x44, 0x6d, 0x80, 0x64,
+	0x24, 0xf0, 0x90, 0x8f, 0x43, 0xf1, 0x4f, 0x55, 0x97, 0x7d, 0x16, 0x34, 0x65, 0x98, 0xb9, 0x0a,
+	0xd5, 0x69, 0xba, 0x9a, 0x64, 0x60, 0x7e, 0xd3, 0xa1, 0x5a, 0xd0, 0xee, 0xbf, 0x94, 0x9b, 0x38,
+	0x19, 0x51, 0x42, 0xd9, 0x29, 0x8d, 0x94, 0xee, 0xc9, 0x1e, 0x3d, 0x81, 0xd2, 0x7f, 0x48, 0x56,
+	0x31, 0xe8, 0x19, 0xcc, 0xf3, 0xb1, 0x20, 0x7c, 0x48, 0x65, 0x8b, 0x6e, 0xee, 0x3c, 0xc8, 0x8d,
+	0xe8, 0x95, 0x0e, 0x39, 0x29, 0xd8, 0xcd, 0xa2, 0xcc, 0x1a, 0xac, 0x4c, 0x29, 0xaa, 0x49, 0x06,
+	0x5b, 0x9f, 0x74, 0x58, 0x9e, 0x16, 0x8b, 0x1e, 0x82, 0xe9, 0xda, 0xfb, 0xce, 0x91, 0xed, 0x35,
+	0xfb, 0xed, 0xa3, 0xae, 0xd3, 0xf3, 0x9c, 0xfe, 0x51, 0xdb, 0xd9, 0xb7, 0xbd, 0x7e, 0xef, 0xf0,
+	0xc0, 0x6e, 0x77, 0x77, 0xbb, 0x76, 0xa7, 0xa2, 0xa1, 0xfb, 0xb0, 0x51, 0x80, 0x73, 0xed, 0xdd,
+	0x7e, 0xaf, 0x63, 0x77, 0x2a, 0x3a, 0x32, 0xa0, 0x5e, 0x00, 0x7a, 0xe9, 0xf4, 0x2a, 0x33, 0x68,
+	0x03, 0xee, 0x16, 0xdc, 0x1f, 0x3a, 0x2f, 0x3a, 0x95, 0xd9, 0xd6, 0xf6, 0xd9, 0x85, 0xa1, 0x9f,
+	0x5f, 0x18, 0xfa, 0xcf, 0x0b, 0x43, 0xff, 0x70, 0x69, 0x68, 0xe7, 0x97, 0x86, 0xf6, 0xfd, 0xd2,
+	0xd0, 0x5e, 0x55, 0xb3, 0xd7, 0xf5, 0xed, 0xe4, 0x9d, 0x15, 0xef, 0x46, 0x34, 0xf6, 0x4b, 0xf2,
+	0x35, 0x7c, 0xfc, 0x7b, 0x00, 0x1d, 0x68, 0x84, 0xb5, 0x87, 0x05, 0x00, 0x00,
+}
+
+func (m *AuctionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AuctionPacketData_CreateRemoteAuctionPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionPacketData_CreateRemoteAuctionPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreateRemoteAuctionPacket != nil {
+		{
+			size, err := m.CreateRemoteAuctionPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AuctionPacketData_PlaceRemoteBidPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionPacketData_PlaceRemoteBidPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PlaceRemoteBidPacket != nil {
+		{
+			size, err := m.PlaceRemoteBidPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AuctionPacketData_AuctionResultPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionPacketData_AuctionResultPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AuctionResultPacket != nil {
+		{
+			size, err := m.AuctionResultPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRemoteAuctionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRemoteAuctionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRemoteAuctionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRemoteAuctionPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRemoteAuctionPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRemoteAuctionPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaceRemoteBidPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaceRemoteBidPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaceRemoteBidPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaceRemoteBidPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaceRemoteBidPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaceRemoteBidPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionResultPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionResultPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionResultPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionResultPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionResultPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionResultPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuctionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *AuctionPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *AuctionPacketData_CreateRemoteAuctionPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateRemoteAuctionPacket != nil {
+		l = m.CreateRemoteAuctionPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *AuctionPacketData_PlaceRemoteBidPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlaceRemoteBidPacket != nil {
+		l = m.PlaceRemoteBidPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *AuctionPacketData_AuctionResultPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionResultPacket != nil {
+		l = m.AuctionResultPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CreateRemoteAuctionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.StartingBid.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovPacket(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *CreateRemoteAuctionPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PlaceRemoteBidPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.BidAmount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *PlaceRemoteBidPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AuctionResultPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.Outcome != 0 {
+		n += 1 + sovPacket(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *AuctionResultPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuctionPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AuctionPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRemoteAuctionPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateRemoteAuctionPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AuctionPacketData_CreateRemoteAuctionPacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceRemoteBidPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PlaceRemoteBidPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AuctionPacketData_PlaceRemoteBidPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionResultPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AuctionResultPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AuctionPacketData_AuctionResultPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRemoteAuctionPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRemoteAuctionPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRemoteAuctionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRemoteAuctionPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRemoteAuctionPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRemoteAuctionPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlaceRemoteBidPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlaceRemoteBidPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlaceRemoteBidPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlaceRemoteBidPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlaceRemoteBidPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlaceRemoteBidPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionResultPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionResultPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionResultPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= RemoteAuctionOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionResultPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionResultPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionResultPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
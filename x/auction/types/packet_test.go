@@ -0,0 +1,24 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"auction/x/auction/types"
+)
+
+func TestParseVoucherDenom(t *testing.T) {
+	voucher := types.VoucherDenom("auction", "channel-1", "uatom")
+	require.Equal(t, "auction/channel-1/uatom", voucher)
+
+	denom, ok := types.ParseVoucherDenom("auction", "channel-1", voucher)
+	require.True(t, ok)
+	require.Equal(t, "uatom", denom)
+
+	// vouchers of another channel and native denoms are not parsed
+	_, ok = types.ParseVoucherDenom("auction", "channel-10", voucher)
+	require.False(t, ok)
+	_, ok = types.ParseVoucherDenom("auction", "channel-1", "uatom")
+	require.False(t, ok)
+}
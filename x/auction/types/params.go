@@ -1,32 +1,266 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyDefaultDuration = []byte("DefaultDuration")
+	// DefaultDefaultDuration is roughly ten minutes with 6 second blocks.
+	DefaultDefaultDuration uint64 = 100
+
+	KeyRejectCreatorGrantees          = []byte("RejectCreatorGrantees")
+	DefaultRejectCreatorGrantees bool = false
+
+	KeySettlementWindow = []byte("SettlementWindow")
+	// DefaultSettlementWindow is roughly an hour with 6 second blocks.
+	DefaultSettlementWindow uint64 = 600
+
+	KeyBuybackInterval = []byte("BuybackInterval")
+	// DefaultBuybackInterval disables buybacks.
+	DefaultBuybackInterval uint64 = 0
+
+	KeyBuybackDenom     = []byte("BuybackDenom")
+	DefaultBuybackDenom = sdk.DefaultBondDenom
+
+	KeyBuybackToCommunityPool          = []byte("BuybackToCommunityPool")
+	DefaultBuybackToCommunityPool bool = false
+
+	KeyIncentiveRate = []byte("IncentiveRate")
+	// DefaultIncentiveRate disables incentives.
+	DefaultIncentiveRate = math.LegacyZeroDec()
+
+	KeyIncentiveEpochLength = []byte("IncentiveEpochLength")
+	// DefaultIncentiveEpochLength is roughly a day with 6 second blocks.
+	DefaultIncentiveEpochLength uint64 = 14400
+
+	KeyIncentiveEpochCap = []byte("IncentiveEpochCap")
+	// DefaultIncentiveEpochCap caps no denom, so no rebate is paid.
+	DefaultIncentiveEpochCap sdk.Coins = nil
+
+	KeyBuybackMinPrices = []byte("BuybackMinPrices")
+	// DefaultBuybackMinPrices prices no denom, so no fee is bought back.
+	DefaultBuybackMinPrices sdk.DecCoins = nil
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	defaultDuration uint64,
+	rejectCreatorGrantees bool,
+	settlementWindow uint64,
+	buybackInterval uint64,
+	buybackDenom string,
+	buybackToCommunityPool bool,
+	incentiveRate math.LegacyDec,
+	incentiveEpochLength uint64,
+	incentiveEpochCap sdk.Coins,
+	buybackMinPrices sdk.DecCoins,
+) Params {
+	return Params{
+		DefaultDuration:        defaultDuration,
+		RejectCreatorGrantees:  rejectCreatorGrantees,
+		SettlementWindow:       settlementWindow,
+		BuybackInterval:        buybackInterval,
+		BuybackDenom:           buybackDenom,
+		BuybackToCommunityPool: buybackToCommunityPool,
+		IncentiveRate:          incentiveRate,
+		IncentiveEpochLength:   incentiveEpochLength,
+		IncentiveEpochCap:      incentiveEpochCap,
+		BuybackMinPrices:       buybackMinPrices,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultDefaultDuration,
+		DefaultRejectCreatorGrantees,
+		DefaultSettlementWindow,
+		DefaultBuybackInterval,
+		DefaultBuybackDenom,
+		DefaultBuybackToCommunityPool,
+		DefaultIncentiveRate,
+		DefaultIncentiveEpochLength,
+		DefaultIncentiveEpochCap,
+		DefaultBuybackMinPrices,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDefaultDuration, &p.DefaultDuration, validateDefaultDuration),
+		paramtypes.NewParamSetPair(KeyRejectCreatorGrantees, &p.RejectCreatorGrantees, validateRejectCreatorGrantees),
+		paramtypes.NewParamSetPair(KeySettlementWindow, &p.SettlementWindow, validateSettlementWindow),
+		paramtypes.NewParamSetPair(KeyBuybackInterval, &p.BuybackInterval, validateBuybackInterval),
+		paramtypes.NewParamSetPair(KeyBuybackDenom, &p.BuybackDenom, validateBuybackDenom),
+		paramtypes.NewParamSetPair(KeyBuybackToCommunityPool, &p.BuybackToCommunityPool, validateBuybackToCommunityPool),
+		paramtypes.NewParamSetPair(KeyIncentiveRate, &p.IncentiveRate, validateIncentiveRate),
+		paramtypes.NewParamSetPair(KeyIncentiveEpochLength, &p.IncentiveEpochLength, validateIncentiveEpochLength),
+		paramtypes.NewParamSetPair(KeyIncentiveEpochCap, &p.IncentiveEpochCap, validateIncentiveEpochCap),
+		paramtypes.NewParamSetPair(KeyBuybackMinPrices, &p.BuybackMinPrices, validateBuybackMinPrices),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateDefaultDuration(p.DefaultDuration); err != nil {
+		return err
+	}
+	if err := validateRejectCreatorGrantees(p.RejectCreatorGrantees); err != nil {
+		return err
+	}
+	if err := validateSettlementWindow(p.SettlementWindow); err != nil {
+		return err
+	}
+	if err := validateBuybackInterval(p.BuybackInterval); err != nil {
+		return err
+	}
+	if err := validateBuybackDenom(p.BuybackDenom); err != nil {
+		return err
+	}
+	if err := validateBuybackToCommunityPool(p.BuybackToCommunityPool); err != nil {
+		return err
+	}
+	if err := validateIncentiveRate(p.IncentiveRate); err != nil {
+		return err
+	}
+	if err := validateIncentiveEpochLength(p.IncentiveEpochLength); err != nil {
+		return err
+	}
+	if err := validateIncentiveEpochCap(p.IncentiveEpochCap); err != nil {
+		return err
+	}
+	if err := validateBuybackMinPrices(p.BuybackMinPrices); err != nil {
+		return err
+	}
+	if p.BuybackMinPrices.AmountOf(p.BuybackDenom).IsPositive() {
+		return fmt.Errorf("buyback min prices cannot price the buyback denom %s", p.BuybackDenom)
+	}
+	return nil
+}
+
+// validateDefaultDuration validates the DefaultDuration param
+func validateDefaultDuration(v interface{}) error {
+	defaultDuration, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if defaultDuration == 0 {
+		return fmt.Errorf("default duration must be positive")
+	}
+	if defaultDuration > MaxDuration {
+		return fmt.Errorf("default duration cannot exceed %d blocks", MaxDuration)
+	}
+	return nil
+}
+
+// validateRejectCreatorGrantees validates the RejectCreatorGrantees param
+func validateRejectCreatorGrantees(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateSettlementWindow validates the SettlementWindow param
+func validateSettlementWindow(v interface{}) error {
+	settlementWindow, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if settlementWindow == 0 {
+		return fmt.Errorf("settlement window must be positive")
+	}
+	if settlementWindow > MaxDuration {
+		return fmt.Errorf("settlement window cannot exceed %d blocks", MaxDuration)
+	}
+	return nil
+}
+
+// validateBuybackInterval validates the BuybackInterval param
+func validateBuybackInterval(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateBuybackDenom validates the BuybackDenom param
+func validateBuybackDenom(v interface{}) error {
+	buybackDenom, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if err := sdk.ValidateDenom(buybackDenom); err != nil {
+		return fmt.Errorf("invalid buyback denom: %w", err)
+	}
+	return nil
+}
+
+// validateBuybackToCommunityPool validates the BuybackToCommunityPool param
+func validateBuybackToCommunityPool(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateIncentiveRate validates the IncentiveRate param
+func validateIncentiveRate(v interface{}) error {
+	incentiveRate, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if incentiveRate.IsNil() || incentiveRate.IsNegative() || incentiveRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("incentive rate must be between 0 and 1: %s", incentiveRate)
+	}
+	return nil
+}
+
+// validateIncentiveEpochLength validates the IncentiveEpochLength param
+func validateIncentiveEpochLength(v interface{}) error {
+	incentiveEpochLength, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if incentiveEpochLength == 0 {
+		return fmt.Errorf("incentive epoch length must be positive")
+	}
+	return nil
+}
+
+// validateIncentiveEpochCap validates the IncentiveEpochCap param
+func validateIncentiveEpochCap(v interface{}) error {
+	incentiveEpochCap, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if !incentiveEpochCap.IsValid() {
+		return fmt.Errorf("invalid incentive epoch cap: %s", incentiveEpochCap)
+	}
+	return nil
+}
+
+// validateBuybackMinPrices validates the BuybackMinPrices param
+func validateBuybackMinPrices(v interface{}) error {
+	buybackMinPrices, ok := v.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if !buybackMinPrices.IsValid() {
+		return fmt.Errorf("invalid buyback min prices: %s", buybackMinPrices)
+	}
 	return nil
 }
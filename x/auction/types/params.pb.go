@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +30,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// default_duration is the number of blocks an auction stays open when
+	// MsgCreateAuction does not set a duration.
+	DefaultDuration uint64 `protobuf:"varint,1,opt,name=default_duration,json=defaultDuration,proto3" json:"default_duration,omitempty"`
+	// reject_creator_grantees rejects bids from addresses holding an authz grant
+	// from the auction creator.
+	RejectCreatorGrantees bool `protobuf:"varint,2,opt,name=reject_creator_grantees,json=rejectCreatorGrantees,proto3" json:"reject_creator_grantees,omitempty"`
+	// settlement_window is the number of blocks the winner of a deferred-payment
+	// auction has to complete the purchase before its deposit is slashed.
+	SettlementWindow uint64 `protobuf:"varint,3,opt,name=settlement_window,json=settlementWindow,proto3" json:"settlement_window,omitempty"`
+	// buyback_interval is the number of blocks between two buyback rounds. Every
+	// round auctions the fees collected in the denoms of buyback_min_prices for
+	// buyback_denom. Zero disables buybacks.
+	BuybackInterval uint64 `protobuf:"varint,4,opt,name=buyback_interval,json=buybackInterval,proto3" json:"buyback_interval,omitempty"`
+	// buyback_denom is the denom buyback auctions are paid in, and the only
+	// denom of the collected fees that is not auctioned.
+	BuybackDenom string `protobuf:"bytes,5,opt,name=buyback_denom,json=buybackDenom,proto3" json:"buyback_denom,omitempty"`
+	// buyback_to_community_pool sends the proceeds of buyback auctions to the
+	// community pool instead of burning them.
+	BuybackToCommunityPool bool `protobuf:"varint,6,opt,name=buyback_to_community_pool,json=buybackToCommunityPool,proto3" json:"buyback_to_community_pool,omitempty"`
+	// incentive_rate is the share of the amount paid in a settled auction that
+	// the incentive pool rebates to the seller and to each winner. Zero disables
+	// incentives.
+	IncentiveRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=incentive_rate,json=incentiveRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"incentive_rate"`
+	// incentive_epoch_length is the number of blocks of an incentive epoch.
+	IncentiveEpochLength uint64 `protobuf:"varint,8,opt,name=incentive_epoch_length,json=incentiveEpochLength,proto3" json:"incentive_epoch_length,omitempty"`
+	// incentive_epoch_cap caps the rebates accrued per epoch, per denom. Auctions
+	// paid in a denom without a cap earn no rebate.
+	IncentiveEpochCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=incentive_epoch_cap,json=incentiveEpochCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"incentive_epoch_cap"`
+	// buyback_min_prices sets, per denom of the collected fees, the lowest price
+	// in buyback_denom a buyback auction accepts for one unit of that denom.
+	// Fees in a denom without a minimum price are left with the fee collector.
+	BuybackMinPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=buyback_min_prices,json=buybackMinPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"buyback_min_prices"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +97,69 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDefaultDuration() uint64 {
+	if m != nil {
+		return m.DefaultDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRejectCreatorGrantees() bool {
+	if m != nil {
+		return m.RejectCreatorGrantees
+	}
+	return false
+}
+
+func (m *Params) GetSettlementWindow() uint64 {
+	if m != nil {
+		return m.SettlementWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBuybackInterval() uint64 {
+	if m != nil {
+		return m.BuybackInterval
+	}
+	return 0
+}
+
+func (m *Params) GetBuybackDenom() string {
+	if m != nil {
+		return m.BuybackDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBuybackToCommunityPool() bool {
+	if m != nil {
+		return m.BuybackToCommunityPool
+	}
+	return false
+}
+
+func (m *Params) GetIncentiveEpochLength() uint64 {
+	if m != nil {
+		return m.IncentiveEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetIncentiveEpochCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.IncentiveEpochCap
+	}
+	return nil
+}
+
+func (m *Params) GetBuybackMinPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BuybackMinPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "auction.auction.Params")
 }
@@ -68,17 +167,44 @@ func init() {
 func init() { proto.RegisterFile("auction/auction/params.proto", fileDescriptor_f22c8605f2022f2c) }
 
 var fileDescriptor_f22c8605f2022f2c = []byte{
-	// 146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2c, 0x4d, 0x2e,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x87, 0xd1, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9,
-	0x25, 0xf9, 0x42, 0xfc, 0x50, 0x51, 0x3d, 0x28, 0x2d, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf,
-	0x0f, 0x26, 0x21, 0x6a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22,
-	0xaa, 0xa4, 0xcd, 0xc5, 0x16, 0x00, 0x36, 0xc9, 0x4a, 0xf1, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7,
-	0x1b, 0xb4, 0x24, 0x60, 0x56, 0x54, 0xc0, 0x2d, 0x83, 0x28, 0x71, 0x32, 0x3c, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x71, 0x4c, 0x3d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0x6b, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0xed, 0x5a, 0xa7, 0xc0, 0x00,
-	0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3d, 0x6f, 0xd4, 0x4c,
+	0x10, 0xc7, 0xcf, 0x4f, 0x5e, 0x9e, 0x64, 0x21, 0x24, 0x31, 0x21, 0x71, 0x42, 0xe4, 0x3b, 0xa0,
+	0x39, 0x12, 0xc5, 0x56, 0x78, 0x89, 0x80, 0x32, 0x77, 0x08, 0x21, 0x05, 0x29, 0x3a, 0x21, 0x21,
+	0x21, 0x21, 0x6b, 0xbd, 0x1e, 0x7c, 0xcb, 0xd9, 0x3b, 0x96, 0xbd, 0xbe, 0x70, 0x1d, 0x05, 0x15,
+	0x15, 0x1f, 0x81, 0x12, 0x51, 0xa5, 0xe0, 0x43, 0xa4, 0x8c, 0xa8, 0x10, 0x45, 0x40, 0x77, 0x42,
+	0xe1, 0x63, 0x20, 0xaf, 0xd7, 0x17, 0xf1, 0x52, 0xd0, 0x78, 0xbd, 0xff, 0xff, 0xcc, 0xce, 0xcf,
+	0xeb, 0x19, 0xb2, 0x4e, 0x73, 0x26, 0x39, 0x0a, 0xb7, 0x5a, 0x13, 0x9a, 0xd2, 0x38, 0x73, 0x92,
+	0x14, 0x25, 0x9a, 0xf3, 0x5a, 0x75, 0xf4, 0xba, 0xb6, 0x48, 0x63, 0x2e, 0xd0, 0x55, 0xcf, 0x32,
+	0x66, 0xcd, 0x66, 0x98, 0xc5, 0x98, 0xb9, 0x3e, 0xcd, 0xc0, 0xed, 0x6f, 0xfb, 0x20, 0xe9, 0xb6,
+	0xcb, 0x90, 0x0b, 0xed, 0xaf, 0x96, 0xbe, 0xa7, 0x76, 0x6e, 0xb9, 0xd1, 0xd6, 0x52, 0x88, 0x21,
+	0x96, 0x7a, 0xf1, 0x56, 0xaa, 0x57, 0xbf, 0x4f, 0x91, 0xe9, 0x7d, 0x45, 0x61, 0x5e, 0x27, 0x0b,
+	0x01, 0x3c, 0xa7, 0x79, 0x24, 0xbd, 0x20, 0x4f, 0x69, 0x81, 0x60, 0x19, 0x0d, 0xa3, 0x39, 0xd9,
+	0x99, 0xd7, 0x7a, 0x5b, 0xcb, 0xe6, 0x0e, 0x59, 0x49, 0xe1, 0x05, 0x30, 0xe9, 0xb1, 0x14, 0xa8,
+	0xc4, 0xd4, 0x0b, 0x53, 0x2a, 0x24, 0x40, 0x66, 0xfd, 0xd7, 0x30, 0x9a, 0x33, 0x9d, 0x4b, 0xa5,
+	0xdd, 0x2a, 0xdd, 0x07, 0xda, 0x34, 0x37, 0xc9, 0x62, 0x06, 0x52, 0x46, 0x10, 0x83, 0x90, 0xde,
+	0x01, 0x17, 0x01, 0x1e, 0x58, 0x13, 0xaa, 0xc6, 0xc2, 0x99, 0xf1, 0x44, 0xe9, 0x05, 0x8f, 0x9f,
+	0x0f, 0x7c, 0xca, 0x7a, 0x1e, 0x17, 0x12, 0xd2, 0x3e, 0x8d, 0xac, 0xc9, 0x92, 0x47, 0xeb, 0x0f,
+	0xb5, 0x6c, 0x5e, 0x23, 0x73, 0x55, 0x68, 0x00, 0x02, 0x63, 0x6b, 0xaa, 0x61, 0x34, 0x67, 0x3b,
+	0xe7, 0xb5, 0xd8, 0x2e, 0x34, 0xf3, 0x2e, 0x59, 0xad, 0x82, 0x24, 0x7a, 0x0c, 0xe3, 0x38, 0x17,
+	0x5c, 0x0e, 0xbc, 0x04, 0x31, 0xb2, 0xa6, 0x15, 0xf6, 0xb2, 0x0e, 0x78, 0x8c, 0xad, 0xca, 0xde,
+	0x47, 0x8c, 0xcc, 0x67, 0xe4, 0x02, 0x17, 0x0c, 0x84, 0xe4, 0x7d, 0xf0, 0x52, 0x2a, 0xc1, 0xfa,
+	0xbf, 0x28, 0xb0, 0xbb, 0x73, 0x74, 0x52, 0xaf, 0x7d, 0x39, 0xa9, 0x5f, 0x2e, 0x6f, 0x3a, 0x0b,
+	0x7a, 0x0e, 0x47, 0x37, 0xa6, 0xb2, 0xeb, 0xec, 0x41, 0x48, 0xd9, 0xa0, 0x0d, 0xec, 0xd3, 0xc7,
+	0x2d, 0xa2, 0x7f, 0x44, 0x1b, 0xd8, 0xfb, 0xd3, 0xc3, 0x0d, 0xa3, 0x33, 0x37, 0x3e, 0xad, 0x43,
+	0x25, 0x98, 0xb7, 0xc8, 0xf2, 0xd9, 0xf1, 0x90, 0x20, 0xeb, 0x7a, 0x11, 0x88, 0x50, 0x76, 0xad,
+	0x19, 0xf5, 0xbd, 0x4b, 0x63, 0xf7, 0x7e, 0x61, 0xee, 0x29, 0xcf, 0x7c, 0x65, 0x90, 0x8b, 0xbf,
+	0xa7, 0x31, 0x9a, 0x58, 0xb3, 0x8d, 0x89, 0xe6, 0xb9, 0x1b, 0xab, 0x8e, 0x2e, 0x5a, 0xb4, 0x8a,
+	0xa3, 0x5b, 0xc5, 0x69, 0x21, 0x17, 0xbb, 0xb7, 0x0b, 0xea, 0x0f, 0x5f, 0xeb, 0xcd, 0x90, 0xcb,
+	0x6e, 0xee, 0x3b, 0x0c, 0x63, 0xdd, 0x2a, 0x7a, 0xd9, 0xca, 0x82, 0x9e, 0x2b, 0x07, 0x09, 0x64,
+	0x2a, 0x21, 0x2b, 0xa1, 0x17, 0x7f, 0xa5, 0x68, 0xd1, 0xc4, 0x7c, 0x6d, 0x10, 0xb3, 0xba, 0xd3,
+	0x98, 0x0b, 0x2f, 0x49, 0x39, 0x83, 0xcc, 0x22, 0x8a, 0x60, 0xfd, 0xaf, 0x04, 0x6d, 0x60, 0x0a,
+	0xe2, 0x8e, 0x86, 0xd8, 0xfc, 0x07, 0x08, 0x9d, 0xa3, 0x39, 0xaa, 0xae, 0x78, 0xc4, 0xc5, 0xbe,
+	0xaa, 0x77, 0xef, 0xca, 0x8f, 0x77, 0x75, 0xe3, 0xcd, 0xe9, 0xe1, 0x86, 0x55, 0x0d, 0xd6, 0xcb,
+	0xf1, 0x88, 0x95, 0xcd, 0xbd, 0xbb, 0x7d, 0x34, 0xb4, 0x8d, 0xe3, 0xa1, 0x6d, 0x7c, 0x1b, 0xda,
+	0xc6, 0xdb, 0x91, 0x5d, 0x3b, 0x1e, 0xd9, 0xb5, 0xcf, 0x23, 0xbb, 0xf6, 0x74, 0xe5, 0xcf, 0x1c,
+	0x55, 0xd5, 0x9f, 0x56, 0x13, 0x72, 0xf3, 0xe7, 0x00, 0x8e, 0xf4, 0xca, 0xfc, 0xb6, 0x03, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +226,46 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.DefaultDuration != that1.DefaultDuration {
+		return false
+	}
+	if this.RejectCreatorGrantees != that1.RejectCreatorGrantees {
+		return false
+	}
+	if this.SettlementWindow != that1.SettlementWindow {
+		return false
+	}
+	if this.BuybackInterval != that1.BuybackInterval {
+		return false
+	}
+	if this.BuybackDenom != that1.BuybackDenom {
+		return false
+	}
+	if this.BuybackToCommunityPool != that1.BuybackToCommunityPool {
+		return false
+	}
+	if !this.IncentiveRate.Equal(that1.IncentiveRate) {
+		return false
+	}
+	if this.IncentiveEpochLength != that1.IncentiveEpochLength {
+		return false
+	}
+	if len(this.IncentiveEpochCap) != len(that1.IncentiveEpochCap) {
+		return false
+	}
+	for i := range this.IncentiveEpochCap {
+		if !this.IncentiveEpochCap[i].Equal(&that1.IncentiveEpochCap[i]) {
+			return false
+		}
+	}
+	if len(this.BuybackMinPrices) != len(that1.BuybackMinPrices) {
+		return false
+	}
+	for i := range this.BuybackMinPrices {
+		if !this.BuybackMinPrices[i].Equal(&that1.BuybackMinPrices[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +288,91 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BuybackMinPrices) > 0 {
+		for iNdEx := len(m.BuybackMinPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuybackMinPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.IncentiveEpochCap) > 0 {
+		for iNdEx := len(m.IncentiveEpochCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveEpochCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.IncentiveEpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IncentiveEpochLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.IncentiveRate.Size()
+		i -= size
+		if _, err := m.IncentiveRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BuybackToCommunityPool {
+		i--
+		if m.BuybackToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BuybackDenom) > 0 {
+		i -= len(m.BuybackDenom)
+		copy(dAtA[i:], m.BuybackDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BuybackDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BuybackInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BuybackInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettlementWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RejectCreatorGrantees {
+		i--
+		if m.RejectCreatorGrantees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DefaultDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultDuration))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -142,6 +393,42 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.DefaultDuration != 0 {
+		n += 1 + sovParams(uint64(m.DefaultDuration))
+	}
+	if m.RejectCreatorGrantees {
+		n += 2
+	}
+	if m.SettlementWindow != 0 {
+		n += 1 + sovParams(uint64(m.SettlementWindow))
+	}
+	if m.BuybackInterval != 0 {
+		n += 1 + sovParams(uint64(m.BuybackInterval))
+	}
+	l = len(m.BuybackDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BuybackToCommunityPool {
+		n += 2
+	}
+	l = m.IncentiveRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.IncentiveEpochLength != 0 {
+		n += 1 + sovParams(uint64(m.IncentiveEpochLength))
+	}
+	if len(m.IncentiveEpochCap) > 0 {
+		for _, e := range m.IncentiveEpochCap {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BuybackMinPrices) > 0 {
+		for _, e := range m.BuybackMinPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -180,6 +467,256 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDuration", wireType)
+			}
+			m.DefaultDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectCreatorGrantees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectCreatorGrantees = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementWindow", wireType)
+			}
+			m.SettlementWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackInterval", wireType)
+			}
+			m.BuybackInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuybackInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuybackDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BuybackToCommunityPool = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpochLength", wireType)
+			}
+			m.IncentiveEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentiveEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveEpochCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveEpochCap = append(m.IncentiveEpochCap, types.Coin{})
+			if err := m.IncentiveEpochCap[len(m.IncentiveEpochCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackMinPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuybackMinPrices = append(m.BuybackMinPrices, types.DecCoin{})
+			if err := m.BuybackMinPrices[len(m.BuybackMinPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,212 +116,2179 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "auction.auction.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "auction.auction.QueryParamsResponse")
+// QuerySuspiciousAuctionsRequest is request type for the Query/SuspiciousAuctions RPC method.
+type QuerySuspiciousAuctionsRequest struct {
+	// creator is the auction creator to inspect.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// lookback is the number of blocks in which settled auctions count towards
+	// the creator's counterparties. Zero looks at the whole history.
+	Lookback uint64 `protobuf:"varint,2,opt,name=lookback,proto3" json:"lookback,omitempty"`
+	// min_overlap_percent is the share of an auction's bidders that must be
+	// counterparties for the auction to be flagged. Zero defaults to 50.
+	MinOverlapPercent uint32 `protobuf:"varint,3,opt,name=min_overlap_percent,json=minOverlapPercent,proto3" json:"min_overlap_percent,omitempty"`
 }
 
-func init() { proto.RegisterFile("auction/auction/query.proto", fileDescriptor_2b00c0271385f7fe) }
-
-var fileDescriptor_2b00c0271385f7fe = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0x4d, 0x2e,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x87, 0xd1, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0xfc, 0x50, 0x41, 0x3d, 0x28, 0x2d, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x6a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a,
-	0x93, 0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f,
-	0x92, 0x08, 0xd2, 0x5f, 0x0c, 0x95, 0xd5, 0x4a, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x4f, 0x4a,
-	0x2c, 0x4e, 0x85, 0x58, 0xa8, 0x5f, 0x66, 0x98, 0x94, 0x5a, 0x92, 0x68, 0xa8, 0x5f, 0x90, 0x98,
-	0x9e, 0x99, 0x07, 0x56, 0x0c, 0x33, 0x09, 0xdd, 0x81, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x93,
-	0x94, 0x44, 0xb8, 0x84, 0x02, 0x41, 0xfa, 0x03, 0xc0, 0x82, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5,
-	0x25, 0x4a, 0x81, 0x5c, 0xc2, 0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x56, 0x5c,
-	0x6c, 0x10, 0xcd, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xe2, 0x7a, 0x68, 0xfe, 0xd3, 0x83,
-	0x68, 0x70, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x1d,
-	0x46, 0xb5, 0x5c, 0xac, 0x60, 0x23, 0x85, 0x4a, 0xb8, 0xd8, 0x20, 0xaa, 0x84, 0x94, 0x31, 0xb4,
-	0x63, 0x3a, 0x45, 0x4a, 0x05, 0xbf, 0x22, 0x88, 0xcb, 0x94, 0xe4, 0x9b, 0x2e, 0x3f, 0x99, 0xcc,
-	0x24, 0x29, 0x24, 0xae, 0x8f, 0xdd, 0xb7, 0x4e, 0x86, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x25, 0x0e, 0x53, 0x59, 0x01, 0xd7, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0x0e, 0x21, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0x02, 0xbb, 0x7e, 0xe2, 0x01,
-	0x00, 0x00,
+func (m *QuerySuspiciousAuctionsRequest) Reset()         { *m = QuerySuspiciousAuctionsRequest{} }
+func (m *QuerySuspiciousAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuspiciousAuctionsRequest) ProtoMessage()    {}
+func (*QuerySuspiciousAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{2}
+}
+func (m *QuerySuspiciousAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuspiciousAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuspiciousAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuspiciousAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuspiciousAuctionsRequest.Merge(m, src)
+}
+func (m *QuerySuspiciousAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuspiciousAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuspiciousAuctionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QuerySuspiciousAuctionsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySuspiciousAuctionsRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QuerySuspiciousAuctionsRequest) GetLookback() uint64 {
+	if m != nil {
+		return m.Lookback
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySuspiciousAuctionsRequest) GetMinOverlapPercent() uint32 {
+	if m != nil {
+		return m.MinOverlapPercent
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QuerySuspiciousAuctionsResponse is response type for the Query/SuspiciousAuctions RPC method.
+type QuerySuspiciousAuctionsResponse struct {
+	Auctions []SuspiciousAuction `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/auction.auction.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySuspiciousAuctionsResponse) Reset()         { *m = QuerySuspiciousAuctionsResponse{} }
+func (m *QuerySuspiciousAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuspiciousAuctionsResponse) ProtoMessage()    {}
+func (*QuerySuspiciousAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{3}
+}
+func (m *QuerySuspiciousAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuspiciousAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuspiciousAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySuspiciousAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuspiciousAuctionsResponse.Merge(m, src)
+}
+func (m *QuerySuspiciousAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuspiciousAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuspiciousAuctionsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QuerySuspiciousAuctionsResponse proto.InternalMessageInfo
+
+func (m *QuerySuspiciousAuctionsResponse) GetAuctions() []SuspiciousAuction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// SuspiciousAuction is a live auction whose bidders overlap with the
+// creator's recent counterparties.
+type SuspiciousAuction struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// counterparty_bidders are the bidders that recently traded with the creator.
+	CounterpartyBidders []string `protobuf:"bytes,2,rep,name=counterparty_bidders,json=counterpartyBidders,proto3" json:"counterparty_bidders,omitempty"`
+	// overlap_percent is the share of the auction's bidders that are
+	// counterparties.
+	OverlapPercent uint32 `protobuf:"varint,3,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SuspiciousAuction) Reset()         { *m = SuspiciousAuction{} }
+func (m *SuspiciousAuction) String() string { return proto.CompactTextString(m) }
+func (*SuspiciousAuction) ProtoMessage()    {}
+func (*SuspiciousAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{4}
+}
+func (m *SuspiciousAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspiciousAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspiciousAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspiciousAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspiciousAuction.Merge(m, src)
+}
+func (m *SuspiciousAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspiciousAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspiciousAuction.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_SuspiciousAuction proto.InternalMessageInfo
+
+func (m *SuspiciousAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *SuspiciousAuction) GetCounterpartyBidders() []string {
+	if m != nil {
+		return m.CounterpartyBidders
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+func (m *SuspiciousAuction) GetOverlapPercent() uint32 {
+	if m != nil {
+		return m.OverlapPercent
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auction.auction.Query/Params",
+	return 0
+}
+
+// QueryAuctionScheduleRequest is request type for the Query/AuctionSchedule RPC method.
+type QueryAuctionScheduleRequest struct {
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+}
+
+func (m *QueryAuctionScheduleRequest) Reset()         { *m = QueryAuctionScheduleRequest{} }
+func (m *QueryAuctionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionScheduleRequest) ProtoMessage()    {}
+func (*QueryAuctionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{5}
+}
+func (m *QueryAuctionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryAuctionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionScheduleRequest.Merge(m, src)
+}
+func (m *QueryAuctionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "auction.auction.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "auction/auction/query.proto",
+// QueryAuctionScheduleResponse is response type for the Query/AuctionSchedule RPC method.
+type QueryAuctionScheduleResponse struct {
+	Schedule AuctionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionScheduleResponse) Reset()         { *m = QueryAuctionScheduleResponse{} }
+func (m *QueryAuctionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionScheduleResponse) ProtoMessage()    {}
+func (*QueryAuctionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{6}
+}
+func (m *QueryAuctionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAuctionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionScheduleResponse.Merge(m, src)
+}
+func (m *QueryAuctionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAuctionScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionScheduleResponse) GetSchedule() AuctionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return AuctionSchedule{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryAuctionSchedulesRequest is request type for the Query/AuctionSchedules RPC method.
+type QueryAuctionSchedulesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionSchedulesRequest) Reset()         { *m = QueryAuctionSchedulesRequest{} }
+func (m *QueryAuctionSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionSchedulesRequest) ProtoMessage()    {}
+func (*QueryAuctionSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{7}
+}
+func (m *QueryAuctionSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAuctionSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionSchedulesRequest.Merge(m, src)
+}
+func (m *QueryAuctionSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAuctionSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryAuctionSchedulesResponse is response type for the Query/AuctionSchedules RPC method.
+type QueryAuctionSchedulesResponse struct {
+	Schedules  []AuctionSchedule   `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionSchedulesResponse) Reset()         { *m = QueryAuctionSchedulesResponse{} }
+func (m *QueryAuctionSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionSchedulesResponse) ProtoMessage()    {}
+func (*QueryAuctionSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{8}
+}
+func (m *QueryAuctionSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionSchedulesResponse.Merge(m, src)
+}
+func (m *QueryAuctionSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionSchedulesResponse) GetSchedules() []AuctionSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryAuctionSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionRewardsRequest is request type for the Query/AuctionRewards RPC method.
+type QueryAuctionRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAuctionRewardsRequest) Reset()         { *m = QueryAuctionRewardsRequest{} }
+func (m *QueryAuctionRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRewardsRequest) ProtoMessage()    {}
+func (*QueryAuctionRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{9}
+}
+func (m *QueryAuctionRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRewardsRequest.Merge(m, src)
+}
+func (m *QueryAuctionRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAuctionRewardsResponse is response type for the Query/AuctionRewards RPC method.
+type QueryAuctionRewardsResponse struct {
+	// rewards are the rebates accrued and not claimed yet.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryAuctionRewardsResponse) Reset()         { *m = QueryAuctionRewardsResponse{} }
+func (m *QueryAuctionRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRewardsResponse) ProtoMessage()    {}
+func (*QueryAuctionRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b00c0271385f7fe, []int{10}
+}
+func (m *QueryAuctionRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRewardsResponse.Merge(m, src)
+}
+func (m *QueryAuctionRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "auction.auction.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "auction.auction.QueryParamsResponse")
+	proto.RegisterType((*QuerySuspiciousAuctionsRequest)(nil), "auction.auction.QuerySuspiciousAuctionsRequest")
+	proto.RegisterType((*QuerySuspiciousAuctionsResponse)(nil), "auction.auction.QuerySuspiciousAuctionsResponse")
+	proto.RegisterType((*SuspiciousAuction)(nil), "auction.auction.SuspiciousAuction")
+	proto.RegisterType((*QueryAuctionScheduleRequest)(nil), "auction.auction.QueryAuctionScheduleRequest")
+	proto.RegisterType((*QueryAuctionScheduleResponse)(nil), "auction.auction.QueryAuctionScheduleResponse")
+	proto.RegisterType((*QueryAuctionSchedulesRequest)(nil), "auction.auction.QueryAuctionSchedulesRequest")
+	proto.RegisterType((*QueryAuctionSchedulesResponse)(nil), "auction.auction.QueryAuctionSchedulesResponse")
+	proto.RegisterType((*QueryAuctionRewardsRequest)(nil), "auction.auction.QueryAuctionRewardsRequest")
+	proto.RegisterType((*QueryAuctionRewardsResponse)(nil), "auction.auction.QueryAuctionRewardsResponse")
+}
+
+func init() { proto.RegisterFile("auction/auction/query.proto", fileDescriptor_2b00c0271385f7fe) }
+
+var fileDescriptor_2b00c0271385f7fe = []byte{
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xa4, 0x90, 0x6d, 0x5e, 0xc5, 0x96, 0x4e, 0x2b, 0x35, 0xeb, 0xee, 0x3a, 0x91, 0x59,
+	0xb1, 0x51, 0xe8, 0xda, 0x4d, 0x10, 0x2b, 0xc4, 0x01, 0x89, 0x20, 0xb1, 0xea, 0x89, 0xae, 0xf7,
+	0xc6, 0x25, 0x9a, 0xd8, 0x83, 0xd7, 0x34, 0xf1, 0x78, 0x3d, 0xf6, 0xb2, 0x55, 0xd5, 0x0b, 0x07,
+	0x24, 0x2e, 0x08, 0x89, 0x2b, 0x88, 0xeb, 0x8a, 0x0b, 0x48, 0xfc, 0x13, 0x7b, 0x5c, 0x89, 0x0b,
+	0x27, 0x40, 0x2d, 0x12, 0xff, 0x06, 0xca, 0xfc, 0x70, 0xe3, 0xd8, 0x69, 0xc3, 0xa5, 0xb6, 0xe7,
+	0xbd, 0xef, 0xbd, 0xef, 0x7b, 0x9d, 0xef, 0x05, 0xf6, 0x48, 0xe6, 0xa5, 0x21, 0x8b, 0x1c, 0xfd,
+	0x7c, 0x9a, 0xd1, 0xe4, 0xc4, 0x8e, 0x13, 0x96, 0x32, 0xbc, 0xa9, 0x0e, 0x6d, 0xf5, 0x34, 0xb6,
+	0xc8, 0x34, 0x8c, 0x98, 0x23, 0xfe, 0xca, 0x1c, 0xc3, 0xf4, 0x18, 0x9f, 0x32, 0xee, 0x8c, 0x09,
+	0xa7, 0xce, 0xb3, 0xfe, 0x98, 0xa6, 0xa4, 0xef, 0x78, 0x2c, 0x8c, 0x54, 0x7c, 0x27, 0x60, 0x01,
+	0x13, 0xaf, 0xce, 0xec, 0x4d, 0x9d, 0xde, 0x0e, 0x18, 0x0b, 0x26, 0xd4, 0x21, 0x71, 0xe8, 0x90,
+	0x28, 0x62, 0x29, 0x99, 0xd5, 0xe7, 0x2a, 0xda, 0x9b, 0xaf, 0x29, 0x08, 0xe5, 0x95, 0x63, 0x12,
+	0x84, 0x91, 0x48, 0xd6, 0x95, 0x16, 0x05, 0xc4, 0x24, 0x21, 0x53, 0x5d, 0xa9, 0xb5, 0x18, 0x4d,
+	0x9f, 0xcb, 0x88, 0xb5, 0x03, 0xf8, 0xd1, 0xac, 0xf2, 0x91, 0x48, 0x77, 0xe9, 0xd3, 0x8c, 0xf2,
+	0xd4, 0x7a, 0x04, 0xdb, 0x85, 0x53, 0x1e, 0xb3, 0x88, 0x53, 0xfc, 0x01, 0x34, 0x64, 0xd9, 0x16,
+	0xea, 0xa0, 0xee, 0xc6, 0x60, 0xd7, 0x5e, 0x98, 0x8c, 0x2d, 0x01, 0xc3, 0xe6, 0xcb, 0x3f, 0xdb,
+	0xb5, 0x17, 0xff, 0xfe, 0xda, 0x43, 0xae, 0x42, 0x58, 0x5f, 0x23, 0x30, 0x45, 0xcd, 0xc7, 0x19,
+	0x8f, 0x43, 0x2f, 0x64, 0x19, 0xff, 0x48, 0x82, 0x74, 0x57, 0xdc, 0x82, 0x1b, 0x5e, 0x42, 0x49,
+	0xca, 0x12, 0x51, 0xbf, 0xe9, 0xea, 0x4f, 0x6c, 0xc0, 0xfa, 0x84, 0xb1, 0xe3, 0x31, 0xf1, 0x8e,
+	0x5b, 0xf5, 0x0e, 0xea, 0xbe, 0xe6, 0xe6, 0xdf, 0xd8, 0x86, 0xed, 0x69, 0x18, 0x8d, 0xd8, 0x33,
+	0x9a, 0x4c, 0x48, 0x3c, 0x8a, 0x69, 0xe2, 0xd1, 0x28, 0x6d, 0xad, 0x75, 0x50, 0xf7, 0x0d, 0x77,
+	0x6b, 0x1a, 0x46, 0x9f, 0xca, 0xc8, 0x91, 0x0c, 0x58, 0x13, 0x68, 0x2f, 0xe5, 0xa1, 0x74, 0x1e,
+	0xc2, 0xba, 0x12, 0x34, 0x53, 0xba, 0xd6, 0xdd, 0x18, 0x58, 0x25, 0xa5, 0x25, 0xf8, 0xbc, 0xe8,
+	0x1c, 0x6e, 0x7d, 0x8b, 0x60, 0xab, 0x94, 0x8a, 0xef, 0x00, 0xa8, 0x8c, 0x51, 0xe8, 0x2b, 0xb1,
+	0x4d, 0x75, 0x72, 0xe8, 0xe3, 0x3e, 0xec, 0x78, 0x2c, 0x8b, 0x52, 0x9a, 0xc4, 0x24, 0x49, 0x4f,
+	0x46, 0xe3, 0xd0, 0xf7, 0x69, 0xc2, 0x5b, 0xf5, 0xce, 0x5a, 0xb7, 0xe9, 0x6e, 0xcf, 0xc7, 0x86,
+	0x32, 0x84, 0xef, 0xc1, 0x66, 0xf5, 0x04, 0x6e, 0xb2, 0xa2, 0xfc, 0x0f, 0x61, 0x4f, 0xc8, 0x57,
+	0x54, 0x1e, 0x7b, 0x4f, 0xa8, 0x9f, 0x4d, 0xa8, 0xfe, 0x1f, 0xb4, 0x61, 0x83, 0xab, 0xa3, 0x4b,
+	0x6a, 0xa0, 0x8f, 0x0e, 0x7d, 0x2b, 0x80, 0xdb, 0xd5, 0x78, 0x35, 0xbb, 0x87, 0xb0, 0xae, 0xb3,
+	0xd5, 0x2d, 0xe9, 0x94, 0x66, 0xb7, 0x80, 0x2d, 0x4c, 0x4e, 0x83, 0xad, 0xcf, 0xab, 0x1b, 0xe5,
+	0xb7, 0xe5, 0x13, 0x80, 0x4b, 0x17, 0xa8, 0x56, 0x6f, 0xdb, 0xd2, 0x32, 0xf6, 0xcc, 0x32, 0xb6,
+	0xf4, 0xb0, 0xb2, 0x8c, 0x7d, 0x44, 0x02, 0xad, 0xd2, 0x9d, 0x43, 0x5a, 0xbf, 0x21, 0xb8, 0xb3,
+	0xa4, 0x51, 0x7e, 0x1d, 0x9a, 0x9a, 0x95, 0xbe, 0x0f, 0xff, 0x4b, 0xd3, 0x25, 0x1a, 0x3f, 0x2c,
+	0x90, 0xae, 0x0b, 0xd2, 0xf7, 0xae, 0x25, 0x2d, 0x79, 0x14, 0x58, 0x3f, 0x00, 0x63, 0x9e, 0xb4,
+	0x4b, 0xbf, 0x24, 0x89, 0x3f, 0xef, 0x24, 0xe2, 0xfb, 0x09, 0xe5, 0x5c, 0x3b, 0x49, 0x7d, 0x5a,
+	0xdf, 0x20, 0xd8, 0xab, 0x04, 0x2a, 0xad, 0x5f, 0xc0, 0x8d, 0x44, 0x1e, 0x29, 0xa5, 0xb7, 0x0a,
+	0xec, 0x34, 0xaf, 0x8f, 0x59, 0x18, 0x0d, 0xdf, 0x9b, 0x49, 0xfc, 0xf9, 0xaf, 0x76, 0x37, 0x08,
+	0xd3, 0x27, 0xd9, 0xd8, 0xf6, 0xd8, 0xd4, 0x51, 0x2b, 0x4b, 0x3e, 0xee, 0x73, 0xff, 0xd8, 0x49,
+	0x4f, 0x62, 0xca, 0x05, 0x80, 0xcb, 0x71, 0xe8, 0x06, 0x83, 0x1f, 0x1a, 0xf0, 0xba, 0xe0, 0x82,
+	0x53, 0x68, 0xc8, 0xcd, 0x81, 0xdf, 0x2a, 0x0d, 0xb6, 0xbc, 0x9e, 0x8c, 0xbb, 0x57, 0x27, 0x49,
+	0x29, 0x56, 0xfb, 0xab, 0xdf, 0xff, 0xf9, 0xbe, 0x7e, 0x0b, 0xef, 0x3a, 0xd5, 0xbb, 0x11, 0xff,
+	0x82, 0x00, 0x97, 0xb7, 0x00, 0x76, 0xaa, 0xab, 0x2f, 0xdd, 0x5b, 0xc6, 0xc1, 0xea, 0x00, 0x45,
+	0xed, 0x81, 0xa0, 0x76, 0x80, 0xed, 0x12, 0x35, 0x9e, 0x83, 0x46, 0xea, 0x88, 0x3b, 0xa7, 0x6a,
+	0x0d, 0x9e, 0xe1, 0x17, 0x08, 0x36, 0x17, 0x2e, 0x1a, 0xde, 0xaf, 0xee, 0x5e, 0xed, 0x6f, 0xe3,
+	0xfe, 0x8a, 0xd9, 0x8a, 0xe8, 0xfb, 0x82, 0xe8, 0x00, 0x1f, 0x94, 0x88, 0xea, 0xfd, 0x95, 0xdf,
+	0x6d, 0xe7, 0x74, 0x6e, 0x71, 0x9c, 0xe1, 0x1f, 0x11, 0xbc, 0xb9, 0xe8, 0x28, 0xbc, 0x5a, 0xf7,
+	0x7c, 0xb0, 0xf6, 0xaa, 0xe9, 0x8a, 0x6d, 0x4f, 0xb0, 0xbd, 0x8b, 0xad, 0xeb, 0xd9, 0xe2, 0x9f,
+	0x10, 0xdc, 0x2c, 0x7a, 0x00, 0xbf, 0x73, 0x65, 0xbb, 0xa2, 0xc5, 0x8c, 0xfd, 0xd5, 0x92, 0x15,
+	0xb3, 0x81, 0x60, 0xb6, 0x8f, 0x7b, 0x4b, 0x99, 0x29, 0x53, 0x38, 0xa7, 0xca, 0xa9, 0x67, 0xc3,
+	0xfe, 0xcb, 0x73, 0x13, 0xbd, 0x3a, 0x37, 0xd1, 0xdf, 0xe7, 0x26, 0xfa, 0xee, 0xc2, 0xac, 0xbd,
+	0xba, 0x30, 0x6b, 0x7f, 0x5c, 0x98, 0xb5, 0xcf, 0x76, 0x35, 0xf8, 0x79, 0x5e, 0x46, 0xb8, 0x6c,
+	0xdc, 0x10, 0x3f, 0xea, 0xef, 0xfe, 0x37, 0x00, 0x2f, 0xcd, 0xd1, 0xc2, 0xcf, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SuspiciousAuctions flags the live auctions of a creator whose bidders
+	// overlap with the creator's recent counterparties.
+	SuspiciousAuctions(ctx context.Context, in *QuerySuspiciousAuctionsRequest, opts ...grpc.CallOption) (*QuerySuspiciousAuctionsResponse, error)
+	// AuctionSchedule queries a recurring auction schedule by ID.
+	AuctionSchedule(ctx context.Context, in *QueryAuctionScheduleRequest, opts ...grpc.CallOption) (*QueryAuctionScheduleResponse, error)
+	// AuctionSchedules lists the recurring auction schedules.
+	AuctionSchedules(ctx context.Context, in *QueryAuctionSchedulesRequest, opts ...grpc.CallOption) (*QueryAuctionSchedulesResponse, error)
+	// AuctionRewards queries the incentive rebates accrued by an address.
+	AuctionRewards(ctx context.Context, in *QueryAuctionRewardsRequest, opts ...grpc.CallOption) (*QueryAuctionRewardsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuspiciousAuctions(ctx context.Context, in *QuerySuspiciousAuctionsRequest, opts ...grpc.CallOption) (*QuerySuspiciousAuctionsResponse, error) {
+	out := new(QuerySuspiciousAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Query/SuspiciousAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionSchedule(ctx context.Context, in *QueryAuctionScheduleRequest, opts ...grpc.CallOption) (*QueryAuctionScheduleResponse, error) {
+	out := new(QueryAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Query/AuctionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionSchedules(ctx context.Context, in *QueryAuctionSchedulesRequest, opts ...grpc.CallOption) (*QueryAuctionSchedulesResponse, error) {
+	out := new(QueryAuctionSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Query/AuctionSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionRewards(ctx context.Context, in *QueryAuctionRewardsRequest, opts ...grpc.CallOption) (*QueryAuctionRewardsResponse, error) {
+	out := new(QueryAuctionRewardsResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Query/AuctionRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SuspiciousAuctions flags the live auctions of a creator whose bidders
+	// overlap with the creator's recent counterparties.
+	SuspiciousAuctions(context.Context, *QuerySuspiciousAuctionsRequest) (*QuerySuspiciousAuctionsResponse, error)
+	// AuctionSchedule queries a recurring auction schedule by ID.
+	AuctionSchedule(context.Context, *QueryAuctionScheduleRequest) (*QueryAuctionScheduleResponse, error)
+	// AuctionSchedules lists the recurring auction schedules.
+	AuctionSchedules(context.Context, *QueryAuctionSchedulesRequest) (*QueryAuctionSchedulesResponse, error)
+	// AuctionRewards queries the incentive rebates accrued by an address.
+	AuctionRewards(context.Context, *QueryAuctionRewardsRequest) (*QueryAuctionRewardsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SuspiciousAuctions(ctx context.Context, req *QuerySuspiciousAuctionsRequest) (*QuerySuspiciousAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspiciousAuctions not implemented")
+}
+func (*UnimplementedQueryServer) AuctionSchedule(ctx context.Context, req *QueryAuctionScheduleRequest) (*QueryAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionSchedule not implemented")
+}
+func (*UnimplementedQueryServer) AuctionSchedules(ctx context.Context, req *QueryAuctionSchedulesRequest) (*QueryAuctionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionSchedules not implemented")
+}
+func (*UnimplementedQueryServer) AuctionRewards(ctx context.Context, req *QueryAuctionRewardsRequest) (*QueryAuctionRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionRewards not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuspiciousAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuspiciousAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuspiciousAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Query/SuspiciousAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuspiciousAuctions(ctx, req.(*QuerySuspiciousAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Query/AuctionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionSchedule(ctx, req.(*QueryAuctionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Query/AuctionSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionSchedules(ctx, req.(*QueryAuctionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Query/AuctionRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionRewards(ctx, req.(*QueryAuctionRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.auction.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SuspiciousAuctions",
+			Handler:    _Query_SuspiciousAuctions_Handler,
+		},
+		{
+			MethodName: "AuctionSchedule",
+			Handler:    _Query_AuctionSchedule_Handler,
+		},
+		{
+			MethodName: "AuctionSchedules",
+			Handler:    _Query_AuctionSchedules_Handler,
+		},
+		{
+			MethodName: "AuctionRewards",
+			Handler:    _Query_AuctionRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auction/auction/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuspiciousAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuspiciousAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuspiciousAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinOverlapPercent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinOverlapPercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Lookback != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Lookback))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuspiciousAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuspiciousAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuspiciousAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspiciousAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspiciousAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspiciousAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverlapPercent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OverlapPercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CounterpartyBidders) > 0 {
+		for iNdEx := len(m.CounterpartyBidders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyBidders[iNdEx])
+			copy(dAtA[i:], m.CounterpartyBidders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyBidders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySuspiciousAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Lookback != 0 {
+		n += 1 + sovQuery(uint64(m.Lookback))
+	}
+	if m.MinOverlapPercent != 0 {
+		n += 1 + sovQuery(uint64(m.MinOverlapPercent))
+	}
+	return n
+}
+
+func (m *QuerySuspiciousAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuspiciousAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CounterpartyBidders) > 0 {
+		for _, s := range m.CounterpartyBidders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OverlapPercent != 0 {
+		n += 1 + sovQuery(uint64(m.OverlapPercent))
+	}
+	return n
+}
+
+func (m *QueryAuctionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuspiciousAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuspiciousAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuspiciousAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
+			}
+			m.Lookback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lookback |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOverlapPercent", wireType)
+			}
+			m.MinOverlapPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOverlapPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuspiciousAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuspiciousAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuspiciousAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, SuspiciousAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspiciousAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspiciousAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspiciousAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyBidders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyBidders = append(m.CounterpartyBidders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPercent", wireType)
+			}
+			m.OverlapPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryAuctionSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, AuctionSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -342,12 +2311,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -369,7 +2370,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -392,15 +2393,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -427,7 +2428,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
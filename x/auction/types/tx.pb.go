@@ -7,24 +7,29 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,210 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuctionStatus enumerates the lifecycle states of an auction.
+type AuctionStatus int32
+
+const (
+	AuctionStatus_AUCTION_STATUS_UNSPECIFIED AuctionStatus = 0
+	// AUCTION_STATUS_OPEN accepts bids until the end height is reached.
+	AuctionStatus_AUCTION_STATUS_OPEN AuctionStatus = 1
+	// AUCTION_STATUS_SETTLED paid the highest bid out to the creator.
+	AuctionStatus_AUCTION_STATUS_SETTLED AuctionStatus = 2
+	// AUCTION_STATUS_CANCELLED was withdrawn by its creator or voided by the
+	// module authority.
+	AuctionStatus_AUCTION_STATUS_CANCELLED AuctionStatus = 3
+	// AUCTION_STATUS_PAUSED was halted by the module authority. Its end height
+	// is pushed back by the paused duration when resumed.
+	AuctionStatus_AUCTION_STATUS_PAUSED AuctionStatus = 4
+	// AUCTION_STATUS_AWAITING_PAYMENT is a closed deferred-payment auction
+	// waiting for its winning bidder to complete the purchase.
+	AuctionStatus_AUCTION_STATUS_AWAITING_PAYMENT AuctionStatus = 5
+	// AUCTION_STATUS_UPCOMING is scheduled to open at its start height or start
+	// time and does not accept bids yet.
+	AuctionStatus_AUCTION_STATUS_UPCOMING AuctionStatus = 6
+)
+
+var AuctionStatus_name = map[int32]string{
+	0: "AUCTION_STATUS_UNSPECIFIED",
+	1: "AUCTION_STATUS_OPEN",
+	2: "AUCTION_STATUS_SETTLED",
+	3: "AUCTION_STATUS_CANCELLED",
+	4: "AUCTION_STATUS_PAUSED",
+	5: "AUCTION_STATUS_AWAITING_PAYMENT",
+	6: "AUCTION_STATUS_UPCOMING",
+}
+
+var AuctionStatus_value = map[string]int32{
+	"AUCTION_STATUS_UNSPECIFIED":      0,
+	"AUCTION_STATUS_OPEN":             1,
+	"AUCTION_STATUS_SETTLED":          2,
+	"AUCTION_STATUS_CANCELLED":        3,
+	"AUCTION_STATUS_PAUSED":           4,
+	"AUCTION_STATUS_AWAITING_PAYMENT": 5,
+	"AUCTION_STATUS_UPCOMING":         6,
+}
+
+func (x AuctionStatus) String() string {
+	return proto.EnumName(AuctionStatus_name, int32(x))
+}
+
+func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{0}
+}
+
+// ScheduleStatus enumerates the lifecycle states of an auction schedule.
+type ScheduleStatus int32
+
+const (
+	ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED ScheduleStatus = 0
+	// SCHEDULE_STATUS_ACTIVE creates an auction every interval.
+	ScheduleStatus_SCHEDULE_STATUS_ACTIVE ScheduleStatus = 1
+	// SCHEDULE_STATUS_COMPLETED reached its count or end height, or ran out of
+	// balance.
+	ScheduleStatus_SCHEDULE_STATUS_COMPLETED ScheduleStatus = 2
+	// SCHEDULE_STATUS_CANCELLED was stopped by its creator.
+	ScheduleStatus_SCHEDULE_STATUS_CANCELLED ScheduleStatus = 3
+	// SCHEDULE_STATUS_FAILED could not create its next auction.
+	ScheduleStatus_SCHEDULE_STATUS_FAILED ScheduleStatus = 4
+)
+
+var ScheduleStatus_name = map[int32]string{
+	0: "SCHEDULE_STATUS_UNSPECIFIED",
+	1: "SCHEDULE_STATUS_ACTIVE",
+	2: "SCHEDULE_STATUS_COMPLETED",
+	3: "SCHEDULE_STATUS_CANCELLED",
+	4: "SCHEDULE_STATUS_FAILED",
+}
+
+var ScheduleStatus_value = map[string]int32{
+	"SCHEDULE_STATUS_UNSPECIFIED": 0,
+	"SCHEDULE_STATUS_ACTIVE":      1,
+	"SCHEDULE_STATUS_COMPLETED":   2,
+	"SCHEDULE_STATUS_CANCELLED":   3,
+	"SCHEDULE_STATUS_FAILED":      4,
+}
+
+func (x ScheduleStatus) String() string {
+	return proto.EnumName(ScheduleStatus_name, int32(x))
+}
+
+func (ScheduleStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{1}
+}
+
+// AuctionType enumerates the allocation rules of an auction.
+type AuctionType int32
+
+const (
+	// AUCTION_TYPE_ENGLISH sells a single item to the highest bidder.
+	AuctionType_AUCTION_TYPE_ENGLISH AuctionType = 0
+	// AUCTION_TYPE_MULTI_UNIT sells identical units to the highest per-unit
+	// bids. Every winner pays the lowest winning price.
+	AuctionType_AUCTION_TYPE_MULTI_UNIT AuctionType = 1
+	// AUCTION_TYPE_REVERSE buys from the lowest bidder. The creator escrows the
+	// starting bid as the maximum budget and bids go downwards.
+	AuctionType_AUCTION_TYPE_REVERSE AuctionType = 2
+	// AUCTION_TYPE_BUNDLE sells several lots, either together to the highest
+	// bundle bid or separately to the highest bid of each lot, whichever
+	// raises more.
+	AuctionType_AUCTION_TYPE_BUNDLE AuctionType = 3
+)
+
+var AuctionType_name = map[int32]string{
+	0: "AUCTION_TYPE_ENGLISH",
+	1: "AUCTION_TYPE_MULTI_UNIT",
+	2: "AUCTION_TYPE_REVERSE",
+	3: "AUCTION_TYPE_BUNDLE",
+}
+
+var AuctionType_value = map[string]int32{
+	"AUCTION_TYPE_ENGLISH":    0,
+	"AUCTION_TYPE_MULTI_UNIT": 1,
+	"AUCTION_TYPE_REVERSE":    2,
+	"AUCTION_TYPE_BUNDLE":     3,
+}
+
+func (x AuctionType) String() string {
+	return proto.EnumName(AuctionType_name, int32(x))
+}
+
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{2}
+}
+
+// NFTLotStatus enumerates the states of the NFT lot of an auction.
+type NFTLotStatus int32
+
+const (
+	// NFT_LOT_STATUS_ESCROWED is held by the module until the auction closes.
+	NFTLotStatus_NFT_LOT_STATUS_ESCROWED NFTLotStatus = 0
+	// NFT_LOT_STATUS_IN_TRANSIT was sent to the winner over ICS-721 and waits
+	// for the acknowledgement.
+	NFTLotStatus_NFT_LOT_STATUS_IN_TRANSIT NFTLotStatus = 1
+	// NFT_LOT_STATUS_DELIVERED reached the winner, on this chain or on the
+	// chain of its NFT destination.
+	NFTLotStatus_NFT_LOT_STATUS_DELIVERED NFTLotStatus = 2
+	// NFT_LOT_STATUS_CLAIMABLE could not be delivered over ICS-721. It is held
+	// in escrow until the winner claims it with MsgClaimNFTLot.
+	NFTLotStatus_NFT_LOT_STATUS_CLAIMABLE NFTLotStatus = 3
+	// NFT_LOT_STATUS_RETURNED went back to the creator of an unsold or
+	// cancelled auction.
+	NFTLotStatus_NFT_LOT_STATUS_RETURNED NFTLotStatus = 4
+)
+
+var NFTLotStatus_name = map[int32]string{
+	0: "NFT_LOT_STATUS_ESCROWED",
+	1: "NFT_LOT_STATUS_IN_TRANSIT",
+	2: "NFT_LOT_STATUS_DELIVERED",
+	3: "NFT_LOT_STATUS_CLAIMABLE",
+	4: "NFT_LOT_STATUS_RETURNED",
+}
+
+var NFTLotStatus_value = map[string]int32{
+	"NFT_LOT_STATUS_ESCROWED":   0,
+	"NFT_LOT_STATUS_IN_TRANSIT": 1,
+	"NFT_LOT_STATUS_DELIVERED":  2,
+	"NFT_LOT_STATUS_CLAIMABLE":  3,
+	"NFT_LOT_STATUS_RETURNED":   4,
+}
+
+func (x NFTLotStatus) String() string {
+	return proto.EnumName(NFTLotStatus_name, int32(x))
+}
+
+func (NFTLotStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{3}
+}
+
+// PricingRule enumerates what the winners of a multi-unit auction pay per unit.
+type PricingRule int32
+
+const (
+	// PRICING_RULE_UNIFORM charges every winner the clearing price, the lowest
+	// winning price.
+	PricingRule_PRICING_RULE_UNIFORM PricingRule = 0
+	// PRICING_RULE_PAY_AS_BID charges every winner its own bid price.
+	PricingRule_PRICING_RULE_PAY_AS_BID PricingRule = 1
+)
+
+var PricingRule_name = map[int32]string{
+	0: "PRICING_RULE_UNIFORM",
+	1: "PRICING_RULE_PAY_AS_BID",
+}
+
+var PricingRule_value = map[string]int32{
+	"PRICING_RULE_UNIFORM":    0,
+	"PRICING_RULE_PAY_AS_BID": 1,
+}
+
+func (x PricingRule) String() string {
+	return proto.EnumName(PricingRule_name, int32(x))
+}
+
+func (PricingRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{4}
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -131,6 +340,50 @@ type MsgCreateAuction struct {
 	Creator     string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Item        string      `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 	StartingBid *types.Coin `protobuf:"bytes,3,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid,omitempty"`
+	// duration is the number of blocks the auction stays open. Zero falls back
+	// to the default_duration param.
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// deposit is the participation deposit bidders lock with MsgRegisterBidder
+	// before they can bid. No deposit is required when unset.
+	Deposit *types.Coin `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// deferred_payment leaves bids unescrowed. The winner pays its bid with
+	// MsgCompletePurchase within the settlement_window param. Requires a
+	// deposit.
+	DeferredPayment bool `protobuf:"varint,6,opt,name=deferred_payment,json=deferredPayment,proto3" json:"deferred_payment,omitempty"`
+	// auction_type selects how bids are allocated at close.
+	AuctionType AuctionType `protobuf:"varint,7,opt,name=auction_type,json=auctionType,proto3,enum=auction.auction.AuctionType" json:"auction_type,omitempty"`
+	// quantity is the number of identical units offered by a MULTI_UNIT
+	// auction.
+	Quantity uint64 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// lot is the optional coin lot of a MULTI_UNIT auction. It is escrowed at
+	// creation and split evenly between the units.
+	Lot *types.Coin `protobuf:"bytes,9,opt,name=lot,proto3" json:"lot,omitempty"`
+	// pricing_rule sets what the winners of a MULTI_UNIT auction pay.
+	PricingRule PricingRule `protobuf:"varint,10,opt,name=pricing_rule,json=pricingRule,proto3,enum=auction.auction.PricingRule" json:"pricing_rule,omitempty"`
+	// lots are the lots of a BUNDLE auction. Their IDs are assigned by the
+	// module.
+	Lots []*AuctionLot `protobuf:"bytes,11,rep,name=lots,proto3" json:"lots,omitempty"`
+	// start_height schedules the opening of the auction at a future height. The
+	// auction is UPCOMING until then and opens immediately when unset.
+	StartHeight int64 `protobuf:"varint,12,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time schedules the opening of the auction at a future block time.
+	// Cannot be combined with start_height.
+	StartTime *time.Time `protobuf:"bytes,13,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// relist_policy relists the auction when it closes without bids.
+	RelistPolicy *RelistPolicy `protobuf:"bytes,14,opt,name=relist_policy,json=relistPolicy,proto3" json:"relist_policy,omitempty"`
+	// fee_allowance is escrowed from the creator to pay the tx fees of bids on
+	// the auction through x/feegrant. What is left is returned at close.
+	FeeAllowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=fee_allowance,json=feeAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance"`
+	// fee_allowance_per_bidder caps the fees paid for a single bidder. It is
+	// required with a fee_allowance.
+	FeeAllowancePerBidder github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=fee_allowance_per_bidder,json=feeAllowancePerBidder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance_per_bidder"`
+	// nft_lot is an x/nft token of the creator sold by an ENGLISH auction. It
+	// is escrowed at creation and delivered to the winner.
+	NftLot *NFTLot `protobuf:"bytes,17,opt,name=nft_lot,json=nftLot,proto3" json:"nft_lot,omitempty"`
+	// reserve_commitment hides the reserve price of an ENGLISH auction until
+	// MsgRevealReserve. It is the hex encoded SHA-256 hash of the reserve coin
+	// and a salt, "{reserve}:{salt}".
+	ReserveCommitment string `protobuf:"bytes,18,opt,name=reserve_commitment,json=reserveCommitment,proto3" json:"reserve_commitment,omitempty"`
 }
 
 func (m *MsgCreateAuction) Reset()         { *m = MsgCreateAuction{} }
@@ -187,6 +440,111 @@ func (m *MsgCreateAuction) GetStartingBid() *types.Coin {
 	return nil
 }
 
+func (m *MsgCreateAuction) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgCreateAuction) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetDeferredPayment() bool {
+	if m != nil {
+		return m.DeferredPayment
+	}
+	return false
+}
+
+func (m *MsgCreateAuction) GetAuctionType() AuctionType {
+	if m != nil {
+		return m.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_ENGLISH
+}
+
+func (m *MsgCreateAuction) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MsgCreateAuction) GetLot() *types.Coin {
+	if m != nil {
+		return m.Lot
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetPricingRule() PricingRule {
+	if m != nil {
+		return m.PricingRule
+	}
+	return PricingRule_PRICING_RULE_UNIFORM
+}
+
+func (m *MsgCreateAuction) GetLots() []*AuctionLot {
+	if m != nil {
+		return m.Lots
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateAuction) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetRelistPolicy() *RelistPolicy {
+	if m != nil {
+		return m.RelistPolicy
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetFeeAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetFeeAllowancePerBidder() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowancePerBidder
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetNftLot() *NFTLot {
+	if m != nil {
+		return m.NftLot
+	}
+	return nil
+}
+
+func (m *MsgCreateAuction) GetReserveCommitment() string {
+	if m != nil {
+		return m.ReserveCommitment
+	}
+	return ""
+}
+
 type MsgCreateAuctionResponse struct {
 	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
@@ -235,6 +593,15 @@ type MsgPlaceBid struct {
 	AuctionId string      `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 	Bidder    string      `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 	BidAmount *types.Coin `protobuf:"bytes,3,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// quantity is the number of units bid for on a MULTI_UNIT auction, in which
+	// case bid_amount is the price per unit.
+	Quantity uint64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// lot_id bids on a single lot of a BUNDLE auction. An empty lot ID bids on
+	// the whole bundle.
+	LotId string `protobuf:"bytes,5,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	// nft_destination delivers the NFT lot over ICS-721 if the bid wins. The
+	// NFT goes to the bidder on this chain when unset.
+	NftDestination *NFTDestination `protobuf:"bytes,6,opt,name=nft_destination,json=nftDestination,proto3" json:"nft_destination,omitempty"`
 }
 
 func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
@@ -291,6 +658,27 @@ func (m *MsgPlaceBid) GetBidAmount() *types.Coin {
 	return nil
 }
 
+func (m *MsgPlaceBid) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MsgPlaceBid) GetLotId() string {
+	if m != nil {
+		return m.LotId
+	}
+	return ""
+}
+
+func (m *MsgPlaceBid) GetNftDestination() *NFTDestination {
+	if m != nil {
+		return m.NftDestination
+	}
+	return nil
+}
+
 type MsgPlaceBidResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
@@ -335,26 +723,23 @@ func (m *MsgPlaceBidResponse) GetSuccess() bool {
 	return false
 }
 
-type Auction struct {
-	Creator     string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Item        string      `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	StartingBid *types.Coin `protobuf:"bytes,3,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid,omitempty"`
-	Id          string      `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Bids        []*Bid      `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids,omitempty"`
+type MsgCancelAuction struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *Auction) Reset()         { *m = Auction{} }
-func (m *Auction) String() string { return proto.CompactTextString(m) }
-func (*Auction) ProtoMessage()    {}
-func (*Auction) Descriptor() ([]byte, []int) {
+func (m *MsgCancelAuction) Reset()         { *m = MsgCancelAuction{} }
+func (m *MsgCancelAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuction) ProtoMessage()    {}
+func (*MsgCancelAuction) Descriptor() ([]byte, []int) {
 	return fileDescriptor_042d57b903dda11f, []int{6}
 }
-func (m *Auction) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -364,70 +749,85 @@ func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Auction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Auction.Merge(m, src)
+func (m *MsgCancelAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuction.Merge(m, src)
 }
-func (m *Auction) XXX_Size() int {
+func (m *MsgCancelAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *Auction) XXX_DiscardUnknown() {
-	xxx_messageInfo_Auction.DiscardUnknown(m)
+func (m *MsgCancelAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Auction proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAuction proto.InternalMessageInfo
 
-func (m *Auction) GetCreator() string {
+func (m *MsgCancelAuction) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *Auction) GetItem() string {
+func (m *MsgCancelAuction) GetAuctionId() string {
 	if m != nil {
-		return m.Item
+		return m.AuctionId
 	}
 	return ""
 }
 
-func (m *Auction) GetStartingBid() *types.Coin {
-	if m != nil {
-		return m.StartingBid
-	}
-	return nil
+type MsgCancelAuctionResponse struct {
 }
 
-func (m *Auction) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *MsgCancelAuctionResponse) Reset()         { *m = MsgCancelAuctionResponse{} }
+func (m *MsgCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuctionResponse) ProtoMessage()    {}
+func (*MsgCancelAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{7}
 }
-
-func (m *Auction) GetBids() []*Bid {
-	if m != nil {
-		return m.Bids
+func (m *MsgCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgCancelAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuctionResponse.Merge(m, src)
+}
+func (m *MsgCancelAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuctionResponse.DiscardUnknown(m)
 }
 
-type Bid struct {
-	Bidder    string      `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
-	BidAmount *types.Coin `protobuf:"bytes,2,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+var xxx_messageInfo_MsgCancelAuctionResponse proto.InternalMessageInfo
+
+type MsgRegisterBidder struct {
+	Bidder    string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-func (m *Bid) Reset()         { *m = Bid{} }
-func (m *Bid) String() string { return proto.CompactTextString(m) }
-func (*Bid) ProtoMessage()    {}
-func (*Bid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_042d57b903dda11f, []int{7}
+func (m *MsgRegisterBidder) Reset()         { *m = MsgRegisterBidder{} }
+func (m *MsgRegisterBidder) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBidder) ProtoMessage()    {}
+func (*MsgRegisterBidder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{8}
 }
-func (m *Bid) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterBidder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Bid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterBidder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Bid.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterBidder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -437,741 +837,14070 @@ func (m *Bid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Bid) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bid.Merge(m, src)
+func (m *MsgRegisterBidder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBidder.Merge(m, src)
 }
-func (m *Bid) XXX_Size() int {
+func (m *MsgRegisterBidder) XXX_Size() int {
 	return m.Size()
 }
-func (m *Bid) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bid.DiscardUnknown(m)
+func (m *MsgRegisterBidder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBidder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bid proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterBidder proto.InternalMessageInfo
 
-func (m *Bid) GetBidder() string {
+func (m *MsgRegisterBidder) GetBidder() string {
 	if m != nil {
 		return m.Bidder
 	}
 	return ""
 }
 
-func (m *Bid) GetBidAmount() *types.Coin {
+func (m *MsgRegisterBidder) GetAuctionId() string {
 	if m != nil {
-		return m.BidAmount
+		return m.AuctionId
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "auction.auction.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "auction.auction.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateAuction)(nil), "auction.auction.MsgCreateAuction")
-	proto.RegisterType((*MsgCreateAuctionResponse)(nil), "auction.auction.MsgCreateAuctionResponse")
-	proto.RegisterType((*MsgPlaceBid)(nil), "auction.auction.MsgPlaceBid")
-	proto.RegisterType((*MsgPlaceBidResponse)(nil), "auction.auction.MsgPlaceBidResponse")
-	proto.RegisterType((*Auction)(nil), "auction.auction.Auction")
-	proto.RegisterType((*Bid)(nil), "auction.auction.Bid")
+type MsgRegisterBidderResponse struct {
 }
 
-func init() { proto.RegisterFile("auction/auction/tx.proto", fileDescriptor_042d57b903dda11f) }
-
-var fileDescriptor_042d57b903dda11f = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x25, 0xfd, 0x95, 0x97, 0x42, 0xe1, 0xa8, 0x88, 0x6b, 0x15, 0x93, 0x5a, 0x0c, 0xa1,
-	0x12, 0xb6, 0x52, 0x10, 0x82, 0x88, 0xa5, 0xe9, 0xc4, 0x10, 0x54, 0x19, 0x21, 0xa4, 0x4a, 0x28,
-	0x3a, 0xfb, 0x4e, 0xe6, 0x24, 0xec, 0x8b, 0x7c, 0x97, 0xaa, 0xdd, 0x10, 0x23, 0x30, 0xb0, 0xf1,
-	0x2f, 0xb0, 0xd1, 0x81, 0x8d, 0x7f, 0xa0, 0x63, 0xc5, 0xc4, 0x84, 0x50, 0x3b, 0xf4, 0xdf, 0x40,
-	0xb6, 0xcf, 0x29, 0x75, 0xaa, 0x86, 0x8d, 0x25, 0xe7, 0xf7, 0xde, 0xf7, 0xbe, 0xfb, 0xbe, 0x77,
-	0x97, 0x03, 0x83, 0x8c, 0x02, 0xc5, 0x45, 0xec, 0x16, 0xab, 0xda, 0x73, 0x86, 0x89, 0x50, 0x02,
-	0x2f, 0xe9, 0x8c, 0xa3, 0x57, 0xf3, 0x3a, 0x89, 0x78, 0x2c, 0xdc, 0xec, 0x37, 0xc7, 0x98, 0x56,
-	0x20, 0x64, 0x24, 0xa4, 0xeb, 0x13, 0xc9, 0xdc, 0xdd, 0x8e, 0xcf, 0x14, 0xe9, 0xb8, 0x81, 0xe0,
-	0xb1, 0xae, 0x37, 0x75, 0x3d, 0x92, 0xa1, 0xbb, 0xdb, 0x49, 0x17, 0x5d, 0x58, 0xc9, 0x0b, 0x83,
-	0x2c, 0x72, 0xf3, 0x40, 0x97, 0x96, 0x43, 0x11, 0x8a, 0x3c, 0x9f, 0x7e, 0xe9, 0xec, 0x6a, 0x59,
-	0xe7, 0x90, 0x24, 0x24, 0xd2, 0x3d, 0xf6, 0x77, 0x04, 0x4b, 0x7d, 0x19, 0xbe, 0x18, 0x52, 0xa2,
-	0xd8, 0x76, 0x56, 0xc1, 0x0f, 0xa1, 0x4e, 0x46, 0xea, 0xb5, 0x48, 0xb8, 0xda, 0x37, 0x50, 0x0b,
-	0xb5, 0xeb, 0x3d, 0xe3, 0xc7, 0xb7, 0x7b, 0xcb, 0x7a, 0xb3, 0x4d, 0x4a, 0x13, 0x26, 0xe5, 0x73,
-	0x95, 0xf0, 0x38, 0xf4, 0xce, 0xa0, 0xb8, 0x0b, 0x73, 0x39, 0xb7, 0x51, 0x6d, 0xa1, 0x76, 0x63,
-	0xa3, 0xe9, 0x94, 0x06, 0xe1, 0xe4, 0x1b, 0xf4, 0xea, 0x87, 0xbf, 0x6e, 0x57, 0xbe, 0x9c, 0x1e,
-	0xac, 0x23, 0x4f, 0x77, 0x74, 0x1f, 0xbc, 0x3b, 0x3d, 0x58, 0x3f, 0xe3, 0x7a, 0x7f, 0x7a, 0xb0,
-	0xbe, 0x56, 0x08, 0xde, 0x1b, 0x4b, 0x2f, 0x29, 0xb5, 0x57, 0xa0, 0x59, 0x4a, 0x79, 0x4c, 0x0e,
-	0x45, 0x2c, 0x99, 0xfd, 0x11, 0xc1, 0xb5, 0xbe, 0x0c, 0xb7, 0x12, 0x46, 0x14, 0xdb, 0xcc, 0xfb,
-	0xb1, 0x01, 0xf3, 0x41, 0x9a, 0x10, 0x49, 0xee, 0xcb, 0x2b, 0x42, 0x8c, 0x61, 0x86, 0x2b, 0x16,
-	0x65, 0xca, 0xeb, 0x5e, 0xf6, 0x8d, 0x9f, 0xc0, 0xa2, 0x54, 0x24, 0x51, 0x3c, 0x0e, 0x07, 0x3e,
-	0xa7, 0x46, 0x2d, 0x73, 0xb5, 0xe2, 0xe8, 0x39, 0xa4, 0x47, 0xe7, 0xe8, 0xa3, 0x73, 0xb6, 0x04,
-	0x8f, 0xbd, 0x46, 0x01, 0xef, 0x71, 0xda, 0x5d, 0x4c, 0x1d, 0x15, 0xfc, 0xf6, 0x63, 0x30, 0xca,
-	0x6a, 0x0a, 0xa9, 0xf8, 0x16, 0x80, 0x36, 0x38, 0xe0, 0x54, 0x0b, 0xab, 0xeb, 0xcc, 0x53, 0x6a,
-	0x7f, 0x40, 0xd0, 0xe8, 0xcb, 0x70, 0xfb, 0x0d, 0x09, 0x58, 0x8f, 0xd3, 0x29, 0x70, 0x7c, 0x13,
-	0xe6, 0x7c, 0x4e, 0x29, 0x4b, 0xb4, 0x17, 0x1d, 0xe1, 0x47, 0x00, 0x3e, 0xa7, 0x03, 0x12, 0x89,
-	0x51, 0xac, 0xa6, 0x7b, 0xa9, 0xfb, 0x9c, 0x6e, 0x66, 0xd8, 0x6e, 0x23, 0x75, 0xa2, 0x69, 0x6c,
-	0x17, 0x6e, 0xfc, 0x25, 0x66, 0xec, 0xc1, 0x80, 0x79, 0x39, 0x0a, 0x02, 0x26, 0x65, 0xa6, 0x68,
-	0xc1, 0x2b, 0x42, 0xfb, 0x2b, 0x82, 0xf9, 0xff, 0x30, 0x7f, 0x7c, 0x15, 0xaa, 0x9c, 0x1a, 0x33,
-	0x19, 0x5f, 0x95, 0x53, 0xdc, 0x86, 0x19, 0x9f, 0x53, 0x69, 0xcc, 0xb6, 0x6a, 0xed, 0xc6, 0xc6,
-	0xf2, 0xc4, 0xdd, 0x4c, 0xdd, 0x64, 0x08, 0xfb, 0x25, 0xd4, 0x52, 0x82, 0xb3, 0x41, 0xa2, 0x4b,
-	0x06, 0x59, 0xfd, 0xf7, 0x41, 0x6e, 0x7c, 0xae, 0x42, 0xad, 0x2f, 0x43, 0xbc, 0x03, 0x8b, 0xe7,
-	0xfe, 0x70, 0xad, 0x09, 0x31, 0xa5, 0x5b, 0x6d, 0xb6, 0xa7, 0x21, 0xc6, 0x07, 0xf1, 0x0a, 0xae,
-	0x9c, 0xbf, 0xf3, 0x6b, 0x17, 0xb5, 0x9e, 0x83, 0x98, 0x77, 0xa7, 0x42, 0xc6, 0xf4, 0xcf, 0x60,
-	0x61, 0x7c, 0x11, 0x57, 0x2f, 0x6a, 0x2b, 0xaa, 0xe6, 0x9d, 0xcb, 0xaa, 0x05, 0x9f, 0x39, 0xfb,
-	0x36, 0x7d, 0x06, 0x7a, 0x9d, 0xc3, 0x63, 0x0b, 0x1d, 0x1d, 0x5b, 0xe8, 0xf7, 0xb1, 0x85, 0x3e,
-	0x9d, 0x58, 0x95, 0xa3, 0x13, 0xab, 0xf2, 0xf3, 0xc4, 0xaa, 0xec, 0x34, 0x27, 0x5f, 0x01, 0xb5,
-	0x3f, 0x64, 0xd2, 0x9f, 0xcb, 0x1e, 0xb0, 0xfb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x75, 0x65,
-	0xda, 0x0f, 0x88, 0x05, 0x00, 0x00,
+func (m *MsgRegisterBidderResponse) Reset()         { *m = MsgRegisterBidderResponse{} }
+func (m *MsgRegisterBidderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBidderResponse) ProtoMessage()    {}
+func (*MsgRegisterBidderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{9}
+}
+func (m *MsgRegisterBidderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBidderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBidderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBidderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBidderResponse.Merge(m, src)
+}
+func (m *MsgRegisterBidderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBidderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBidderResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRegisterBidderResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgCompletePurchase struct {
+	Buyer     string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateAuction allows users to create an auction.
-	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
-	// PlaceBid allows users to submit a bid.
-	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+func (m *MsgCompletePurchase) Reset()         { *m = MsgCompletePurchase{} }
+func (m *MsgCompletePurchase) String() string { return proto.CompactTextString(m) }
+func (*MsgCompletePurchase) ProtoMessage()    {}
+func (*MsgCompletePurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{10}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCompletePurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/auction.auction.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCompletePurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompletePurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCompletePurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompletePurchase.Merge(m, src)
+}
+func (m *MsgCompletePurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompletePurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompletePurchase.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
-	out := new(MsgCreateAuctionResponse)
-	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CreateAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCompletePurchase proto.InternalMessageInfo
+
+func (m *MsgCompletePurchase) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
-	out := new(MsgPlaceBidResponse)
-	err := c.cc.Invoke(ctx, "/auction.auction.Msg/PlaceBid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCompletePurchase) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateAuction allows users to create an auction.
-	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
-	// PlaceBid allows users to submit a bid.
-	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+type MsgCompletePurchaseResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCompletePurchaseResponse) Reset()         { *m = MsgCompletePurchaseResponse{} }
+func (m *MsgCompletePurchaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompletePurchaseResponse) ProtoMessage()    {}
+func (*MsgCompletePurchaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{11}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCompletePurchaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateAuction(ctx context.Context, req *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+func (m *MsgCompletePurchaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompletePurchaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+func (m *MsgCompletePurchaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompletePurchaseResponse.Merge(m, src)
+}
+func (m *MsgCompletePurchaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompletePurchaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompletePurchaseResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCompletePurchaseResponse proto.InternalMessageInfo
+
+type MsgCreateAuctionSchedule struct {
+	Creator  string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Template AuctionTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template"`
+	// interval is the number of blocks between two auctions.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// count is the number of auctions to create. Zero runs the schedule until
+	// end_height.
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// end_height is the last height at which an auction is created. Zero runs
+	// the schedule until count auctions were created.
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// balance is deposited at creation and funds the lot, or the budget, of
+	// every auction.
+	Balance *types.Coin `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auction.auction.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgCreateAuctionSchedule) Reset()         { *m = MsgCreateAuctionSchedule{} }
+func (m *MsgCreateAuctionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuctionSchedule) ProtoMessage()    {}
+func (*MsgCreateAuctionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{12}
+}
+func (m *MsgCreateAuctionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAuctionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAuctionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateAuctionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuctionSchedule.Merge(m, src)
+}
+func (m *MsgCreateAuctionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAuctionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuctionSchedule.DiscardUnknown(m)
 }
 
-func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auction.auction.Msg/CreateAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+var xxx_messageInfo_MsgCreateAuctionSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateAuctionSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceBid(ctx, in)
+func (m *MsgCreateAuctionSchedule) GetTemplate() AuctionTemplate {
+	if m != nil {
+		return m.Template
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/auction.auction.Msg/PlaceBid",
+	return AuctionTemplate{}
+}
+
+func (m *MsgCreateAuctionSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	return 0
+}
+
+func (m *MsgCreateAuctionSchedule) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "auction.auction.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateAuction",
-			Handler:    _Msg_CreateAuction_Handler,
-		},
-		{
-			MethodName: "PlaceBid",
-			Handler:    _Msg_PlaceBid_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "auction/auction/tx.proto",
+func (m *MsgCreateAuctionSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateAuctionSchedule) GetBalance() *types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateAuctionScheduleResponse struct {
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCreateAuctionScheduleResponse) Reset()         { *m = MsgCreateAuctionScheduleResponse{} }
+func (m *MsgCreateAuctionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuctionScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateAuctionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{13}
+}
+func (m *MsgCreateAuctionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAuctionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAuctionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateAuctionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuctionScheduleResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateAuctionScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateAuctionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuctionScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateAuctionScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateAuctionScheduleResponse) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelAuctionSchedule struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 }
 
-func (m *MsgCreateAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StartingBid != nil {
-		{
-			size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCancelAuctionSchedule) Reset()         { *m = MsgCancelAuctionSchedule{} }
+func (m *MsgCancelAuctionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuctionSchedule) ProtoMessage()    {}
+func (*MsgCancelAuctionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{14}
+}
+func (m *MsgCancelAuctionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAuctionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAuctionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Item) > 0 {
-		i -= len(m.Item)
-		copy(dAtA[i:], m.Item)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelAuctionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuctionSchedule.Merge(m, src)
+}
+func (m *MsgCancelAuctionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAuctionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuctionSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelAuctionSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelAuctionSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelAuctionSchedule) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
 }
 
-func (m *MsgCreateAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AuctionId) > 0 {
-		i -= len(m.AuctionId)
-		copy(dAtA[i:], m.AuctionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgCancelAuctionScheduleResponse struct {
 }
 
-func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAuctionScheduleResponse) Reset()         { *m = MsgCancelAuctionScheduleResponse{} }
+func (m *MsgCancelAuctionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAuctionScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelAuctionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{15}
+}
+func (m *MsgCancelAuctionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAuctionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAuctionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelAuctionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAuctionScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelAuctionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAuctionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAuctionScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelAuctionScheduleResponse proto.InternalMessageInfo
+
+// MsgFundIncentivePool is the Msg/FundIncentivePool request type.
+type MsgFundIncentivePool struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount is moved from the community pool to the incentive pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidAmount != nil {
-		{
-			size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgFundIncentivePool) Reset()         { *m = MsgFundIncentivePool{} }
+func (m *MsgFundIncentivePool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundIncentivePool) ProtoMessage()    {}
+func (*MsgFundIncentivePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{16}
+}
+func (m *MsgFundIncentivePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundIncentivePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundIncentivePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgFundIncentivePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundIncentivePool.Merge(m, src)
+}
+func (m *MsgFundIncentivePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundIncentivePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundIncentivePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundIncentivePool proto.InternalMessageInfo
+
+func (m *MsgFundIncentivePool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.AuctionId) > 0 {
-		i -= len(m.AuctionId)
-		copy(dAtA[i:], m.AuctionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgFundIncentivePool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgFundIncentivePoolResponse defines the response structure for executing a
+// MsgFundIncentivePool message.
+type MsgFundIncentivePoolResponse struct {
+}
+
+func (m *MsgFundIncentivePoolResponse) Reset()         { *m = MsgFundIncentivePoolResponse{} }
+func (m *MsgFundIncentivePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundIncentivePoolResponse) ProtoMessage()    {}
+func (*MsgFundIncentivePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{17}
+}
+func (m *MsgFundIncentivePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundIncentivePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundIncentivePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFundIncentivePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundIncentivePoolResponse.Merge(m, src)
+}
+func (m *MsgFundIncentivePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundIncentivePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundIncentivePoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFundIncentivePoolResponse proto.InternalMessageInfo
+
+type MsgClaimAuctionRewards struct {
+	Claimant string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
 }
 
-func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgClaimAuctionRewards) Reset()         { *m = MsgClaimAuctionRewards{} }
+func (m *MsgClaimAuctionRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAuctionRewards) ProtoMessage()    {}
+func (*MsgClaimAuctionRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{18}
+}
+func (m *MsgClaimAuctionRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAuctionRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAuctionRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimAuctionRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAuctionRewards.Merge(m, src)
+}
+func (m *MsgClaimAuctionRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAuctionRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAuctionRewards.DiscardUnknown(m)
 }
 
-func (m *Auction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimAuctionRewards proto.InternalMessageInfo
+
+func (m *MsgClaimAuctionRewards) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClaimAuctionRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bids) > 0 {
-		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgClaimAuctionRewardsResponse) Reset()         { *m = MsgClaimAuctionRewardsResponse{} }
+func (m *MsgClaimAuctionRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAuctionRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimAuctionRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{19}
+}
+func (m *MsgClaimAuctionRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAuctionRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAuctionRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StartingBid != nil {
-		{
-			size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Item) > 0 {
-		i -= len(m.Item)
-		copy(dAtA[i:], m.Item)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimAuctionRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAuctionRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimAuctionRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAuctionRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAuctionRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *Bid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimAuctionRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAuctionRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateTreasuryAuction is the Msg/CreateTreasuryAuction request type.
+type MsgCreateTreasuryAuction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Item      string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// lot is moved from the community pool and split evenly between the units.
+	Lot types.Coin `protobuf:"bytes,3,opt,name=lot,proto3" json:"lot"`
+	// starting_bid is the minimum price of a unit.
+	StartingBid types.Coin `protobuf:"bytes,4,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid"`
+	// quantity is the number of units the lot is sold in, a single unit when
+	// zero.
+	Quantity    uint64      `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PricingRule PricingRule `protobuf:"varint,6,opt,name=pricing_rule,json=pricingRule,proto3,enum=auction.auction.PricingRule" json:"pricing_rule,omitempty"`
+	// duration is the number of blocks the auction stays open. Zero falls back
+	// to the default_duration param.
+	Duration uint64 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidAmount != nil {
-		{
-			size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCreateTreasuryAuction) Reset()         { *m = MsgCreateTreasuryAuction{} }
+func (m *MsgCreateTreasuryAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryAuction) ProtoMessage()    {}
+func (*MsgCreateTreasuryAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{20}
+}
+func (m *MsgCreateTreasuryAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCreateTreasuryAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryAuction.Merge(m, src)
+}
+func (m *MsgCreateTreasuryAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryAuction proto.InternalMessageInfo
+
+func (m *MsgCreateTreasuryAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateTreasuryAuction) GetItem() string {
+	if m != nil {
+		return m.Item
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgCreateTreasuryAuction) GetLot() types.Coin {
+	if m != nil {
+		return m.Lot
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return types.Coin{}
+}
+
+func (m *MsgCreateTreasuryAuction) GetStartingBid() types.Coin {
+	if m != nil {
+		return m.StartingBid
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTreasuryAuction) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateAuction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTreasuryAuction) GetPricingRule() PricingRule {
+	if m != nil {
+		return m.PricingRule
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return PricingRule_PRICING_RULE_UNIFORM
+}
+
+func (m *MsgCreateTreasuryAuction) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	l = len(m.Item)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgCreateTreasuryAuctionResponse defines the response structure for
+// executing a MsgCreateTreasuryAuction message.
+type MsgCreateTreasuryAuctionResponse struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *MsgCreateTreasuryAuctionResponse) Reset()         { *m = MsgCreateTreasuryAuctionResponse{} }
+func (m *MsgCreateTreasuryAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryAuctionResponse) ProtoMessage()    {}
+func (*MsgCreateTreasuryAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{21}
+}
+func (m *MsgCreateTreasuryAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.StartingBid != nil {
-		l = m.StartingBid.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreateTreasuryAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryAuctionResponse.Merge(m, src)
+}
+func (m *MsgCreateTreasuryAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateTreasuryAuctionResponse) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSendCreateRemoteAuction is the Msg/SendCreateRemoteAuction request type.
+type MsgSendCreateRemoteAuction struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port      string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// timeout_timestamp is the absolute timeout of the packet in nanoseconds.
+	TimeoutTimestamp uint64     `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Item             string     `protobuf:"bytes,5,opt,name=item,proto3" json:"item,omitempty"`
+	StartingBid      types.Coin `protobuf:"bytes,6,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid"`
+	Duration         uint64     `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *MsgSendCreateRemoteAuction) Reset()         { *m = MsgSendCreateRemoteAuction{} }
+func (m *MsgSendCreateRemoteAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCreateRemoteAuction) ProtoMessage()    {}
+func (*MsgSendCreateRemoteAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{22}
+}
+func (m *MsgSendCreateRemoteAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendCreateRemoteAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendCreateRemoteAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.AuctionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSendCreateRemoteAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCreateRemoteAuction.Merge(m, src)
+}
+func (m *MsgSendCreateRemoteAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendCreateRemoteAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCreateRemoteAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendCreateRemoteAuction proto.InternalMessageInfo
+
+func (m *MsgSendCreateRemoteAuction) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgPlaceBid) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendCreateRemoteAuction) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	var l int
-	_ = l
-	l = len(m.AuctionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendCreateRemoteAuction) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendCreateRemoteAuction) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	if m.BidAmount != nil {
-		l = m.BidAmount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgSendCreateRemoteAuction) GetItem() string {
+	if m != nil {
+		return m.Item
 	}
-	return n
+	return ""
 }
 
-func (m *MsgPlaceBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSendCreateRemoteAuction) GetStartingBid() types.Coin {
+	if m != nil {
+		return m.StartingBid
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	return types.Coin{}
+}
+
+func (m *MsgSendCreateRemoteAuction) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
 	}
-	return n
+	return 0
 }
 
-func (m *Auction) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSendCreateRemoteAuctionResponse defines the response structure for
+// executing a MsgSendCreateRemoteAuction message.
+type MsgSendCreateRemoteAuctionResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSendCreateRemoteAuctionResponse) Reset()         { *m = MsgSendCreateRemoteAuctionResponse{} }
+func (m *MsgSendCreateRemoteAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCreateRemoteAuctionResponse) ProtoMessage()    {}
+func (*MsgSendCreateRemoteAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{23}
+}
+func (m *MsgSendCreateRemoteAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendCreateRemoteAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendCreateRemoteAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSendCreateRemoteAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCreateRemoteAuctionResponse.Merge(m, src)
+}
+func (m *MsgSendCreateRemoteAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendCreateRemoteAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCreateRemoteAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendCreateRemoteAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgSendCreateRemoteAuctionResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	l = len(m.Item)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgSendPlaceRemoteBid is the Msg/SendPlaceRemoteBid request type.
+type MsgSendPlaceRemoteBid struct {
+	Bidder    string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Port      string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// timeout_timestamp is the absolute timeout of the packet in nanoseconds.
+	TimeoutTimestamp uint64     `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	AuctionId        string     `protobuf:"bytes,5,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	BidAmount        types.Coin `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount"`
+}
+
+func (m *MsgSendPlaceRemoteBid) Reset()         { *m = MsgSendPlaceRemoteBid{} }
+func (m *MsgSendPlaceRemoteBid) String() string { return proto.CompactTextString(m) }
+func (*MsgSendPlaceRemoteBid) ProtoMessage()    {}
+func (*MsgSendPlaceRemoteBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{24}
+}
+func (m *MsgSendPlaceRemoteBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendPlaceRemoteBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendPlaceRemoteBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.StartingBid != nil {
-		l = m.StartingBid.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSendPlaceRemoteBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendPlaceRemoteBid.Merge(m, src)
+}
+func (m *MsgSendPlaceRemoteBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendPlaceRemoteBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendPlaceRemoteBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendPlaceRemoteBid proto.InternalMessageInfo
+
+func (m *MsgSendPlaceRemoteBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendPlaceRemoteBid) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	if len(m.Bids) > 0 {
-		for _, e := range m.Bids {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendPlaceRemoteBid) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgSendPlaceRemoteBid) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *MsgSendPlaceRemoteBid) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *MsgSendPlaceRemoteBid) GetBidAmount() types.Coin {
+	if m != nil {
+		return m.BidAmount
+	}
+	return types.Coin{}
+}
+
+// MsgSendPlaceRemoteBidResponse defines the response structure for executing
+// a MsgSendPlaceRemoteBid message.
+type MsgSendPlaceRemoteBidResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSendPlaceRemoteBidResponse) Reset()         { *m = MsgSendPlaceRemoteBidResponse{} }
+func (m *MsgSendPlaceRemoteBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendPlaceRemoteBidResponse) ProtoMessage()    {}
+func (*MsgSendPlaceRemoteBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{25}
+}
+func (m *MsgSendPlaceRemoteBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendPlaceRemoteBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendPlaceRemoteBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendPlaceRemoteBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendPlaceRemoteBidResponse.Merge(m, src)
+}
+func (m *MsgSendPlaceRemoteBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendPlaceRemoteBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendPlaceRemoteBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendPlaceRemoteBidResponse proto.InternalMessageInfo
+
+func (m *MsgSendPlaceRemoteBidResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// MsgGrantBidFeeAllowance is the Msg/GrantBidFeeAllowance request type.
+type MsgGrantBidFeeAllowance struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidders are granted an allowance of fee_allowance_per_bidder each.
+	Bidders []string `protobuf:"bytes,3,rep,name=bidders,proto3" json:"bidders,omitempty"`
+}
+
+func (m *MsgGrantBidFeeAllowance) Reset()         { *m = MsgGrantBidFeeAllowance{} }
+func (m *MsgGrantBidFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBidFeeAllowance) ProtoMessage()    {}
+func (*MsgGrantBidFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{26}
+}
+func (m *MsgGrantBidFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBidFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBidFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantBidFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBidFeeAllowance.Merge(m, src)
+}
+func (m *MsgGrantBidFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBidFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBidFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantBidFeeAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantBidFeeAllowance) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgGrantBidFeeAllowance) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *MsgGrantBidFeeAllowance) GetBidders() []string {
+	if m != nil {
+		return m.Bidders
+	}
+	return nil
+}
+
+// MsgGrantBidFeeAllowanceResponse defines the response structure for
+// executing a MsgGrantBidFeeAllowance message.
+type MsgGrantBidFeeAllowanceResponse struct {
+}
+
+func (m *MsgGrantBidFeeAllowanceResponse) Reset()         { *m = MsgGrantBidFeeAllowanceResponse{} }
+func (m *MsgGrantBidFeeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBidFeeAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantBidFeeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{27}
+}
+func (m *MsgGrantBidFeeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBidFeeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBidFeeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantBidFeeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBidFeeAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantBidFeeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBidFeeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBidFeeAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantBidFeeAllowanceResponse proto.InternalMessageInfo
+
+// MsgClaimNFTLot is the Msg/ClaimNFTLot request type.
+type MsgClaimNFTLot struct {
+	// claimant is the winner of the auction, who receives the NFT on this
+	// chain.
+	Claimant  string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *MsgClaimNFTLot) Reset()         { *m = MsgClaimNFTLot{} }
+func (m *MsgClaimNFTLot) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNFTLot) ProtoMessage()    {}
+func (*MsgClaimNFTLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{28}
+}
+func (m *MsgClaimNFTLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNFTLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNFTLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimNFTLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNFTLot.Merge(m, src)
+}
+func (m *MsgClaimNFTLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNFTLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNFTLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimNFTLot proto.InternalMessageInfo
+
+func (m *MsgClaimNFTLot) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
+func (m *MsgClaimNFTLot) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// MsgClaimNFTLotResponse defines the response structure for executing a
+// MsgClaimNFTLot message.
+type MsgClaimNFTLotResponse struct {
+}
+
+func (m *MsgClaimNFTLotResponse) Reset()         { *m = MsgClaimNFTLotResponse{} }
+func (m *MsgClaimNFTLotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNFTLotResponse) ProtoMessage()    {}
+func (*MsgClaimNFTLotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{29}
+}
+func (m *MsgClaimNFTLotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNFTLotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNFTLotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimNFTLotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNFTLotResponse.Merge(m, src)
+}
+func (m *MsgClaimNFTLotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNFTLotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNFTLotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimNFTLotResponse proto.InternalMessageInfo
+
+// MsgRevealReserve is the Msg/RevealReserve request type.
+type MsgRevealReserve struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	AuctionId string     `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Reserve   types.Coin `protobuf:"bytes,3,opt,name=reserve,proto3" json:"reserve"`
+	Salt      string     `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealReserve) Reset()         { *m = MsgRevealReserve{} }
+func (m *MsgRevealReserve) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealReserve) ProtoMessage()    {}
+func (*MsgRevealReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{30}
+}
+func (m *MsgRevealReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealReserve.Merge(m, src)
+}
+func (m *MsgRevealReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealReserve proto.InternalMessageInfo
+
+func (m *MsgRevealReserve) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevealReserve) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *MsgRevealReserve) GetReserve() types.Coin {
+	if m != nil {
+		return m.Reserve
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRevealReserve) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+// MsgRevealReserveResponse defines the response structure for executing a
+// MsgRevealReserve message.
+type MsgRevealReserveResponse struct {
+}
+
+func (m *MsgRevealReserveResponse) Reset()         { *m = MsgRevealReserveResponse{} }
+func (m *MsgRevealReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealReserveResponse) ProtoMessage()    {}
+func (*MsgRevealReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{31}
+}
+func (m *MsgRevealReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealReserveResponse.Merge(m, src)
+}
+func (m *MsgRevealReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealReserveResponse proto.InternalMessageInfo
+
+// MsgPauseAuction is the Msg/PauseAuction request type.
+type MsgPauseAuction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// auction_id is the auction to pause. An empty ID pauses the whole module.
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *MsgPauseAuction) Reset()         { *m = MsgPauseAuction{} }
+func (m *MsgPauseAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseAuction) ProtoMessage()    {}
+func (*MsgPauseAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{32}
+}
+func (m *MsgPauseAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseAuction.Merge(m, src)
+}
+func (m *MsgPauseAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseAuction proto.InternalMessageInfo
+
+func (m *MsgPauseAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPauseAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// MsgPauseAuctionResponse defines the response structure for executing a
+// MsgPauseAuction message.
+type MsgPauseAuctionResponse struct {
+}
+
+func (m *MsgPauseAuctionResponse) Reset()         { *m = MsgPauseAuctionResponse{} }
+func (m *MsgPauseAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseAuctionResponse) ProtoMessage()    {}
+func (*MsgPauseAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{33}
+}
+func (m *MsgPauseAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseAuctionResponse.Merge(m, src)
+}
+func (m *MsgPauseAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseAuctionResponse proto.InternalMessageInfo
+
+// MsgResumeAuction is the Msg/ResumeAuction request type.
+type MsgResumeAuction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// auction_id is the auction to resume. An empty ID resumes the whole module.
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *MsgResumeAuction) Reset()         { *m = MsgResumeAuction{} }
+func (m *MsgResumeAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeAuction) ProtoMessage()    {}
+func (*MsgResumeAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{34}
+}
+func (m *MsgResumeAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeAuction.Merge(m, src)
+}
+func (m *MsgResumeAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeAuction proto.InternalMessageInfo
+
+func (m *MsgResumeAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// MsgResumeAuctionResponse defines the response structure for executing a
+// MsgResumeAuction message.
+type MsgResumeAuctionResponse struct {
+}
+
+func (m *MsgResumeAuctionResponse) Reset()         { *m = MsgResumeAuctionResponse{} }
+func (m *MsgResumeAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeAuctionResponse) ProtoMessage()    {}
+func (*MsgResumeAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{35}
+}
+func (m *MsgResumeAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeAuctionResponse.Merge(m, src)
+}
+func (m *MsgResumeAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeAuctionResponse proto.InternalMessageInfo
+
+// MsgAdminCancelAuction is the Msg/AdminCancelAuction request type.
+type MsgAdminCancelAuction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// auction_id is the auction to void.
+	AuctionId string `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// reason explains why the auction was voided and is stored on the auction.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgAdminCancelAuction) Reset()         { *m = MsgAdminCancelAuction{} }
+func (m *MsgAdminCancelAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgAdminCancelAuction) ProtoMessage()    {}
+func (*MsgAdminCancelAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{36}
+}
+func (m *MsgAdminCancelAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdminCancelAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdminCancelAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdminCancelAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdminCancelAuction.Merge(m, src)
+}
+func (m *MsgAdminCancelAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdminCancelAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdminCancelAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdminCancelAuction proto.InternalMessageInfo
+
+func (m *MsgAdminCancelAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAdminCancelAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *MsgAdminCancelAuction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgAdminCancelAuctionResponse defines the response structure for executing a
+// MsgAdminCancelAuction message.
+type MsgAdminCancelAuctionResponse struct {
+}
+
+func (m *MsgAdminCancelAuctionResponse) Reset()         { *m = MsgAdminCancelAuctionResponse{} }
+func (m *MsgAdminCancelAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdminCancelAuctionResponse) ProtoMessage()    {}
+func (*MsgAdminCancelAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{37}
+}
+func (m *MsgAdminCancelAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdminCancelAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdminCancelAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdminCancelAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdminCancelAuctionResponse.Merge(m, src)
+}
+func (m *MsgAdminCancelAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdminCancelAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdminCancelAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdminCancelAuctionResponse proto.InternalMessageInfo
+
+type Auction struct {
+	Creator     string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Item        string        `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	StartingBid *types.Coin   `protobuf:"bytes,3,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid,omitempty"`
+	Id          string        `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Bids        []*Bid        `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids,omitempty"`
+	EndHeight   int64         `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Status      AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=auction.auction.AuctionStatus" json:"status,omitempty"`
+	// paused_at is the height at which the auction was paused.
+	PausedAt int64 `protobuf:"varint,8,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	// cancel_reason is the reason given by the module authority when voiding
+	// the auction.
+	CancelReason string `protobuf:"bytes,9,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// deposit is the participation deposit required from bidders.
+	Deposit *types.Coin `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// deferred_payment reports whether bids are paid after the auction closed.
+	DeferredPayment bool `protobuf:"varint,11,opt,name=deferred_payment,json=deferredPayment,proto3" json:"deferred_payment,omitempty"`
+	// winning_bid is the bid offered the auction while awaiting payment, and
+	// the bid that paid once settled, on deferred-payment auctions. On BUNDLE
+	// auctions it is the bundle bid that won all lots.
+	WinningBid *Bid `protobuf:"bytes,12,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"`
+	// payment_deadline is the last height at which the winning bid can be paid.
+	PaymentDeadline int64       `protobuf:"varint,13,opt,name=payment_deadline,json=paymentDeadline,proto3" json:"payment_deadline,omitempty"`
+	AuctionType     AuctionType `protobuf:"varint,14,opt,name=auction_type,json=auctionType,proto3,enum=auction.auction.AuctionType" json:"auction_type,omitempty"`
+	// quantity is the number of units offered by a MULTI_UNIT auction.
+	Quantity uint64 `protobuf:"varint,15,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// lot is the escrowed coin lot of a MULTI_UNIT auction.
+	Lot *types.Coin `protobuf:"bytes,16,opt,name=lot,proto3" json:"lot,omitempty"`
+	// clearing_price is the lowest winning price of a settled MULTI_UNIT
+	// auction, which every winner pays under the uniform pricing rule.
+	ClearingPrice *types.Coin `protobuf:"bytes,17,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
+	PricingRule   PricingRule `protobuf:"varint,18,opt,name=pricing_rule,json=pricingRule,proto3,enum=auction.auction.PricingRule" json:"pricing_rule,omitempty"`
+	// lots are the lots of a BUNDLE auction. Bundle bids are stored in bids,
+	// bids on a single lot are stored separately as LotBid.
+	Lots []*AuctionLot `protobuf:"bytes,19,rep,name=lots,proto3" json:"lots,omitempty"`
+	// start_height is the height at which an UPCOMING auction opens.
+	StartHeight int64 `protobuf:"varint,20,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the block time at which an UPCOMING auction opens.
+	StartTime *time.Time `protobuf:"bytes,21,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// duration is the number of blocks the auction stays open once started.
+	Duration     uint64        `protobuf:"varint,22,opt,name=duration,proto3" json:"duration,omitempty"`
+	RelistPolicy *RelistPolicy `protobuf:"bytes,23,opt,name=relist_policy,json=relistPolicy,proto3" json:"relist_policy,omitempty"`
+	// relist_round counts how many times the auction was relisted, zero for the
+	// original listing.
+	RelistRound uint32 `protobuf:"varint,24,opt,name=relist_round,json=relistRound,proto3" json:"relist_round,omitempty"`
+	// relisted_from is the unsold auction this auction relists.
+	RelistedFrom string `protobuf:"bytes,25,opt,name=relisted_from,json=relistedFrom,proto3" json:"relisted_from,omitempty"`
+	// relisted_as is the auction that relists this unsold auction.
+	RelistedAs string `protobuf:"bytes,26,opt,name=relisted_as,json=relistedAs,proto3" json:"relisted_as,omitempty"`
+	// schedule_id is the schedule that created the auction.
+	ScheduleId string `protobuf:"bytes,27,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// liquidation is set on auctions started by another module to liquidate
+	// collateral.
+	Liquidation *Liquidation `protobuf:"bytes,28,opt,name=liquidation,proto3" json:"liquidation,omitempty"`
+	// fee_allowance is the amount the creator escrowed to pay the tx fees of
+	// bids.
+	FeeAllowance          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,29,rep,name=fee_allowance,json=feeAllowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance"`
+	FeeAllowancePerBidder github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,30,rep,name=fee_allowance_per_bidder,json=feeAllowancePerBidder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_allowance_per_bidder"`
+	// fee_sponsor is the account holding the fee allowance. Bidders set it as
+	// the fee granter of their bids.
+	FeeSponsor string `protobuf:"bytes,31,opt,name=fee_sponsor,json=feeSponsor,proto3" json:"fee_sponsor,omitempty"`
+	// fee_grantees are the bidders the fee sponsor granted an allowance to.
+	FeeGrantees []string `protobuf:"bytes,32,rep,name=fee_grantees,json=feeGrantees,proto3" json:"fee_grantees,omitempty"`
+	// nft_lot is the x/nft token sold by the auction.
+	NftLot *NFTLot `protobuf:"bytes,33,opt,name=nft_lot,json=nftLot,proto3" json:"nft_lot,omitempty"`
+	// reserve_commitment is the hash of the hidden reserve price.
+	ReserveCommitment string `protobuf:"bytes,34,opt,name=reserve_commitment,json=reserveCommitment,proto3" json:"reserve_commitment,omitempty"`
+	// reserve is the reserve price once revealed.
+	Reserve *types.Coin `protobuf:"bytes,35,opt,name=reserve,proto3" json:"reserve,omitempty"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{38}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+func (m *Auction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Auction) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *Auction) GetStartingBid() *types.Coin {
+	if m != nil {
+		return m.StartingBid
+	}
+	return nil
+}
+
+func (m *Auction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Auction) GetBids() []*Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *Auction) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Auction) GetStatus() AuctionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
+}
+
+func (m *Auction) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
+}
+
+func (m *Auction) GetCancelReason() string {
+	if m != nil {
+		return m.CancelReason
+	}
+	return ""
+}
+
+func (m *Auction) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Auction) GetDeferredPayment() bool {
+	if m != nil {
+		return m.DeferredPayment
+	}
+	return false
+}
+
+func (m *Auction) GetWinningBid() *Bid {
+	if m != nil {
+		return m.WinningBid
+	}
+	return nil
+}
+
+func (m *Auction) GetPaymentDeadline() int64 {
+	if m != nil {
+		return m.PaymentDeadline
+	}
+	return 0
+}
+
+func (m *Auction) GetAuctionType() AuctionType {
+	if m != nil {
+		return m.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_ENGLISH
+}
+
+func (m *Auction) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Auction) GetLot() *types.Coin {
+	if m != nil {
+		return m.Lot
+	}
+	return nil
+}
+
+func (m *Auction) GetClearingPrice() *types.Coin {
+	if m != nil {
+		return m.ClearingPrice
+	}
+	return nil
+}
+
+func (m *Auction) GetPricingRule() PricingRule {
+	if m != nil {
+		return m.PricingRule
+	}
+	return PricingRule_PRICING_RULE_UNIFORM
+}
+
+func (m *Auction) GetLots() []*AuctionLot {
+	if m != nil {
+		return m.Lots
+	}
+	return nil
+}
+
+func (m *Auction) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Auction) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Auction) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *Auction) GetRelistPolicy() *RelistPolicy {
+	if m != nil {
+		return m.RelistPolicy
+	}
+	return nil
+}
+
+func (m *Auction) GetRelistRound() uint32 {
+	if m != nil {
+		return m.RelistRound
+	}
+	return 0
+}
+
+func (m *Auction) GetRelistedFrom() string {
+	if m != nil {
+		return m.RelistedFrom
+	}
+	return ""
+}
+
+func (m *Auction) GetRelistedAs() string {
+	if m != nil {
+		return m.RelistedAs
+	}
+	return ""
+}
+
+func (m *Auction) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *Auction) GetLiquidation() *Liquidation {
+	if m != nil {
+		return m.Liquidation
+	}
+	return nil
+}
+
+func (m *Auction) GetFeeAllowance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowance
+	}
+	return nil
+}
+
+func (m *Auction) GetFeeAllowancePerBidder() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAllowancePerBidder
+	}
+	return nil
+}
+
+func (m *Auction) GetFeeSponsor() string {
+	if m != nil {
+		return m.FeeSponsor
+	}
+	return ""
+}
+
+func (m *Auction) GetFeeGrantees() []string {
+	if m != nil {
+		return m.FeeGrantees
+	}
+	return nil
+}
+
+func (m *Auction) GetNftLot() *NFTLot {
+	if m != nil {
+		return m.NftLot
+	}
+	return nil
+}
+
+func (m *Auction) GetReserveCommitment() string {
+	if m != nil {
+		return m.ReserveCommitment
+	}
+	return ""
+}
+
+func (m *Auction) GetReserve() *types.Coin {
+	if m != nil {
+		return m.Reserve
+	}
+	return nil
+}
+
+type Bid struct {
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_amount is the price per unit on MULTI_UNIT auctions.
+	BidAmount *types.Coin `protobuf:"bytes,2,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// quantity is the number of units bid for on MULTI_UNIT auctions.
+	Quantity uint64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// filled is the number of units allocated to the bid at settlement.
+	Filled uint64 `protobuf:"varint,4,opt,name=filled,proto3" json:"filled,omitempty"`
+	// nft_destination is where the NFT lot is delivered if the bid wins.
+	NftDestination *NFTDestination `protobuf:"bytes,5,opt,name=nft_destination,json=nftDestination,proto3" json:"nft_destination,omitempty"`
+}
+
+func (m *Bid) Reset()         { *m = Bid{} }
+func (m *Bid) String() string { return proto.CompactTextString(m) }
+func (*Bid) ProtoMessage()    {}
+func (*Bid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{39}
+}
+func (m *Bid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bid.Merge(m, src)
+}
+func (m *Bid) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bid) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bid proto.InternalMessageInfo
+
+func (m *Bid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *Bid) GetBidAmount() *types.Coin {
+	if m != nil {
+		return m.BidAmount
+	}
+	return nil
+}
+
+func (m *Bid) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Bid) GetFilled() uint64 {
+	if m != nil {
+		return m.Filled
+	}
+	return 0
+}
+
+func (m *Bid) GetNftDestination() *NFTDestination {
+	if m != nil {
+		return m.NftDestination
+	}
+	return nil
+}
+
+// NFTLot is an x/nft token sold as the lot of an auction.
+type NFTLot struct {
+	ClassId string       `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id      string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status  NFTLotStatus `protobuf:"varint,3,opt,name=status,proto3,enum=auction.auction.NFTLotStatus" json:"status,omitempty"`
+}
+
+func (m *NFTLot) Reset()         { *m = NFTLot{} }
+func (m *NFTLot) String() string { return proto.CompactTextString(m) }
+func (*NFTLot) ProtoMessage()    {}
+func (*NFTLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{40}
+}
+func (m *NFTLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTLot.Merge(m, src)
+}
+func (m *NFTLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTLot proto.InternalMessageInfo
+
+func (m *NFTLot) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTLot) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NFTLot) GetStatus() NFTLotStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NFTLotStatus_NFT_LOT_STATUS_ESCROWED
+}
+
+// NFTDestination is the address of a bidder on another chain, which the NFT
+// lot is delivered to over ICS-721.
+type NFTDestination struct {
+	// channel_id is an ICS-721 channel of the auction port.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Receiver  string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *NFTDestination) Reset()         { *m = NFTDestination{} }
+func (m *NFTDestination) String() string { return proto.CompactTextString(m) }
+func (*NFTDestination) ProtoMessage()    {}
+func (*NFTDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{41}
+}
+func (m *NFTDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTDestination.Merge(m, src)
+}
+func (m *NFTDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTDestination proto.InternalMessageInfo
+
+func (m *NFTDestination) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *NFTDestination) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// RelistPolicy relists an auction that closes without bids as a new linked
+// auction. Escrowed lots and budgets stay in escrow between rounds.
+type RelistPolicy struct {
+	// max_relists is the number of times the auction can be relisted.
+	MaxRelists uint32 `protobuf:"varint,1,opt,name=max_relists,json=maxRelists,proto3" json:"max_relists,omitempty"`
+	// decrement is subtracted from the starting bid at every relist.
+	Decrement *types.Coin `protobuf:"bytes,2,opt,name=decrement,proto3" json:"decrement,omitempty"`
+}
+
+func (m *RelistPolicy) Reset()         { *m = RelistPolicy{} }
+func (m *RelistPolicy) String() string { return proto.CompactTextString(m) }
+func (*RelistPolicy) ProtoMessage()    {}
+func (*RelistPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{42}
+}
+func (m *RelistPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelistPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelistPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelistPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelistPolicy.Merge(m, src)
+}
+func (m *RelistPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelistPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelistPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelistPolicy proto.InternalMessageInfo
+
+func (m *RelistPolicy) GetMaxRelists() uint32 {
+	if m != nil {
+		return m.MaxRelists
+	}
+	return 0
+}
+
+func (m *RelistPolicy) GetDecrement() *types.Coin {
+	if m != nil {
+		return m.Decrement
+	}
+	return nil
+}
+
+// Liquidation routes the proceeds of a collateral liquidation auction. The
+// beneficiary is repaid up to the debt target and the surplus returns to the
+// owner of the collateral.
+type Liquidation struct {
+	DebtTarget  *types.Coin `protobuf:"bytes,1,opt,name=debt_target,json=debtTarget,proto3" json:"debt_target,omitempty"`
+	Beneficiary string      `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Owner       string      `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// repaid is the amount paid to the beneficiary at settlement.
+	Repaid *types.Coin `protobuf:"bytes,4,opt,name=repaid,proto3" json:"repaid,omitempty"`
+	// shortfall is the part of the debt target the auction did not raise.
+	Shortfall *types.Coin `protobuf:"bytes,5,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+}
+
+func (m *Liquidation) Reset()         { *m = Liquidation{} }
+func (m *Liquidation) String() string { return proto.CompactTextString(m) }
+func (*Liquidation) ProtoMessage()    {}
+func (*Liquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{43}
+}
+func (m *Liquidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Liquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Liquidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Liquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Liquidation.Merge(m, src)
+}
+func (m *Liquidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Liquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Liquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Liquidation proto.InternalMessageInfo
+
+func (m *Liquidation) GetDebtTarget() *types.Coin {
+	if m != nil {
+		return m.DebtTarget
+	}
+	return nil
+}
+
+func (m *Liquidation) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *Liquidation) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Liquidation) GetRepaid() *types.Coin {
+	if m != nil {
+		return m.Repaid
+	}
+	return nil
+}
+
+func (m *Liquidation) GetShortfall() *types.Coin {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
+}
+
+// AuctionTemplate describes the auctions created by an auction schedule.
+type AuctionTemplate struct {
+	Item        string      `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	StartingBid *types.Coin `protobuf:"bytes,2,opt,name=starting_bid,json=startingBid,proto3" json:"starting_bid,omitempty"`
+	Duration    uint64      `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	AuctionType AuctionType `protobuf:"varint,4,opt,name=auction_type,json=auctionType,proto3,enum=auction.auction.AuctionType" json:"auction_type,omitempty"`
+	Quantity    uint64      `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Lot         *types.Coin `protobuf:"bytes,6,opt,name=lot,proto3" json:"lot,omitempty"`
+	PricingRule PricingRule `protobuf:"varint,7,opt,name=pricing_rule,json=pricingRule,proto3,enum=auction.auction.PricingRule" json:"pricing_rule,omitempty"`
+}
+
+func (m *AuctionTemplate) Reset()         { *m = AuctionTemplate{} }
+func (m *AuctionTemplate) String() string { return proto.CompactTextString(m) }
+func (*AuctionTemplate) ProtoMessage()    {}
+func (*AuctionTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{44}
+}
+func (m *AuctionTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionTemplate.Merge(m, src)
+}
+func (m *AuctionTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionTemplate proto.InternalMessageInfo
+
+func (m *AuctionTemplate) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *AuctionTemplate) GetStartingBid() *types.Coin {
+	if m != nil {
+		return m.StartingBid
+	}
+	return nil
+}
+
+func (m *AuctionTemplate) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AuctionTemplate) GetAuctionType() AuctionType {
+	if m != nil {
+		return m.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_ENGLISH
+}
+
+func (m *AuctionTemplate) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *AuctionTemplate) GetLot() *types.Coin {
+	if m != nil {
+		return m.Lot
+	}
+	return nil
+}
+
+func (m *AuctionTemplate) GetPricingRule() PricingRule {
+	if m != nil {
+		return m.PricingRule
+	}
+	return PricingRule_PRICING_RULE_UNIFORM
+}
+
+// AuctionSchedule creates auctions from a template at a fixed interval.
+type AuctionSchedule struct {
+	Id        string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator   string          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Template  AuctionTemplate `protobuf:"bytes,3,opt,name=template,proto3" json:"template"`
+	Interval  uint64          `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Count     uint64          `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	EndHeight int64           `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// balance is the remaining deposit funding the next auctions.
+	Balance *types.Coin `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	// next_height is the height at which the next auction is created.
+	NextHeight int64 `protobuf:"varint,8,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// auction_ids are the auctions created so far.
+	AuctionIds []string       `protobuf:"bytes,9,rep,name=auction_ids,json=auctionIds,proto3" json:"auction_ids,omitempty"`
+	Status     ScheduleStatus `protobuf:"varint,10,opt,name=status,proto3,enum=auction.auction.ScheduleStatus" json:"status,omitempty"`
+}
+
+func (m *AuctionSchedule) Reset()         { *m = AuctionSchedule{} }
+func (m *AuctionSchedule) String() string { return proto.CompactTextString(m) }
+func (*AuctionSchedule) ProtoMessage()    {}
+func (*AuctionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{45}
+}
+func (m *AuctionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionSchedule.Merge(m, src)
+}
+func (m *AuctionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionSchedule proto.InternalMessageInfo
+
+func (m *AuctionSchedule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuctionSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *AuctionSchedule) GetTemplate() AuctionTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return AuctionTemplate{}
+}
+
+func (m *AuctionSchedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *AuctionSchedule) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AuctionSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *AuctionSchedule) GetBalance() *types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *AuctionSchedule) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *AuctionSchedule) GetAuctionIds() []string {
+	if m != nil {
+		return m.AuctionIds
+	}
+	return nil
+}
+
+func (m *AuctionSchedule) GetStatus() ScheduleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED
+}
+
+// AuctionLot is a lot of a BUNDLE auction.
+type AuctionLot struct {
+	// id identifies the lot within its auction.
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Item string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// coin is the optional coin lot, escrowed at creation and delivered to the
+	// winner.
+	Coin *types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	// winning_bid is the bid that won the lot on its own at settlement.
+	WinningBid *Bid `protobuf:"bytes,4,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"`
+}
+
+func (m *AuctionLot) Reset()         { *m = AuctionLot{} }
+func (m *AuctionLot) String() string { return proto.CompactTextString(m) }
+func (*AuctionLot) ProtoMessage()    {}
+func (*AuctionLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{46}
+}
+func (m *AuctionLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionLot.Merge(m, src)
+}
+func (m *AuctionLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionLot proto.InternalMessageInfo
+
+func (m *AuctionLot) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuctionLot) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *AuctionLot) GetCoin() *types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return nil
+}
+
+func (m *AuctionLot) GetWinningBid() *Bid {
+	if m != nil {
+		return m.WinningBid
+	}
+	return nil
+}
+
+// LotBid is a bid on a single lot of a BUNDLE auction.
+type LotBid struct {
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	LotId     string `protobuf:"bytes,2,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	Bid       *Bid   `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid,omitempty"`
+}
+
+func (m *LotBid) Reset()         { *m = LotBid{} }
+func (m *LotBid) String() string { return proto.CompactTextString(m) }
+func (*LotBid) ProtoMessage()    {}
+func (*LotBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{47}
+}
+func (m *LotBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LotBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LotBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LotBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotBid.Merge(m, src)
+}
+func (m *LotBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *LotBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotBid proto.InternalMessageInfo
+
+func (m *LotBid) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *LotBid) GetLotId() string {
+	if m != nil {
+		return m.LotId
+	}
+	return ""
+}
+
+func (m *LotBid) GetBid() *Bid {
+	if m != nil {
+		return m.Bid
+	}
+	return nil
+}
+
+// BidderRegistration records the deposit a bidder locked to participate in an
+// auction.
+type BidderRegistration struct {
+	AuctionId string      `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    string      `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Deposit   *types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *BidderRegistration) Reset()         { *m = BidderRegistration{} }
+func (m *BidderRegistration) String() string { return proto.CompactTextString(m) }
+func (*BidderRegistration) ProtoMessage()    {}
+func (*BidderRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{48}
+}
+func (m *BidderRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidderRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderRegistration.Merge(m, src)
+}
+func (m *BidderRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidderRegistration proto.InternalMessageInfo
+
+func (m *BidderRegistration) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *BidderRegistration) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BidderRegistration) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// AuctionReward holds the incentive rebates accrued by an address and not
+// claimed yet.
+type AuctionReward struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AuctionReward) Reset()         { *m = AuctionReward{} }
+func (m *AuctionReward) String() string { return proto.CompactTextString(m) }
+func (*AuctionReward) ProtoMessage()    {}
+func (*AuctionReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{49}
+}
+func (m *AuctionReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionReward.Merge(m, src)
+}
+func (m *AuctionReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionReward proto.InternalMessageInfo
+
+func (m *AuctionReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AuctionReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// IncentiveEpoch tracks the rebates accrued in the current incentive epoch.
+type IncentiveEpoch struct {
+	Epoch   uint64                                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
+}
+
+func (m *IncentiveEpoch) Reset()         { *m = IncentiveEpoch{} }
+func (m *IncentiveEpoch) String() string { return proto.CompactTextString(m) }
+func (*IncentiveEpoch) ProtoMessage()    {}
+func (*IncentiveEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_042d57b903dda11f, []int{50}
+}
+func (m *IncentiveEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveEpoch.Merge(m, src)
+}
+func (m *IncentiveEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveEpoch proto.InternalMessageInfo
+
+func (m *IncentiveEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *IncentiveEpoch) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("auction.auction.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
+	proto.RegisterEnum("auction.auction.ScheduleStatus", ScheduleStatus_name, ScheduleStatus_value)
+	proto.RegisterEnum("auction.auction.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("auction.auction.NFTLotStatus", NFTLotStatus_name, NFTLotStatus_value)
+	proto.RegisterEnum("auction.auction.PricingRule", PricingRule_name, PricingRule_value)
+	proto.RegisterType((*MsgUpdateParams)(nil), "auction.auction.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "auction.auction.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateAuction)(nil), "auction.auction.MsgCreateAuction")
+	proto.RegisterType((*MsgCreateAuctionResponse)(nil), "auction.auction.MsgCreateAuctionResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "auction.auction.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "auction.auction.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgCancelAuction)(nil), "auction.auction.MsgCancelAuction")
+	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "auction.auction.MsgCancelAuctionResponse")
+	proto.RegisterType((*MsgRegisterBidder)(nil), "auction.auction.MsgRegisterBidder")
+	proto.RegisterType((*MsgRegisterBidderResponse)(nil), "auction.auction.MsgRegisterBidderResponse")
+	proto.RegisterType((*MsgCompletePurchase)(nil), "auction.auction.MsgCompletePurchase")
+	proto.RegisterType((*MsgCompletePurchaseResponse)(nil), "auction.auction.MsgCompletePurchaseResponse")
+	proto.RegisterType((*MsgCreateAuctionSchedule)(nil), "auction.auction.MsgCreateAuctionSchedule")
+	proto.RegisterType((*MsgCreateAuctionScheduleResponse)(nil), "auction.auction.MsgCreateAuctionScheduleResponse")
+	proto.RegisterType((*MsgCancelAuctionSchedule)(nil), "auction.auction.MsgCancelAuctionSchedule")
+	proto.RegisterType((*MsgCancelAuctionScheduleResponse)(nil), "auction.auction.MsgCancelAuctionScheduleResponse")
+	proto.RegisterType((*MsgFundIncentivePool)(nil), "auction.auction.MsgFundIncentivePool")
+	proto.RegisterType((*MsgFundIncentivePoolResponse)(nil), "auction.auction.MsgFundIncentivePoolResponse")
+	proto.RegisterType((*MsgClaimAuctionRewards)(nil), "auction.auction.MsgClaimAuctionRewards")
+	proto.RegisterType((*MsgClaimAuctionRewardsResponse)(nil), "auction.auction.MsgClaimAuctionRewardsResponse")
+	proto.RegisterType((*MsgCreateTreasuryAuction)(nil), "auction.auction.MsgCreateTreasuryAuction")
+	proto.RegisterType((*MsgCreateTreasuryAuctionResponse)(nil), "auction.auction.MsgCreateTreasuryAuctionResponse")
+	proto.RegisterType((*MsgSendCreateRemoteAuction)(nil), "auction.auction.MsgSendCreateRemoteAuction")
+	proto.RegisterType((*MsgSendCreateRemoteAuctionResponse)(nil), "auction.auction.MsgSendCreateRemoteAuctionResponse")
+	proto.RegisterType((*MsgSendPlaceRemoteBid)(nil), "auction.auction.MsgSendPlaceRemoteBid")
+	proto.RegisterType((*MsgSendPlaceRemoteBidResponse)(nil), "auction.auction.MsgSendPlaceRemoteBidResponse")
+	proto.RegisterType((*MsgGrantBidFeeAllowance)(nil), "auction.auction.MsgGrantBidFeeAllowance")
+	proto.RegisterType((*MsgGrantBidFeeAllowanceResponse)(nil), "auction.auction.MsgGrantBidFeeAllowanceResponse")
+	proto.RegisterType((*MsgClaimNFTLot)(nil), "auction.auction.MsgClaimNFTLot")
+	proto.RegisterType((*MsgClaimNFTLotResponse)(nil), "auction.auction.MsgClaimNFTLotResponse")
+	proto.RegisterType((*MsgRevealReserve)(nil), "auction.auction.MsgRevealReserve")
+	proto.RegisterType((*MsgRevealReserveResponse)(nil), "auction.auction.MsgRevealReserveResponse")
+	proto.RegisterType((*MsgPauseAuction)(nil), "auction.auction.MsgPauseAuction")
+	proto.RegisterType((*MsgPauseAuctionResponse)(nil), "auction.auction.MsgPauseAuctionResponse")
+	proto.RegisterType((*MsgResumeAuction)(nil), "auction.auction.MsgResumeAuction")
+	proto.RegisterType((*MsgResumeAuctionResponse)(nil), "auction.auction.MsgResumeAuctionResponse")
+	proto.RegisterType((*MsgAdminCancelAuction)(nil), "auction.auction.MsgAdminCancelAuction")
+	proto.RegisterType((*MsgAdminCancelAuctionResponse)(nil), "auction.auction.MsgAdminCancelAuctionResponse")
+	proto.RegisterType((*Auction)(nil), "auction.auction.Auction")
+	proto.RegisterType((*Bid)(nil), "auction.auction.Bid")
+	proto.RegisterType((*NFTLot)(nil), "auction.auction.NFTLot")
+	proto.RegisterType((*NFTDestination)(nil), "auction.auction.NFTDestination")
+	proto.RegisterType((*RelistPolicy)(nil), "auction.auction.RelistPolicy")
+	proto.RegisterType((*Liquidation)(nil), "auction.auction.Liquidation")
+	proto.RegisterType((*AuctionTemplate)(nil), "auction.auction.AuctionTemplate")
+	proto.RegisterType((*AuctionSchedule)(nil), "auction.auction.AuctionSchedule")
+	proto.RegisterType((*AuctionLot)(nil), "auction.auction.AuctionLot")
+	proto.RegisterType((*LotBid)(nil), "auction.auction.LotBid")
+	proto.RegisterType((*BidderRegistration)(nil), "auction.auction.BidderRegistration")
+	proto.RegisterType((*AuctionReward)(nil), "auction.auction.AuctionReward")
+	proto.RegisterType((*IncentiveEpoch)(nil), "auction.auction.IncentiveEpoch")
+}
+
+func init() { proto.RegisterFile("auction/auction/tx.proto", fileDescriptor_042d57b903dda11f) }
+
+var fileDescriptor_042d57b903dda11f = []byte{
+	// 3195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcf, 0x73, 0xdb, 0xc6,
+	0xf5, 0x37, 0x48, 0x8a, 0x12, 0x1f, 0xf5, 0x83, 0x86, 0x65, 0x0b, 0x82, 0x2d, 0x89, 0x46, 0xbe,
+	0x5f, 0x47, 0x56, 0x6a, 0x29, 0xb6, 0xeb, 0xb4, 0x56, 0xda, 0x26, 0x14, 0x45, 0xd9, 0x9c, 0x4a,
+	0x34, 0x07, 0xa4, 0xec, 0xb1, 0x67, 0x3a, 0x28, 0x04, 0x2c, 0x29, 0xa4, 0x20, 0xc0, 0x00, 0xa0,
+	0x6c, 0xb5, 0x97, 0x4c, 0xa7, 0xed, 0x74, 0x72, 0x4a, 0xdb, 0x99, 0xde, 0xfa, 0x63, 0xa6, 0x87,
+	0x66, 0x7a, 0xca, 0xa1, 0xb7, 0x9e, 0xda, 0x53, 0xa6, 0xa7, 0x4c, 0x67, 0x3a, 0xd3, 0x4b, 0x9b,
+	0x4e, 0x72, 0xc8, 0xa9, 0xa7, 0xfe, 0x03, 0x9d, 0x5d, 0x2c, 0x40, 0xfc, 0xa2, 0x40, 0xc9, 0x71,
+	0x72, 0x91, 0xb8, 0xbb, 0x9f, 0x7d, 0xef, 0xed, 0x7b, 0x6f, 0xdf, 0xbe, 0x7d, 0x0b, 0xe0, 0xe4,
+	0x81, 0xe2, 0x68, 0xa6, 0xb1, 0xe1, 0xfd, 0x77, 0x9e, 0xad, 0xf7, 0x2d, 0xd3, 0x31, 0xd9, 0x39,
+	0xda, 0xb3, 0x4e, 0xff, 0xf3, 0xe7, 0xe5, 0x9e, 0x66, 0x98, 0x1b, 0xe4, 0xaf, 0x8b, 0xe1, 0x97,
+	0x15, 0xd3, 0xee, 0x99, 0xf6, 0xc6, 0x81, 0x6c, 0xa3, 0x8d, 0xa3, 0x9b, 0x07, 0xc8, 0x91, 0x6f,
+	0x6e, 0x28, 0xa6, 0x66, 0xd0, 0xf1, 0x05, 0x3a, 0xde, 0xb3, 0xbb, 0x1b, 0x47, 0x37, 0xf1, 0x3f,
+	0x3a, 0xb0, 0xe8, 0x0e, 0x48, 0xa4, 0xb5, 0xe1, 0x36, 0xe8, 0xd0, 0x7c, 0xd7, 0xec, 0x9a, 0x6e,
+	0x3f, 0xfe, 0x45, 0x7b, 0x57, 0xba, 0xa6, 0xd9, 0xd5, 0xd1, 0x06, 0x69, 0x1d, 0x0c, 0x3a, 0x1b,
+	0x8e, 0xd6, 0x43, 0xb6, 0x23, 0xf7, 0xfa, 0x14, 0x70, 0x25, 0xba, 0x90, 0xbe, 0x6c, 0xc9, 0x3d,
+	0x4a, 0x54, 0xf8, 0x13, 0x03, 0x73, 0x7b, 0x76, 0x77, 0xbf, 0xaf, 0xca, 0x0e, 0x6a, 0x92, 0x11,
+	0xf6, 0x35, 0x28, 0xc8, 0x03, 0xe7, 0xd0, 0xb4, 0x34, 0xe7, 0x98, 0x63, 0xca, 0xcc, 0x6a, 0x61,
+	0x8b, 0xfb, 0xdb, 0x1f, 0x6f, 0xcc, 0x53, 0x69, 0x2a, 0xaa, 0x6a, 0x21, 0xdb, 0x6e, 0x39, 0x96,
+	0x66, 0x74, 0xc5, 0x21, 0x94, 0xdd, 0x84, 0xbc, 0x4b, 0x9b, 0xcb, 0x94, 0x99, 0xd5, 0xe2, 0xad,
+	0x85, 0xf5, 0x88, 0xa6, 0xd6, 0x5d, 0x06, 0x5b, 0x85, 0x0f, 0xff, 0xb5, 0x72, 0xee, 0xfd, 0xcf,
+	0x3e, 0x58, 0x63, 0x44, 0x3a, 0x63, 0xf3, 0xab, 0x3f, 0xfc, 0xec, 0x83, 0xb5, 0x21, 0xad, 0x77,
+	0x3f, 0xfb, 0x60, 0xed, 0xaa, 0x27, 0xf0, 0x33, 0x5f, 0xf4, 0x88, 0xa4, 0xc2, 0x22, 0x2c, 0x44,
+	0xba, 0x44, 0x64, 0xf7, 0x4d, 0xc3, 0x46, 0xc2, 0x4f, 0xa6, 0xa0, 0xb4, 0x67, 0x77, 0xab, 0x16,
+	0x92, 0x1d, 0x54, 0x71, 0xe7, 0xb3, 0x1c, 0x4c, 0x2a, 0xb8, 0xc3, 0xb4, 0xdc, 0x75, 0x89, 0x5e,
+	0x93, 0x65, 0x21, 0xa7, 0x39, 0xa8, 0x47, 0x24, 0x2f, 0x88, 0xe4, 0x37, 0xfb, 0x0d, 0x98, 0xb6,
+	0x1d, 0xd9, 0x72, 0x34, 0xa3, 0x2b, 0x1d, 0x68, 0x2a, 0x97, 0x25, 0xab, 0x5a, 0x5c, 0xa7, 0x7a,
+	0xc0, 0xb6, 0x5d, 0xa7, 0xb6, 0x5d, 0xaf, 0x9a, 0x9a, 0x21, 0x16, 0x3d, 0xf8, 0x96, 0xa6, 0xb2,
+	0x3c, 0x4c, 0xa9, 0x03, 0x4b, 0xc6, 0x7c, 0xb9, 0x5c, 0x99, 0x59, 0xcd, 0x89, 0x7e, 0x9b, 0xbd,
+	0x0d, 0x93, 0x2a, 0xea, 0x9b, 0xb6, 0xe6, 0x70, 0x13, 0x69, 0x44, 0x3d, 0x24, 0x7b, 0x1d, 0x4a,
+	0x2a, 0xea, 0x20, 0xcb, 0x42, 0xaa, 0xd4, 0x97, 0x8f, 0x7b, 0xc8, 0x70, 0xb8, 0x7c, 0x99, 0x59,
+	0x9d, 0x12, 0xe7, 0xbc, 0xfe, 0xa6, 0xdb, 0xcd, 0xbe, 0x01, 0xd3, 0x54, 0x65, 0x92, 0x73, 0xdc,
+	0x47, 0xdc, 0x64, 0x99, 0x59, 0x9d, 0xbd, 0x75, 0x25, 0x66, 0x0f, 0xaa, 0x97, 0xf6, 0x71, 0x1f,
+	0x89, 0x45, 0x79, 0xd8, 0xc0, 0xc2, 0xbf, 0x3d, 0x90, 0x0d, 0x07, 0x7b, 0xc0, 0x94, 0x2b, 0xbc,
+	0xd7, 0x66, 0x5f, 0x81, 0xac, 0x6e, 0x3a, 0x5c, 0x21, 0x4d, 0x70, 0x8c, 0xc2, 0x92, 0xf4, 0x2d,
+	0x4d, 0xc1, 0x2a, 0xb4, 0x06, 0x3a, 0xe2, 0x60, 0x84, 0x24, 0x4d, 0x17, 0x24, 0x0e, 0x74, 0x24,
+	0x16, 0xfb, 0xc3, 0x06, 0xbb, 0x01, 0x39, 0xdd, 0x74, 0x6c, 0xae, 0x58, 0xce, 0xae, 0x16, 0x6f,
+	0x5d, 0x1e, 0xb5, 0x84, 0x5d, 0xd3, 0x11, 0x09, 0x90, 0xbd, 0x4a, 0xad, 0x26, 0x1d, 0x22, 0xad,
+	0x7b, 0xe8, 0x70, 0xd3, 0x65, 0x66, 0x35, 0x4b, 0x4d, 0x73, 0x9f, 0x74, 0xb1, 0x6f, 0x00, 0xb8,
+	0x10, 0xbc, 0x57, 0xb8, 0x19, 0xb2, 0x10, 0x7e, 0xdd, 0xdd, 0x48, 0xeb, 0xde, 0x46, 0x5a, 0x6f,
+	0x7b, 0x1b, 0x69, 0x2b, 0xf7, 0xde, 0xc7, 0x2b, 0x8c, 0x58, 0x20, 0x73, 0x70, 0x2f, 0xbb, 0x05,
+	0x33, 0x16, 0xd2, 0x35, 0xdb, 0x91, 0xfa, 0xa6, 0xae, 0x29, 0xc7, 0xdc, 0x2c, 0xa1, 0xb1, 0x14,
+	0x93, 0x4e, 0x24, 0xa8, 0x26, 0x01, 0x89, 0xd3, 0x56, 0xa0, 0xc5, 0xf6, 0x61, 0xa6, 0x83, 0x90,
+	0x24, 0xeb, 0xba, 0xf9, 0x54, 0x36, 0x14, 0xc4, 0xcd, 0x95, 0xb3, 0x27, 0x2a, 0x74, 0xeb, 0x55,
+	0xbc, 0x6d, 0xfe, 0xf0, 0xf1, 0xca, 0x6a, 0x57, 0x73, 0x0e, 0x07, 0x07, 0xeb, 0x8a, 0xd9, 0xa3,
+	0x11, 0x82, 0xfe, 0xbb, 0x61, 0xab, 0xdf, 0xdb, 0xc0, 0x06, 0xb7, 0xc9, 0x04, 0x5b, 0x9c, 0xee,
+	0x20, 0x54, 0xf1, 0x18, 0xb0, 0x3f, 0x62, 0x80, 0x0b, 0xb1, 0x94, 0xfa, 0xc8, 0xc2, 0x9e, 0xad,
+	0x22, 0x8b, 0x2b, 0x7d, 0xfe, 0xdc, 0x2f, 0x06, 0xb9, 0x37, 0x91, 0xb5, 0x45, 0x38, 0xb1, 0xaf,
+	0xc2, 0xa4, 0xd1, 0x71, 0x24, 0xec, 0x43, 0xe7, 0x47, 0xc4, 0x89, 0xc6, 0x4e, 0x1b, 0x1b, 0x34,
+	0x6f, 0x74, 0x9c, 0x5d, 0xd3, 0x61, 0x6f, 0x00, 0x6b, 0x21, 0x1b, 0x59, 0x47, 0x48, 0x52, 0xcc,
+	0x5e, 0x4f, 0x73, 0x88, 0xef, 0xb3, 0x64, 0xab, 0x9e, 0xa7, 0x23, 0x55, 0x7f, 0x60, 0x73, 0x1a,
+	0xc7, 0x12, 0x6f, 0x67, 0x0b, 0x77, 0x81, 0x8b, 0xc6, 0x01, 0x2f, 0x48, 0xb0, 0x4b, 0x00, 0xde,
+	0x3e, 0xd1, 0x54, 0x1a, 0x12, 0x0a, 0xb4, 0xa7, 0xae, 0x0a, 0x3f, 0xcd, 0x40, 0x71, 0xcf, 0xee,
+	0x36, 0x75, 0x59, 0x41, 0x78, 0x4b, 0x9f, 0x0c, 0x67, 0x2f, 0x41, 0x9e, 0x2a, 0xd3, 0x8d, 0x22,
+	0xb4, 0xc5, 0x7e, 0x1d, 0xe0, 0x40, 0x53, 0x25, 0xb9, 0x67, 0x0e, 0x0c, 0x27, 0x3d, 0x8a, 0x14,
+	0x0e, 0x34, 0xb5, 0x42, 0xb0, 0xa1, 0x6d, 0x98, 0x8b, 0x6c, 0xc3, 0x8b, 0x90, 0xd7, 0x4d, 0x07,
+	0x0b, 0x32, 0x41, 0xb8, 0x4d, 0xe8, 0xa6, 0x53, 0x57, 0xd9, 0xfb, 0x30, 0x87, 0xb5, 0xab, 0x22,
+	0xdb, 0xd1, 0x0c, 0x37, 0xfa, 0xe4, 0x09, 0xc7, 0x95, 0x24, 0x2d, 0x6f, 0x0f, 0x61, 0xe2, 0xac,
+	0xd1, 0x71, 0x02, 0xed, 0xcd, 0x22, 0x56, 0x23, 0x5d, 0x83, 0xb0, 0x01, 0x17, 0x02, 0x9a, 0xf0,
+	0x15, 0xc8, 0xc1, 0xa4, 0x3d, 0x50, 0x14, 0x64, 0xdb, 0x44, 0x1d, 0x53, 0xa2, 0xd7, 0x14, 0x1e,
+	0xbb, 0xe1, 0x17, 0x9b, 0x5e, 0x4f, 0x0f, 0xbf, 0x61, 0xcd, 0x66, 0x22, 0x9a, 0x8d, 0x58, 0x94,
+	0x07, 0x2e, 0x4a, 0xda, 0x0f, 0xfb, 0x8f, 0xe0, 0xfc, 0x9e, 0xdd, 0x15, 0x51, 0x57, 0xb3, 0x1d,
+	0xdf, 0xe3, 0x86, 0x86, 0x61, 0x42, 0x86, 0x49, 0xe1, 0x1a, 0x52, 0xc0, 0x65, 0x58, 0x8c, 0x11,
+	0xf6, 0xb9, 0x3e, 0x24, 0xda, 0xa9, 0x9a, 0xbd, 0xbe, 0x8e, 0x1c, 0xd4, 0x1c, 0x58, 0xca, 0xa1,
+	0x6c, 0x23, 0x76, 0x1e, 0x26, 0x0e, 0x06, 0xc7, 0x3e, 0x5b, 0xb7, 0x91, 0xc6, 0x15, 0x30, 0x57,
+	0x17, 0x2a, 0x2c, 0xc1, 0xe5, 0x04, 0xba, 0x3e, 0xdb, 0x9f, 0x65, 0xe2, 0xbe, 0xdd, 0x52, 0x0e,
+	0x91, 0x8a, 0x03, 0xe7, 0x68, 0x65, 0xdf, 0x83, 0x29, 0x07, 0xf5, 0xfa, 0xba, 0xec, 0x20, 0x7a,
+	0x52, 0x97, 0x47, 0x9e, 0x0c, 0x14, 0x17, 0x3c, 0xb2, 0xfd, 0xc9, 0xd8, 0x3d, 0x35, 0xc3, 0x41,
+	0xd6, 0x91, 0xac, 0x13, 0xb7, 0xce, 0x89, 0x7e, 0x1b, 0xaf, 0x5d, 0x21, 0xfe, 0xee, 0xfa, 0xad,
+	0xdb, 0xc0, 0x6b, 0x47, 0x86, 0xea, 0x85, 0xe6, 0x09, 0x12, 0x9a, 0x0b, 0xc8, 0x50, 0x69, 0x60,
+	0xbe, 0x0d, 0x93, 0x07, 0xb2, 0x4e, 0xa2, 0x61, 0x3e, 0xf5, 0x5c, 0xa4, 0xc8, 0x88, 0x73, 0x54,
+	0xa1, 0x3c, 0x4a, 0x25, 0xbe, 0xd7, 0xae, 0x40, 0xd1, 0xa6, 0x7d, 0xc3, 0x8d, 0x0c, 0x5e, 0x57,
+	0x5d, 0x15, 0x94, 0xb8, 0x87, 0x8d, 0xa1, 0xd7, 0x08, 0xd9, 0x4c, 0x94, 0x6c, 0x44, 0x52, 0x01,
+	0xca, 0xa3, 0x98, 0xf8, 0x16, 0xfe, 0x2f, 0x03, 0xf3, 0x7b, 0x76, 0x77, 0x67, 0x60, 0xa8, 0x75,
+	0x43, 0x41, 0x86, 0xa3, 0x1d, 0xa1, 0xa6, 0x69, 0xea, 0x67, 0xce, 0xd1, 0x0e, 0x21, 0x4f, 0xe3,
+	0x50, 0x26, 0x2d, 0xe0, 0xdf, 0x39, 0x6d, 0xc0, 0xa7, 0x19, 0x9d, 0x4b, 0x7f, 0xf3, 0x6e, 0x3c,
+	0xa3, 0xbb, 0x96, 0x98, 0xd1, 0xc5, 0x16, 0x27, 0x2c, 0xc3, 0x95, 0xa4, 0x7e, 0x5f, 0x2b, 0x55,
+	0xb8, 0x84, 0x35, 0xa7, 0xcb, 0x5a, 0xcf, 0xdf, 0xff, 0x4f, 0x65, 0x4b, 0xb5, 0xb1, 0x47, 0x2a,
+	0xb8, 0x5b, 0x36, 0x1c, 0x6a, 0x1d, 0xbf, 0xbd, 0x39, 0x83, 0x05, 0xf2, 0x9b, 0xc2, 0xbb, 0x0c,
+	0x2c, 0x27, 0x53, 0xf1, 0xfd, 0x64, 0xa8, 0x2c, 0xe6, 0xc5, 0x2a, 0x4b, 0xf8, 0x4d, 0x36, 0xb0,
+	0x93, 0xdb, 0x16, 0x92, 0xed, 0x81, 0x75, 0xec, 0x85, 0xcd, 0xb3, 0xda, 0x3a, 0x29, 0xa7, 0x7d,
+	0xcd, 0x4d, 0xde, 0xd2, 0x0e, 0xa1, 0xe0, 0x7e, 0xc7, 0x13, 0xd8, 0x7b, 0x91, 0x5c, 0x38, 0x77,
+	0x0a, 0x02, 0xd1, 0xb4, 0xd8, 0x3f, 0xd2, 0x26, 0x22, 0x47, 0x5a, 0x34, 0x59, 0xcc, 0x9f, 0x36,
+	0x59, 0x0c, 0xe6, 0xdc, 0x93, 0xe1, 0x9c, 0x7b, 0xf3, 0x9b, 0x71, 0x7f, 0x5c, 0x4b, 0xf4, 0xc7,
+	0x44, 0x23, 0x08, 0x15, 0x28, 0x8f, 0x1a, 0x1b, 0x37, 0x9d, 0xf8, 0x55, 0x06, 0xf8, 0x3d, 0xbb,
+	0xdb, 0x42, 0x86, 0xea, 0xd2, 0x11, 0x51, 0xcf, 0x1c, 0xf3, 0x72, 0xd2, 0x37, 0x2d, 0xc7, 0x33,
+	0x24, 0xfe, 0x8d, 0x79, 0x29, 0x87, 0xb2, 0x61, 0x20, 0x5d, 0xa2, 0x57, 0x93, 0x82, 0x58, 0xa0,
+	0x3d, 0x75, 0x95, 0x7d, 0x05, 0xce, 0xe3, 0xe4, 0xd6, 0x1c, 0xb8, 0x49, 0x2e, 0xc9, 0x63, 0x69,
+	0x28, 0x2e, 0xd1, 0x01, 0x3f, 0xbf, 0xf5, 0x1d, 0x65, 0x22, 0xe0, 0x28, 0x51, 0x83, 0xe7, 0x9f,
+	0xc3, 0xe0, 0x23, 0x6d, 0x12, 0x0e, 0x88, 0x6f, 0x82, 0x30, 0x5a, 0x3d, 0xbe, 0x92, 0x79, 0x98,
+	0xb2, 0xd1, 0xdb, 0x03, 0x84, 0x0f, 0x09, 0xc6, 0xa5, 0xe7, 0xb5, 0x85, 0x77, 0x32, 0x70, 0x91,
+	0x92, 0x20, 0xa9, 0x8a, 0x4b, 0x01, 0x4b, 0x31, 0x2a, 0x05, 0x78, 0xd1, 0xaa, 0x0d, 0xbb, 0xc4,
+	0x44, 0x34, 0x65, 0xac, 0x86, 0x52, 0xc3, 0xd3, 0xe8, 0x78, 0x98, 0x25, 0x86, 0xf3, 0x94, 0xd7,
+	0x61, 0x29, 0x51, 0x03, 0x63, 0xe9, 0xef, 0xfb, 0xe4, 0x3e, 0x7d, 0xcf, 0x92, 0x0d, 0x67, 0x4b,
+	0x53, 0x77, 0x82, 0x97, 0x87, 0xb3, 0xe6, 0x6e, 0x78, 0xa2, 0x2b, 0x9a, 0xcd, 0x65, 0xcb, 0x59,
+	0x3c, 0x91, 0x36, 0x23, 0xd6, 0xbf, 0x0a, 0x2b, 0x23, 0x78, 0xfb, 0x71, 0xff, 0x09, 0xcc, 0x7a,
+	0x11, 0xdb, 0xbd, 0x21, 0x9c, 0x14, 0xef, 0xd3, 0xf2, 0xac, 0xc8, 0x71, 0xc0, 0xc1, 0xa5, 0x30,
+	0x6d, 0x9f, 0xeb, 0xfb, 0x0c, 0x49, 0x65, 0x45, 0x74, 0x84, 0x64, 0x5d, 0x74, 0x6f, 0x1b, 0x67,
+	0x57, 0xc7, 0x5d, 0x98, 0xa4, 0x37, 0x96, 0xf4, 0x20, 0x9c, 0xc3, 0xe6, 0x16, 0x3d, 0x3c, 0xf6,
+	0x55, 0x5b, 0xd6, 0xdd, 0x8c, 0xaa, 0x20, 0x92, 0xdf, 0x89, 0x99, 0x71, 0x48, 0x52, 0x7f, 0x19,
+	0xbf, 0x76, 0x2b, 0x3d, 0x4d, 0x79, 0x60, 0xa3, 0xe7, 0x3d, 0x59, 0x52, 0x54, 0x3b, 0x76, 0x31,
+	0x27, 0x28, 0x0c, 0x2d, 0xe6, 0x04, 0xbb, 0x7c, 0xd9, 0x7f, 0xeb, 0x99, 0xc0, 0x1e, 0xf4, 0x5e,
+	0xb4, 0xf0, 0x77, 0xe2, 0xc2, 0x0b, 0x89, 0xc2, 0x87, 0xa4, 0xf1, 0x55, 0x1f, 0xe8, 0xf3, 0xc5,
+	0xff, 0x0b, 0x43, 0xc2, 0x52, 0x45, 0xed, 0x69, 0x46, 0xf8, 0x46, 0xf4, 0x62, 0xd6, 0x80, 0xa3,
+	0x1d, 0x3e, 0xa2, 0x4c, 0x83, 0x46, 0x2f, 0xda, 0xda, 0xdc, 0x8c, 0xaf, 0xed, 0xe5, 0xc4, 0xb5,
+	0xc5, 0x45, 0x15, 0x56, 0x60, 0x29, 0x71, 0xc0, 0x5f, 0xe5, 0x3f, 0x67, 0x60, 0xf2, 0xcb, 0x28,
+	0xb4, 0xcd, 0x42, 0x86, 0x26, 0x24, 0x05, 0x31, 0xa3, 0xa9, 0xec, 0x2a, 0xe4, 0x0e, 0x34, 0xd5,
+	0xe6, 0x26, 0x48, 0xce, 0x36, 0x1f, 0xcb, 0x1e, 0x70, 0x30, 0x24, 0x88, 0xc8, 0x6d, 0x24, 0x1f,
+	0xbd, 0x8d, 0xbc, 0x06, 0x79, 0xdb, 0x91, 0x9d, 0x81, 0x4d, 0xeb, 0x67, 0xcb, 0xa3, 0x6e, 0x49,
+	0x2d, 0x82, 0x12, 0x29, 0x9a, 0xbd, 0x0c, 0x85, 0x3e, 0xf6, 0x62, 0x55, 0x92, 0x1d, 0x52, 0x3d,
+	0xcb, 0x8a, 0x53, 0x6e, 0x47, 0xc5, 0x61, 0x5f, 0x82, 0x19, 0x85, 0xa8, 0x4f, 0xa2, 0x16, 0x2a,
+	0x10, 0xc1, 0xa7, 0xdd, 0x4e, 0x91, 0xf4, 0x05, 0xeb, 0x83, 0xf0, 0x5c, 0xf5, 0xc1, 0x62, 0x72,
+	0x7d, 0xf0, 0x0e, 0x14, 0x9f, 0x6a, 0x86, 0xe1, 0xe9, 0x7b, 0xba, 0xcc, 0x8c, 0xd4, 0x14, 0x50,
+	0x20, 0xd6, 0xf4, 0x75, 0x28, 0x51, 0xc2, 0x92, 0x8a, 0x64, 0x55, 0xd7, 0x0c, 0xb7, 0x7a, 0x96,
+	0x15, 0xe7, 0x68, 0xff, 0x36, 0xed, 0x8e, 0x55, 0x20, 0x67, 0x9f, 0xa7, 0x02, 0x39, 0x97, 0x5c,
+	0x81, 0x2c, 0x8d, 0x55, 0x81, 0x7c, 0x13, 0x66, 0x15, 0x1d, 0xc9, 0x78, 0x07, 0x49, 0x38, 0x57,
+	0x44, 0xdc, 0xf9, 0xb4, 0x79, 0x33, 0xde, 0x04, 0x9c, 0x68, 0xa2, 0x58, 0x5a, 0xca, 0x9e, 0xb5,
+	0x86, 0x79, 0xe1, 0xac, 0x35, 0xcc, 0xf9, 0xb4, 0x1a, 0xe6, 0xc5, 0xd3, 0xd7, 0x30, 0x83, 0x79,
+	0xd9, 0xa5, 0x48, 0x7d, 0x3a, 0x56, 0xdf, 0x5c, 0x38, 0x7d, 0x7d, 0xf3, 0x2a, 0xd0, 0xb6, 0x64,
+	0x99, 0x03, 0x43, 0xe5, 0xb8, 0x32, 0xb3, 0x3a, 0x23, 0x16, 0xdd, 0x3e, 0x11, 0x77, 0xe1, 0xbd,
+	0xe0, 0x36, 0x91, 0x2a, 0x75, 0x2c, 0xb3, 0xc7, 0x2d, 0xba, 0x7b, 0xc1, 0xeb, 0xdc, 0xb1, 0xcc,
+	0x1e, 0xbe, 0x55, 0xfb, 0x20, 0xd9, 0xe6, 0x78, 0x02, 0x01, 0xaf, 0xab, 0x62, 0x47, 0xaf, 0xdd,
+	0x97, 0xa3, 0xd7, 0x6e, 0xf6, 0x5b, 0x50, 0xd4, 0xb5, 0xb7, 0x07, 0x9a, 0xea, 0x2e, 0xf6, 0x0a,
+	0x59, 0x4b, 0xdc, 0x7c, 0xbb, 0x43, 0x8c, 0x18, 0x9c, 0x10, 0xaf, 0xd4, 0x2e, 0x7d, 0xa9, 0x95,
+	0xda, 0xe5, 0x2f, 0xac, 0x52, 0x7b, 0x17, 0x8a, 0x58, 0x0a, 0x12, 0xdf, 0x4d, 0x8b, 0x5b, 0x49,
+	0x39, 0x9f, 0xa0, 0x83, 0x50, 0xcb, 0xc5, 0xb2, 0xaf, 0x03, 0x5e, 0x91, 0xd4, 0xb5, 0x64, 0xc3,
+	0x41, 0xc8, 0xe6, 0xca, 0xe5, 0xec, 0x89, 0x73, 0x31, 0xa3, 0x7b, 0x14, 0x1c, 0xac, 0x10, 0x5f,
+	0x7d, 0x9e, 0x0a, 0xb1, 0x30, 0xa2, 0x42, 0x8c, 0xe3, 0x2b, 0xed, 0xe4, 0x5e, 0x4a, 0x8d, 0xaf,
+	0x14, 0x29, 0xfc, 0x9d, 0x81, 0xec, 0x49, 0x57, 0x89, 0x70, 0x99, 0x37, 0x73, 0xc6, 0x32, 0x6f,
+	0x36, 0x12, 0xeb, 0x2e, 0x41, 0xbe, 0xa3, 0xe9, 0x3a, 0x52, 0xe9, 0x15, 0x83, 0xb6, 0x92, 0xea,
+	0xbc, 0x13, 0x67, 0xaa, 0xf3, 0x0a, 0x6f, 0x41, 0x9e, 0x66, 0xd3, 0x8b, 0x24, 0x9b, 0xb6, 0xed,
+	0xe1, 0xed, 0x75, 0x92, 0xb4, 0xeb, 0xde, 0x21, 0x9b, 0xf1, 0x0f, 0xd9, 0x3b, 0xfe, 0xd9, 0x98,
+	0x25, 0xd1, 0x70, 0x69, 0x84, 0x85, 0xc2, 0x47, 0xa3, 0xf0, 0x6d, 0x98, 0x0d, 0x4b, 0x13, 0xb9,
+	0x6c, 0x31, 0xd1, 0xcb, 0x16, 0x0f, 0x53, 0x16, 0x52, 0x90, 0x76, 0xe4, 0x57, 0xd5, 0xfd, 0xb6,
+	0x70, 0x08, 0xd3, 0xc1, 0xf8, 0x83, 0x03, 0x41, 0x4f, 0x7e, 0x26, 0xb9, 0xa1, 0xc1, 0x2d, 0x48,
+	0xcf, 0x88, 0xd0, 0x93, 0x9f, 0xb9, 0x28, 0x9b, 0xfd, 0x1a, 0x14, 0x54, 0xa4, 0x58, 0x88, 0x38,
+	0x47, 0xba, 0x81, 0x7c, 0xac, 0xf0, 0x1f, 0x06, 0x8a, 0x81, 0xf0, 0xc0, 0x6e, 0x42, 0x51, 0x45,
+	0x07, 0x8e, 0xe4, 0xc8, 0x56, 0x17, 0xb9, 0x37, 0x8f, 0x13, 0x49, 0x01, 0x46, 0xb7, 0x09, 0x98,
+	0x2d, 0x43, 0xf1, 0x00, 0x19, 0xa8, 0xa3, 0x29, 0x9a, 0x6c, 0x1d, 0xd3, 0x45, 0x05, 0xbb, 0x70,
+	0xe9, 0xd4, 0x7c, 0x6a, 0x20, 0x8b, 0x26, 0x6f, 0x6e, 0x83, 0xbd, 0x89, 0x73, 0xba, 0xbe, 0x3c,
+	0x46, 0xed, 0x45, 0xa4, 0x40, 0xbc, 0x5e, 0xfb, 0xd0, 0xb4, 0x9c, 0x8e, 0xac, 0xeb, 0xe9, 0x0f,
+	0x8d, 0x43, 0xac, 0xf0, 0xd7, 0x0c, 0xcc, 0x45, 0x2a, 0xc0, 0x7e, 0xe2, 0xc6, 0x9c, 0x90, 0xb8,
+	0x65, 0xce, 0xfc, 0x42, 0x9a, 0x8d, 0x9c, 0x40, 0xd1, 0xfc, 0x21, 0xf7, 0x3c, 0xf9, 0xc3, 0x44,
+	0x72, 0xfe, 0x90, 0x3f, 0xd3, 0x0b, 0xe6, 0xe4, 0x29, 0x4f, 0x7f, 0xe1, 0xc7, 0x59, 0x98, 0x8b,
+	0xd4, 0x77, 0xe9, 0x76, 0x62, 0xfc, 0xed, 0x14, 0xc8, 0x97, 0x33, 0xa3, 0x8b, 0xf5, 0xd9, 0xcf,
+	0xab, 0x58, 0x9f, 0x1b, 0x55, 0xac, 0x9f, 0x18, 0x5d, 0xac, 0xcf, 0x9f, 0x50, 0xac, 0x9f, 0x1c,
+	0xb7, 0x58, 0x8f, 0xf7, 0xa8, 0x81, 0x9e, 0xf9, 0x89, 0x8d, 0x9b, 0x1d, 0x03, 0xee, 0xa2, 0x54,
+	0x57, 0xa0, 0x38, 0xbc, 0xd9, 0xd8, 0x5c, 0x81, 0x94, 0x0c, 0xc0, 0xbf, 0xda, 0xe0, 0x4d, 0xec,
+	0x45, 0x1e, 0xf7, 0x2d, 0x39, 0x1e, 0xef, 0x3c, 0x2d, 0x47, 0x62, 0xcf, 0x2f, 0x19, 0x80, 0x61,
+	0xa6, 0x15, 0x33, 0x41, 0xd2, 0xc5, 0xe4, 0x06, 0xe4, 0x14, 0x53, 0x33, 0xd2, 0x2f, 0x24, 0x04,
+	0x16, 0x4d, 0xab, 0x73, 0xe3, 0xa5, 0xd5, 0x42, 0x07, 0xf2, 0xbb, 0xa6, 0x33, 0xc6, 0x03, 0xe3,
+	0xf0, 0xc9, 0x2f, 0x13, 0x7c, 0xf2, 0xbb, 0x06, 0xd9, 0xe1, 0xad, 0x29, 0x99, 0x1d, 0x06, 0x08,
+	0xef, 0x30, 0xc0, 0x7a, 0x0f, 0x57, 0xf8, 0x19, 0xcb, 0xf2, 0x23, 0xf0, 0x59, 0x5e, 0x35, 0x03,
+	0x77, 0x94, 0xec, 0xb8, 0x77, 0x14, 0xe1, 0x17, 0x0c, 0xcc, 0x84, 0x8a, 0xed, 0xd8, 0xf3, 0x65,
+	0x37, 0x13, 0xf0, 0x8e, 0x1c, 0xda, 0xfc, 0xe2, 0x9e, 0x2a, 0x84, 0x9f, 0x33, 0x30, 0xeb, 0xbf,
+	0x34, 0xd4, 0xfa, 0xa6, 0x72, 0x88, 0x77, 0x04, 0xc2, 0x3f, 0x68, 0x89, 0xcc, 0x6d, 0xb0, 0x6f,
+	0xc1, 0xa4, 0xac, 0x28, 0xd6, 0x00, 0xa9, 0x2f, 0x4c, 0x26, 0x8f, 0xc1, 0xda, 0xc7, 0x43, 0x55,
+	0xb9, 0x8e, 0xcc, 0x2e, 0x03, 0x5f, 0xd9, 0xaf, 0xb6, 0xeb, 0x0f, 0x1a, 0x52, 0xab, 0x5d, 0x69,
+	0xef, 0xb7, 0xa4, 0xfd, 0x46, 0xab, 0x59, 0xab, 0xd6, 0x77, 0xea, 0xb5, 0xed, 0xd2, 0x39, 0x76,
+	0x01, 0x2e, 0x44, 0xc6, 0x1f, 0x34, 0x6b, 0x8d, 0x12, 0xc3, 0xf2, 0x70, 0x29, 0x32, 0xd0, 0xaa,
+	0xb5, 0xdb, 0xbb, 0xb5, 0xed, 0x52, 0x86, 0xbd, 0x02, 0x5c, 0x64, 0xac, 0x5a, 0x69, 0x54, 0x6b,
+	0xbb, 0x78, 0x34, 0xcb, 0x2e, 0xc2, 0xc5, 0xc8, 0x68, 0xb3, 0xb2, 0xdf, 0xaa, 0x6d, 0x97, 0x72,
+	0xec, 0x4b, 0xb0, 0x12, 0x19, 0xaa, 0x3c, 0xaa, 0xd4, 0xdb, 0xf5, 0xc6, 0x3d, 0xa9, 0x59, 0x79,
+	0xbc, 0x57, 0x6b, 0xb4, 0x4b, 0x13, 0xec, 0x65, 0x58, 0x88, 0x8a, 0xdc, 0xac, 0x3e, 0xd8, 0xab,
+	0x37, 0xee, 0x95, 0xf2, 0x6b, 0xbf, 0x67, 0x60, 0x36, 0xbc, 0x57, 0xd9, 0x15, 0xb8, 0xdc, 0xaa,
+	0xde, 0xaf, 0x6d, 0xef, 0xef, 0xd6, 0x92, 0xd7, 0xc8, 0xc3, 0xa5, 0x28, 0xa0, 0x52, 0x6d, 0xd7,
+	0x1f, 0xd6, 0x4a, 0x0c, 0xbb, 0x04, 0x8b, 0xd1, 0xb1, 0xea, 0x83, 0xbd, 0xe6, 0x6e, 0xad, 0x4d,
+	0x56, 0x9a, 0x34, 0x1c, 0x58, 0x6a, 0x02, 0xe5, 0x9d, 0x4a, 0x1d, 0x8f, 0xe5, 0xd6, 0x9e, 0x42,
+	0x31, 0x70, 0xd0, 0xb0, 0x1c, 0xcc, 0x7b, 0xab, 0x6a, 0x3f, 0x6e, 0xd6, 0xa4, 0x5a, 0xe3, 0xde,
+	0x6e, 0xbd, 0x75, 0xbf, 0x74, 0x2e, 0xb8, 0x5e, 0x32, 0xb2, 0xb7, 0xbf, 0xdb, 0xae, 0x4b, 0xfb,
+	0x8d, 0x7a, 0xbb, 0xc4, 0xc4, 0xa6, 0x89, 0xb5, 0x87, 0x35, 0xb1, 0x55, 0x2b, 0x65, 0x82, 0x96,
+	0x23, 0x23, 0x5b, 0xfb, 0x8d, 0xed, 0xdd, 0x5a, 0x29, 0xbb, 0xf6, 0x3b, 0x06, 0xa6, 0x83, 0x89,
+	0x14, 0x66, 0xd0, 0xd8, 0x69, 0x4b, 0xbb, 0x0f, 0xda, 0x9e, 0x90, 0xb5, 0x56, 0x55, 0x7c, 0xf0,
+	0x88, 0x28, 0x67, 0x09, 0x16, 0x23, 0x83, 0xf5, 0x86, 0xd4, 0x16, 0x2b, 0x8d, 0x16, 0xe1, 0x7f,
+	0x05, 0xb8, 0xc8, 0xf0, 0x76, 0x6d, 0xb7, 0xfe, 0xb0, 0x26, 0x7a, 0x8e, 0x10, 0x19, 0xad, 0xee,
+	0x56, 0xea, 0x7b, 0x95, 0x2d, 0x2c, 0x48, 0x02, 0x5f, 0xb1, 0xd6, 0xde, 0x17, 0x1b, 0x44, 0x3d,
+	0xdb, 0x50, 0x0c, 0x9c, 0x7e, 0x78, 0x9d, 0x4d, 0xb1, 0x5e, 0xc5, 0x9e, 0x20, 0x62, 0x6d, 0xee,
+	0x37, 0xea, 0x3b, 0x0f, 0xc4, 0x3d, 0x57, 0x3d, 0xa1, 0x91, 0x66, 0xe5, 0xb1, 0x54, 0x69, 0x49,
+	0x5b, 0xf5, 0xed, 0x12, 0x73, 0xeb, 0xcf, 0x73, 0x90, 0xdd, 0xb3, 0xbb, 0xec, 0x13, 0x98, 0x0e,
+	0x7d, 0x8e, 0x16, 0x3f, 0xef, 0x22, 0xdf, 0x7c, 0xf1, 0xab, 0x69, 0x08, 0xbf, 0xf8, 0xfd, 0x1d,
+	0x98, 0x09, 0x7f, 0x11, 0x76, 0x35, 0x69, 0x6a, 0x08, 0xc2, 0x5f, 0x4f, 0x85, 0xf8, 0xe4, 0x1b,
+	0x30, 0xe5, 0x7f, 0x2c, 0x72, 0x25, 0x69, 0x9a, 0x37, 0xca, 0xff, 0xdf, 0x49, 0xa3, 0x21, 0x71,
+	0x43, 0xf5, 0xc2, 0x64, 0x71, 0x83, 0x10, 0xfe, 0x7a, 0x2a, 0xc4, 0x27, 0xff, 0x04, 0xa6, 0x43,
+	0xe5, 0xe0, 0x44, 0x4d, 0x07, 0x11, 0xfc, 0x6a, 0x1a, 0x22, 0x28, 0x7a, 0xb8, 0x5c, 0x9b, 0x28,
+	0x7a, 0x08, 0xc2, 0x5f, 0x4f, 0x85, 0xf8, 0xe4, 0x75, 0x60, 0x13, 0xca, 0xa9, 0xd7, 0x92, 0x08,
+	0xc4, 0x71, 0xfc, 0xfa, 0x78, 0x38, 0x9f, 0xdb, 0x77, 0x61, 0x36, 0xf2, 0x49, 0x89, 0x90, 0x2c,
+	0x6a, 0x10, 0xc3, 0xaf, 0xa5, 0x63, 0x7c, 0x0e, 0x1d, 0x28, 0xc5, 0x3e, 0x1f, 0x49, 0xf4, 0x91,
+	0x28, 0x8a, 0xff, 0xca, 0x38, 0x28, 0x9f, 0xcf, 0x00, 0x2e, 0x26, 0x7f, 0x2e, 0x92, 0xee, 0xe5,
+	0x1e, 0x94, 0xbf, 0x39, 0x36, 0x34, 0xc4, 0x36, 0xf1, 0x6b, 0x8a, 0x74, 0x6f, 0x4d, 0x61, 0x7b,
+	0xd2, 0xe7, 0x13, 0xac, 0x06, 0xe7, 0xe3, 0x9f, 0x4e, 0xfc, 0x7f, 0x12, 0x9d, 0x18, 0x8c, 0xbf,
+	0x31, 0x16, 0xcc, 0x67, 0x65, 0xc2, 0x85, 0xa4, 0x0f, 0x12, 0x5e, 0x4e, 0x14, 0x3a, 0x0e, 0xe4,
+	0x37, 0xc6, 0x04, 0xc6, 0x2d, 0x19, 0xfd, 0x5c, 0xe0, 0x04, 0x4b, 0x46, 0xa0, 0xfc, 0xcd, 0xb1,
+	0xa1, 0x3e, 0xdb, 0x1f, 0xc0, 0xc2, 0xa8, 0x07, 0xec, 0x57, 0x92, 0xa8, 0x8d, 0x00, 0xf3, 0xb7,
+	0x4f, 0x01, 0x0e, 0xee, 0xfa, 0x84, 0xb7, 0xdd, 0x6b, 0xa3, 0x48, 0x85, 0x71, 0xfc, 0xfa, 0x78,
+	0x38, 0x9f, 0x9b, 0x05, 0xf3, 0x89, 0x4f, 0xa1, 0x89, 0x41, 0x30, 0x09, 0xc9, 0xbf, 0x3a, 0x2e,
+	0xd2, 0xe7, 0xf9, 0x08, 0x8a, 0xc1, 0xf7, 0xcd, 0x95, 0x91, 0x5e, 0xe1, 0x02, 0xf8, 0x97, 0x53,
+	0x00, 0xe1, 0x78, 0x1c, 0x7c, 0xc1, 0x1c, 0x11, 0x8f, 0x03, 0x10, 0xfe, 0x7a, 0x2a, 0xc4, 0x23,
+	0xcf, 0x4f, 0xbc, 0x83, 0xb3, 0xd7, 0xad, 0x9b, 0x1f, 0x7e, 0xb2, 0xcc, 0x7c, 0xf4, 0xc9, 0x32,
+	0xf3, 0xef, 0x4f, 0x96, 0x99, 0xf7, 0x3e, 0x5d, 0x3e, 0xf7, 0xd1, 0xa7, 0xcb, 0xe7, 0xfe, 0xf1,
+	0xe9, 0xf2, 0xb9, 0x27, 0x0b, 0xf1, 0x77, 0x26, 0x92, 0xfb, 0x1e, 0xe4, 0x49, 0xb1, 0xfa, 0xf6,
+	0xff, 0x06, 0x00, 0xb1, 0x6e, 0xd6, 0x33, 0x71, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateAuction allows users to create an auction.
+	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
+	// PlaceBid allows users to submit a bid.
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// CancelAuction allows the creator to cancel an auction without bids.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a (governance) operation for halting bidding on an
+	// auction, or on the whole module.
+	PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a (governance) operation for resuming bidding on a
+	// paused auction, or on the whole module.
+	ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error)
+	// AdminCancelAuction defines a (governance) operation for voiding an auction
+	// and refunding its escrowed bid.
+	AdminCancelAuction(ctx context.Context, in *MsgAdminCancelAuction, opts ...grpc.CallOption) (*MsgAdminCancelAuctionResponse, error)
+	// RegisterBidder locks the participation deposit required to bid on an
+	// auction.
+	RegisterBidder(ctx context.Context, in *MsgRegisterBidder, opts ...grpc.CallOption) (*MsgRegisterBidderResponse, error)
+	// CompletePurchase allows the winner of a deferred-payment auction to pay
+	// its bid.
+	CompletePurchase(ctx context.Context, in *MsgCompletePurchase, opts ...grpc.CallOption) (*MsgCompletePurchaseResponse, error)
+	// CreateAuctionSchedule creates auctions from a template at a fixed
+	// interval, funding their lots from a pre-deposited balance.
+	CreateAuctionSchedule(ctx context.Context, in *MsgCreateAuctionSchedule, opts ...grpc.CallOption) (*MsgCreateAuctionScheduleResponse, error)
+	// CancelAuctionSchedule stops a schedule and returns its remaining balance.
+	CancelAuctionSchedule(ctx context.Context, in *MsgCancelAuctionSchedule, opts ...grpc.CallOption) (*MsgCancelAuctionScheduleResponse, error)
+	// FundIncentivePool defines a (governance) operation for moving funds from
+	// the community pool to the incentive pool.
+	FundIncentivePool(ctx context.Context, in *MsgFundIncentivePool, opts ...grpc.CallOption) (*MsgFundIncentivePoolResponse, error)
+	// ClaimAuctionRewards pays the incentive rebates accrued by the claimant.
+	ClaimAuctionRewards(ctx context.Context, in *MsgClaimAuctionRewards, opts ...grpc.CallOption) (*MsgClaimAuctionRewardsResponse, error)
+	// CreateTreasuryAuction defines a (governance) operation for selling a lot
+	// of the community pool, the proceeds are returned to the community pool.
+	CreateTreasuryAuction(ctx context.Context, in *MsgCreateTreasuryAuction, opts ...grpc.CallOption) (*MsgCreateTreasuryAuctionResponse, error)
+	// SendCreateRemoteAuction lists an auction on the chain at the other end of
+	// an auction channel.
+	SendCreateRemoteAuction(ctx context.Context, in *MsgSendCreateRemoteAuction, opts ...grpc.CallOption) (*MsgSendCreateRemoteAuctionResponse, error)
+	// SendPlaceRemoteBid escrows a bid and places it on an auction of the chain
+	// at the other end of an auction channel.
+	SendPlaceRemoteBid(ctx context.Context, in *MsgSendPlaceRemoteBid, opts ...grpc.CallOption) (*MsgSendPlaceRemoteBidResponse, error)
+	// GrantBidFeeAllowance lets the creator of an auction sponsoring bid fees
+	// grant allowances to bidders before their first bid.
+	GrantBidFeeAllowance(ctx context.Context, in *MsgGrantBidFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBidFeeAllowanceResponse, error)
+	// ClaimNFTLot releases the NFT lot of a won auction to its winner after
+	// the delivery over ICS-721 failed.
+	ClaimNFTLot(ctx context.Context, in *MsgClaimNFTLot, opts ...grpc.CallOption) (*MsgClaimNFTLotResponse, error)
+	// RevealReserve reveals the hidden reserve price of an ENGLISH auction. The
+	// auction only sells if the reserve is revealed before it ends and the
+	// highest bid meets it.
+	RevealReserve(ctx context.Context, in *MsgRevealReserve, opts ...grpc.CallOption) (*MsgRevealReserveResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
+	out := new(MsgCreateAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CreateAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseAuction(ctx context.Context, in *MsgPauseAuction, opts ...grpc.CallOption) (*MsgPauseAuctionResponse, error) {
+	out := new(MsgPauseAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/PauseAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeAuction(ctx context.Context, in *MsgResumeAuction, opts ...grpc.CallOption) (*MsgResumeAuctionResponse, error) {
+	out := new(MsgResumeAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/ResumeAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AdminCancelAuction(ctx context.Context, in *MsgAdminCancelAuction, opts ...grpc.CallOption) (*MsgAdminCancelAuctionResponse, error) {
+	out := new(MsgAdminCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/AdminCancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBidder(ctx context.Context, in *MsgRegisterBidder, opts ...grpc.CallOption) (*MsgRegisterBidderResponse, error) {
+	out := new(MsgRegisterBidderResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/RegisterBidder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CompletePurchase(ctx context.Context, in *MsgCompletePurchase, opts ...grpc.CallOption) (*MsgCompletePurchaseResponse, error) {
+	out := new(MsgCompletePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CompletePurchase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuctionSchedule(ctx context.Context, in *MsgCreateAuctionSchedule, opts ...grpc.CallOption) (*MsgCreateAuctionScheduleResponse, error) {
+	out := new(MsgCreateAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CreateAuctionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAuctionSchedule(ctx context.Context, in *MsgCancelAuctionSchedule, opts ...grpc.CallOption) (*MsgCancelAuctionScheduleResponse, error) {
+	out := new(MsgCancelAuctionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CancelAuctionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundIncentivePool(ctx context.Context, in *MsgFundIncentivePool, opts ...grpc.CallOption) (*MsgFundIncentivePoolResponse, error) {
+	out := new(MsgFundIncentivePoolResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/FundIncentivePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAuctionRewards(ctx context.Context, in *MsgClaimAuctionRewards, opts ...grpc.CallOption) (*MsgClaimAuctionRewardsResponse, error) {
+	out := new(MsgClaimAuctionRewardsResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/ClaimAuctionRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTreasuryAuction(ctx context.Context, in *MsgCreateTreasuryAuction, opts ...grpc.CallOption) (*MsgCreateTreasuryAuctionResponse, error) {
+	out := new(MsgCreateTreasuryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/CreateTreasuryAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendCreateRemoteAuction(ctx context.Context, in *MsgSendCreateRemoteAuction, opts ...grpc.CallOption) (*MsgSendCreateRemoteAuctionResponse, error) {
+	out := new(MsgSendCreateRemoteAuctionResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/SendCreateRemoteAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendPlaceRemoteBid(ctx context.Context, in *MsgSendPlaceRemoteBid, opts ...grpc.CallOption) (*MsgSendPlaceRemoteBidResponse, error) {
+	out := new(MsgSendPlaceRemoteBidResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/SendPlaceRemoteBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantBidFeeAllowance(ctx context.Context, in *MsgGrantBidFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBidFeeAllowanceResponse, error) {
+	out := new(MsgGrantBidFeeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/GrantBidFeeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimNFTLot(ctx context.Context, in *MsgClaimNFTLot, opts ...grpc.CallOption) (*MsgClaimNFTLotResponse, error) {
+	out := new(MsgClaimNFTLotResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/ClaimNFTLot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealReserve(ctx context.Context, in *MsgRevealReserve, opts ...grpc.CallOption) (*MsgRevealReserveResponse, error) {
+	out := new(MsgRevealReserveResponse)
+	err := c.cc.Invoke(ctx, "/auction.auction.Msg/RevealReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateAuction allows users to create an auction.
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	// PlaceBid allows users to submit a bid.
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// CancelAuction allows the creator to cancel an auction without bids.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// PauseAuction defines a (governance) operation for halting bidding on an
+	// auction, or on the whole module.
+	PauseAuction(context.Context, *MsgPauseAuction) (*MsgPauseAuctionResponse, error)
+	// ResumeAuction defines a (governance) operation for resuming bidding on a
+	// paused auction, or on the whole module.
+	ResumeAuction(context.Context, *MsgResumeAuction) (*MsgResumeAuctionResponse, error)
+	// AdminCancelAuction defines a (governance) operation for voiding an auction
+	// and refunding its escrowed bid.
+	AdminCancelAuction(context.Context, *MsgAdminCancelAuction) (*MsgAdminCancelAuctionResponse, error)
+	// RegisterBidder locks the participation deposit required to bid on an
+	// auction.
+	RegisterBidder(context.Context, *MsgRegisterBidder) (*MsgRegisterBidderResponse, error)
+	// CompletePurchase allows the winner of a deferred-payment auction to pay
+	// its bid.
+	CompletePurchase(context.Context, *MsgCompletePurchase) (*MsgCompletePurchaseResponse, error)
+	// CreateAuctionSchedule creates auctions from a template at a fixed
+	// interval, funding their lots from a pre-deposited balance.
+	CreateAuctionSchedule(context.Context, *MsgCreateAuctionSchedule) (*MsgCreateAuctionScheduleResponse, error)
+	// CancelAuctionSchedule stops a schedule and returns its remaining balance.
+	CancelAuctionSchedule(context.Context, *MsgCancelAuctionSchedule) (*MsgCancelAuctionScheduleResponse, error)
+	// FundIncentivePool defines a (governance) operation for moving funds from
+	// the community pool to the incentive pool.
+	FundIncentivePool(context.Context, *MsgFundIncentivePool) (*MsgFundIncentivePoolResponse, error)
+	// ClaimAuctionRewards pays the incentive rebates accrued by the claimant.
+	ClaimAuctionRewards(context.Context, *MsgClaimAuctionRewards) (*MsgClaimAuctionRewardsResponse, error)
+	// CreateTreasuryAuction defines a (governance) operation for selling a lot
+	// of the community pool, the proceeds are returned to the community pool.
+	CreateTreasuryAuction(context.Context, *MsgCreateTreasuryAuction) (*MsgCreateTreasuryAuctionResponse, error)
+	// SendCreateRemoteAuction lists an auction on the chain at the other end of
+	// an auction channel.
+	SendCreateRemoteAuction(context.Context, *MsgSendCreateRemoteAuction) (*MsgSendCreateRemoteAuctionResponse, error)
+	// SendPlaceRemoteBid escrows a bid and places it on an auction of the chain
+	// at the other end of an auction channel.
+	SendPlaceRemoteBid(context.Context, *MsgSendPlaceRemoteBid) (*MsgSendPlaceRemoteBidResponse, error)
+	// GrantBidFeeAllowance lets the creator of an auction sponsoring bid fees
+	// grant allowances to bidders before their first bid.
+	GrantBidFeeAllowance(context.Context, *MsgGrantBidFeeAllowance) (*MsgGrantBidFeeAllowanceResponse, error)
+	// ClaimNFTLot releases the NFT lot of a won auction to its winner after
+	// the delivery over ICS-721 failed.
+	ClaimNFTLot(context.Context, *MsgClaimNFTLot) (*MsgClaimNFTLotResponse, error)
+	// RevealReserve reveals the hidden reserve price of an ENGLISH auction. The
+	// auction only sells if the reserve is revealed before it ends and the
+	// highest bid meets it.
+	RevealReserve(context.Context, *MsgRevealReserve) (*MsgRevealReserveResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateAuction(ctx context.Context, req *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) PauseAuction(ctx context.Context, req *MsgPauseAuction) (*MsgPauseAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAuction not implemented")
+}
+func (*UnimplementedMsgServer) ResumeAuction(ctx context.Context, req *MsgResumeAuction) (*MsgResumeAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAuction not implemented")
+}
+func (*UnimplementedMsgServer) AdminCancelAuction(ctx context.Context, req *MsgAdminCancelAuction) (*MsgAdminCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCancelAuction not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBidder(ctx context.Context, req *MsgRegisterBidder) (*MsgRegisterBidderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBidder not implemented")
+}
+func (*UnimplementedMsgServer) CompletePurchase(ctx context.Context, req *MsgCompletePurchase) (*MsgCompletePurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePurchase not implemented")
+}
+func (*UnimplementedMsgServer) CreateAuctionSchedule(ctx context.Context, req *MsgCreateAuctionSchedule) (*MsgCreateAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuctionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelAuctionSchedule(ctx context.Context, req *MsgCancelAuctionSchedule) (*MsgCancelAuctionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuctionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) FundIncentivePool(ctx context.Context, req *MsgFundIncentivePool) (*MsgFundIncentivePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundIncentivePool not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAuctionRewards(ctx context.Context, req *MsgClaimAuctionRewards) (*MsgClaimAuctionRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAuctionRewards not implemented")
+}
+func (*UnimplementedMsgServer) CreateTreasuryAuction(ctx context.Context, req *MsgCreateTreasuryAuction) (*MsgCreateTreasuryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreasuryAuction not implemented")
+}
+func (*UnimplementedMsgServer) SendCreateRemoteAuction(ctx context.Context, req *MsgSendCreateRemoteAuction) (*MsgSendCreateRemoteAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCreateRemoteAuction not implemented")
+}
+func (*UnimplementedMsgServer) SendPlaceRemoteBid(ctx context.Context, req *MsgSendPlaceRemoteBid) (*MsgSendPlaceRemoteBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPlaceRemoteBid not implemented")
+}
+func (*UnimplementedMsgServer) GrantBidFeeAllowance(ctx context.Context, req *MsgGrantBidFeeAllowance) (*MsgGrantBidFeeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBidFeeAllowance not implemented")
+}
+func (*UnimplementedMsgServer) ClaimNFTLot(ctx context.Context, req *MsgClaimNFTLot) (*MsgClaimNFTLotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNFTLot not implemented")
+}
+func (*UnimplementedMsgServer) RevealReserve(ctx context.Context, req *MsgRevealReserve) (*MsgRevealReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealReserve not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CreateAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/PlaceBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/PauseAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseAuction(ctx, req.(*MsgPauseAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/ResumeAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeAuction(ctx, req.(*MsgResumeAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdminCancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdminCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdminCancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/AdminCancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdminCancelAuction(ctx, req.(*MsgAdminCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBidder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBidder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBidder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/RegisterBidder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBidder(ctx, req.(*MsgRegisterBidder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CompletePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompletePurchase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompletePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CompletePurchase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompletePurchase(ctx, req.(*MsgCompletePurchase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuctionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CreateAuctionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuctionSchedule(ctx, req.(*MsgCreateAuctionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuctionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuctionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuctionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CancelAuctionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuctionSchedule(ctx, req.(*MsgCancelAuctionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundIncentivePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundIncentivePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundIncentivePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/FundIncentivePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundIncentivePool(ctx, req.(*MsgFundIncentivePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAuctionRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAuctionRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAuctionRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/ClaimAuctionRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAuctionRewards(ctx, req.(*MsgClaimAuctionRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTreasuryAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTreasuryAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTreasuryAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/CreateTreasuryAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTreasuryAuction(ctx, req.(*MsgCreateTreasuryAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendCreateRemoteAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendCreateRemoteAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendCreateRemoteAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/SendCreateRemoteAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendCreateRemoteAuction(ctx, req.(*MsgSendCreateRemoteAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendPlaceRemoteBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendPlaceRemoteBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendPlaceRemoteBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/SendPlaceRemoteBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendPlaceRemoteBid(ctx, req.(*MsgSendPlaceRemoteBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantBidFeeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBidFeeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBidFeeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/GrantBidFeeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBidFeeAllowance(ctx, req.(*MsgGrantBidFeeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimNFTLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimNFTLot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimNFTLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/ClaimNFTLot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimNFTLot(ctx, req.(*MsgClaimNFTLot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auction.auction.Msg/RevealReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealReserve(ctx, req.(*MsgRevealReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.auction.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _Msg_CreateAuction_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "PauseAuction",
+			Handler:    _Msg_PauseAuction_Handler,
+		},
+		{
+			MethodName: "ResumeAuction",
+			Handler:    _Msg_ResumeAuction_Handler,
+		},
+		{
+			MethodName: "AdminCancelAuction",
+			Handler:    _Msg_AdminCancelAuction_Handler,
+		},
+		{
+			MethodName: "RegisterBidder",
+			Handler:    _Msg_RegisterBidder_Handler,
+		},
+		{
+			MethodName: "CompletePurchase",
+			Handler:    _Msg_CompletePurchase_Handler,
+		},
+		{
+			MethodName: "CreateAuctionSchedule",
+			Handler:    _Msg_CreateAuctionSchedule_Handler,
+		},
+		{
+			MethodName: "CancelAuctionSchedule",
+			Handler:    _Msg_CancelAuctionSchedule_Handler,
+		},
+		{
+			MethodName: "FundIncentivePool",
+			Handler:    _Msg_FundIncentivePool_Handler,
+		},
+		{
+			MethodName: "ClaimAuctionRewards",
+			Handler:    _Msg_ClaimAuctionRewards_Handler,
+		},
+		{
+			MethodName: "CreateTreasuryAuction",
+			Handler:    _Msg_CreateTreasuryAuction_Handler,
+		},
+		{
+			MethodName: "SendCreateRemoteAuction",
+			Handler:    _Msg_SendCreateRemoteAuction_Handler,
+		},
+		{
+			MethodName: "SendPlaceRemoteBid",
+			Handler:    _Msg_SendPlaceRemoteBid_Handler,
+		},
+		{
+			MethodName: "GrantBidFeeAllowance",
+			Handler:    _Msg_GrantBidFeeAllowance_Handler,
+		},
+		{
+			MethodName: "ClaimNFTLot",
+			Handler:    _Msg_ClaimNFTLot_Handler,
+		},
+		{
+			MethodName: "RevealReserve",
+			Handler:    _Msg_RevealReserve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auction/auction/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveCommitment) > 0 {
+		i -= len(m.ReserveCommitment)
+		copy(dAtA[i:], m.ReserveCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReserveCommitment)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.NftLot != nil {
+		{
+			size, err := m.NftLot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.FeeAllowancePerBidder) > 0 {
+		for iNdEx := len(m.FeeAllowancePerBidder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowancePerBidder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.FeeAllowance) > 0 {
+		for iNdEx := len(m.FeeAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.RelistPolicy != nil {
+		{
+			size, err := m.RelistPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.PricingRule != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Lot != nil {
+		{
+			size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DeferredPayment {
+		i--
+		if m.DeferredPayment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartingBid != nil {
+		{
+			size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NftDestination != nil {
+		{
+			size, err := m.NftDestination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BidAmount != nil {
+		{
+			size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBidder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBidder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBidder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBidderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBidderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBidderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompletePurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompletePurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompletePurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompletePurchaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompletePurchaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompletePurchaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuctionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuctionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuctionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuctionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuctionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuctionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAuctionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAuctionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAuctionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundIncentivePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundIncentivePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundIncentivePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundIncentivePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundIncentivePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundIncentivePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAuctionRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAuctionRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAuctionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAuctionRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAuctionRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAuctionRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTreasuryAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTreasuryAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTreasuryAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PricingRule != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTreasuryAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTreasuryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTreasuryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendCreateRemoteAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendCreateRemoteAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendCreateRemoteAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendCreateRemoteAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendCreateRemoteAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendCreateRemoteAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendPlaceRemoteBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendPlaceRemoteBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendPlaceRemoteBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendPlaceRemoteBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendPlaceRemoteBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendPlaceRemoteBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantBidFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantBidFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBidFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidders) > 0 {
+		for iNdEx := len(m.Bidders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bidders[iNdEx])
+			copy(dAtA[i:], m.Bidders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Bidders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantBidFeeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantBidFeeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBidFeeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNFTLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNFTLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNFTLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNFTLotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNFTLotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNFTLotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminCancelAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminCancelAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminCancelAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminCancelAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminCancelAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminCancelAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reserve != nil {
+		{
+			size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ReserveCommitment) > 0 {
+		i -= len(m.ReserveCommitment)
+		copy(dAtA[i:], m.ReserveCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReserveCommitment)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.NftLot != nil {
+		{
+			size, err := m.NftLot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.FeeGrantees) > 0 {
+		for iNdEx := len(m.FeeGrantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeGrantees[iNdEx])
+			copy(dAtA[i:], m.FeeGrantees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeGrantees[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.FeeSponsor) > 0 {
+		i -= len(m.FeeSponsor)
+		copy(dAtA[i:], m.FeeSponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeSponsor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.FeeAllowancePerBidder) > 0 {
+		for iNdEx := len(m.FeeAllowancePerBidder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowancePerBidder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.FeeAllowance) > 0 {
+		for iNdEx := len(m.FeeAllowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.Liquidation != nil {
+		{
+			size, err := m.Liquidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.RelistedAs) > 0 {
+		i -= len(m.RelistedAs)
+		copy(dAtA[i:], m.RelistedAs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelistedAs)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.RelistedFrom) > 0 {
+		i -= len(m.RelistedFrom)
+		copy(dAtA[i:], m.RelistedFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelistedFrom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.RelistRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelistRound))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.RelistPolicy != nil {
+		{
+			size, err := m.RelistPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.StartTime != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintTx(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.PricingRule != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ClearingPrice != nil {
+		{
+			size, err := m.ClearingPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Lot != nil {
+		{
+			size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PaymentDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.WinningBid != nil {
+		{
+			size, err := m.WinningBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DeferredPayment {
+		i--
+		if m.DeferredPayment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CancelReason) > 0 {
+		i -= len(m.CancelReason)
+		copy(dAtA[i:], m.CancelReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CancelReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PausedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PausedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartingBid != nil {
+		{
+			size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NftDestination != nil {
+		{
+			size, err := m.NftDestination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Filled != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Filled))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BidAmount != nil {
+		{
+			size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelistPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelistPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelistPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decrement != nil {
+		{
+			size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxRelists != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRelists))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Liquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Liquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shortfall != nil {
+		{
+			size, err := m.Shortfall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Repaid != nil {
+		{
+			size, err := m.Repaid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DebtTarget != nil {
+		{
+			size, err := m.DebtTarget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PricingRule != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PricingRule))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Lot != nil {
+		{
+			size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartingBid != nil {
+		{
+			size, err := m.StartingBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AuctionIds) > 0 {
+		for iNdEx := len(m.AuctionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuctionIds[iNdEx])
+			copy(dAtA[i:], m.AuctionIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionIds[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WinningBid != nil {
+		{
+			size, err := m.WinningBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Coin != nil {
+		{
+			size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Item) > 0 {
+		i -= len(m.Item)
+		copy(dAtA[i:], m.Item)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Item)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LotBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LotBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LotBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bid != nil {
+		{
+			size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuctionId) > 0 {
+		i -= len(m.AuctionId)
+		copy(dAtA[i:], m.AuctionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartingBid != nil {
+		l = m.StartingBid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeferredPayment {
+		n += 2
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovTx(uint64(m.AuctionType))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if m.Lot != nil {
+		l = m.Lot.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PricingRule != 0 {
+		n += 1 + sovTx(uint64(m.PricingRule))
+	}
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RelistPolicy != nil {
+		l = m.RelistPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeAllowance) > 0 {
+		for _, e := range m.FeeAllowance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeAllowancePerBidder) > 0 {
+		for _, e := range m.FeeAllowancePerBidder {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NftLot != nil {
+		l = m.NftLot.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReserveCommitment)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidAmount != nil {
+		l = m.BidAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NftDestination != nil {
+		l = m.NftDestination.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBidder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterBidderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompletePurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompletePurchaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAuctionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAuctionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAuctionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundIncentivePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundIncentivePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAuctionRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAuctionRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateTreasuryAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Lot.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StartingBid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if m.PricingRule != 0 {
+		n += 1 + sovTx(uint64(m.PricingRule))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgCreateTreasuryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendCreateRemoteAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StartingBid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *MsgSendCreateRemoteAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgSendPlaceRemoteBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BidAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendPlaceRemoteBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgGrantBidFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bidders) > 0 {
+		for _, s := range m.Bidders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantBidFeeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimNFTLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimNFTLotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdminCancelAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdminCancelAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartingBid != nil {
+		l = m.StartingBid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.PausedAt != 0 {
+		n += 1 + sovTx(uint64(m.PausedAt))
+	}
+	l = len(m.CancelReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeferredPayment {
+		n += 2
+	}
+	if m.WinningBid != nil {
+		l = m.WinningBid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PaymentDeadline != 0 {
+		n += 1 + sovTx(uint64(m.PaymentDeadline))
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovTx(uint64(m.AuctionType))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if m.Lot != nil {
+		l = m.Lot.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.ClearingPrice != nil {
+		l = m.ClearingPrice.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.PricingRule != 0 {
+		n += 2 + sovTx(uint64(m.PricingRule))
+	}
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 2 + sovTx(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 2 + sovTx(uint64(m.Duration))
+	}
+	if m.RelistPolicy != nil {
+		l = m.RelistPolicy.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.RelistRound != 0 {
+		n += 2 + sovTx(uint64(m.RelistRound))
+	}
+	l = len(m.RelistedFrom)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.RelistedAs)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.Liquidation != nil {
+		l = m.Liquidation.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeAllowance) > 0 {
+		for _, e := range m.FeeAllowance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeAllowancePerBidder) > 0 {
+		for _, e := range m.FeeAllowancePerBidder {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.FeeSponsor)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeGrantees) > 0 {
+		for _, s := range m.FeeGrantees {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NftLot != nil {
+		l = m.NftLot.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReserveCommitment)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.Reserve != nil {
+		l = m.Reserve.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Bid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidAmount != nil {
+		l = m.BidAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if m.Filled != 0 {
+		n += 1 + sovTx(uint64(m.Filled))
+	}
+	if m.NftDestination != nil {
+		l = m.NftDestination.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *NFTDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RelistPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRelists != 0 {
+		n += 1 + sovTx(uint64(m.MaxRelists))
+	}
+	if m.Decrement != nil {
+		l = m.Decrement.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Liquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DebtTarget != nil {
+		l = m.DebtTarget.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Repaid != nil {
+		l = m.Repaid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Shortfall != nil {
+		l = m.Shortfall.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AuctionTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartingBid != nil {
+		l = m.StartingBid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovTx(uint64(m.AuctionType))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if m.Lot != nil {
+		l = m.Lot.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PricingRule != 0 {
+		n += 1 + sovTx(uint64(m.PricingRule))
+	}
+	return n
+}
+
+func (m *AuctionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTx(uint64(m.NextHeight))
+	}
+	if len(m.AuctionIds) > 0 {
+		for _, s := range m.AuctionIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *AuctionLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Item)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Coin != nil {
+		l = m.Coin.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WinningBid != nil {
+		l = m.WinningBid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LotBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Bid != nil {
+		l = m.Bid.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BidderRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AuctionReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartingBid == nil {
+				m.StartingBid = &types.Coin{}
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredPayment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeferredPayment = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lot == nil {
+				m.Lot = &types.Coin{}
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, &AuctionLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelistPolicy == nil {
+				m.RelistPolicy = &RelistPolicy{}
+			}
+			if err := m.RelistPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = append(m.FeeAllowance, types.Coin{})
+			if err := m.FeeAllowance[len(m.FeeAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowancePerBidder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowancePerBidder = append(m.FeeAllowancePerBidder, types.Coin{})
+			if err := m.FeeAllowancePerBidder[len(m.FeeAllowancePerBidder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftLot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NftLot == nil {
+				m.NftLot = &NFTLot{}
+			}
+			if err := m.NftLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidAmount == nil {
+				m.BidAmount = &types.Coin{}
+			}
+			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NftDestination == nil {
+				m.NftDestination = &NFTDestination{}
+			}
+			if err := m.NftDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBidder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBidder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBidder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBidderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBidderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBidderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompletePurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompletePurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompletePurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompletePurchaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompletePurchaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompletePurchaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuctionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuctionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuctionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuctionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuctionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuctionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAuctionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAuctionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAuctionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundIncentivePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundIncentivePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundIncentivePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundIncentivePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundIncentivePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundIncentivePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAuctionRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAuctionRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAuctionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAuctionRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAuctionRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAuctionRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTreasuryAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTreasuryAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTreasuryAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTreasuryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTreasuryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTreasuryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendCreateRemoteAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendCreateRemoteAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendCreateRemoteAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendCreateRemoteAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendCreateRemoteAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendCreateRemoteAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPlaceRemoteBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPlaceRemoteBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPlaceRemoteBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendPlaceRemoteBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendPlaceRemoteBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendPlaceRemoteBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantBidFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantBidFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantBidFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidders = append(m.Bidders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantBidFeeAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantBidFeeAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantBidFeeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNFTLot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNFTLot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNFTLot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNFTLotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNFTLotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNFTLotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAdminCancelAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdminCancelAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdminCancelAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAdminCancelAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdminCancelAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdminCancelAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartingBid == nil {
+				m.StartingBid = &types.Coin{}
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, &Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			m.PausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredPayment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeferredPayment = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinningBid == nil {
+				m.WinningBid = &Bid{}
+			}
+			if err := m.WinningBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDeadline", wireType)
+			}
+			m.PaymentDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lot == nil {
+				m.Lot = &types.Coin{}
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearingPrice == nil {
+				m.ClearingPrice = &types.Coin{}
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, &AuctionLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelistPolicy == nil {
+				m.RelistPolicy = &RelistPolicy{}
+			}
+			if err := m.RelistPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistRound", wireType)
+			}
+			m.RelistRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelistRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelistedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelistedAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelistedAs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Liquidation == nil {
+				m.Liquidation = &Liquidation{}
+			}
+			if err := m.Liquidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowance = append(m.FeeAllowance, types.Coin{})
+			if err := m.FeeAllowance[len(m.FeeAllowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowancePerBidder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowancePerBidder = append(m.FeeAllowancePerBidder, types.Coin{})
+			if err := m.FeeAllowancePerBidder[len(m.FeeAllowancePerBidder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrantees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrantees = append(m.FeeGrantees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftLot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NftLot == nil {
+				m.NftLot = &NFTLot{}
+			}
+			if err := m.NftLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reserve == nil {
+				m.Reserve = &types.Coin{}
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidAmount == nil {
+				m.BidAmount = &types.Coin{}
+			}
+			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			m.Filled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Filled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NftDestination == nil {
+				m.NftDestination = &NFTDestination{}
+			}
+			if err := m.NftDestination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTLot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTLot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTLot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= NFTLotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelistPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelistPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelistPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelists", wireType)
+			}
+			m.MaxRelists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelists |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decrement == nil {
+				m.Decrement = &types.Coin{}
+			}
+			if err := m.Decrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Liquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Liquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Liquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DebtTarget == nil {
+				m.DebtTarget = &types.Coin{}
+			}
+			if err := m.DebtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repaid == nil {
+				m.Repaid = &types.Coin{}
+			}
+			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shortfall == nil {
+				m.Shortfall = &types.Coin{}
+			}
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartingBid == nil {
+				m.StartingBid = &types.Coin{}
+			}
+			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lot == nil {
+				m.Lot = &types.Coin{}
+			}
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingRule", wireType)
+			}
+			m.PricingRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricingRule |= PricingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Bid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BidAmount != nil {
-		l = m.BidAmount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *AuctionSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,15 +14923,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1230,11 +14959,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1261,60 +15022,173 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
 			}
-			if iNdEx >= l {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AuctionIds = append(m.AuctionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ScheduleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1336,7 +15210,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
+func (m *AuctionLot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,15 +15233,15 @@ func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionLot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionLot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1395,7 +15269,7 @@ func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1431,7 +15305,7 @@ func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1458,68 +15332,18 @@ func (m *MsgCreateAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartingBid == nil {
-				m.StartingBid = &types.Coin{}
+			if m.Coin == nil {
+				m.Coin = &types.Coin{}
 			}
-			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateAuctionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAuctionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningBid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1529,23 +15353,27 @@ func (m *MsgCreateAuctionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			if m.WinningBid == nil {
+				m.WinningBid = &Bid{}
+			}
+			if err := m.WinningBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1568,7 +15396,7 @@ func (m *MsgCreateAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+func (m *LotBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1591,10 +15419,10 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: LotBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LotBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1631,7 +15459,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1659,11 +15487,11 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.LotId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1690,10 +15518,10 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidAmount == nil {
-				m.BidAmount = &types.Coin{}
+			if m.Bid == nil {
+				m.Bid = &Bid{}
 			}
-			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1718,77 +15546,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Auction) Unmarshal(dAtA []byte) error {
+func (m *BidderRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1811,15 +15569,15 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidderRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidderRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1847,11 +15605,11 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1879,11 +15637,11 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Item = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1910,16 +15668,66 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartingBid == nil {
-				m.StartingBid = &types.Coin{}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
 			}
-			if err := m.StartingBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1947,11 +15755,11 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1978,8 +15786,8 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bids = append(m.Bids, &Bid{})
-			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2004,7 +15812,7 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bid) Unmarshal(dAtA []byte) error {
+func (m *IncentiveEpoch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2027,17 +15835,17 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveEpoch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2047,27 +15855,14 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2094,10 +15889,8 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidAmount == nil {
-				m.BidAmount = &types.Coin{}
-			}
-			if err := m.BidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1 +1,207 @@
 package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDuration bounds, in blocks, the duration of auctions, how far ahead
+// their start height lies and the interval of auction schedules. It is
+// roughly a year with 6 second blocks, so that the heights computed from
+// these values stay far from overflowing.
+const MaxDuration uint64 = 5256000
+
+// RequiresDeposit reports whether bidders must lock a deposit before bidding
+// on the auction.
+func (a Auction) RequiresDeposit() bool {
+	return a.Deposit != nil && a.Deposit.IsPositive()
+}
+
+// IsMultiUnit reports whether the auction sells identical units.
+func (a Auction) IsMultiUnit() bool {
+	return a.AuctionType == AuctionType_AUCTION_TYPE_MULTI_UNIT
+}
+
+// IsReverse reports whether the auction buys from the lowest bidder.
+func (a Auction) IsReverse() bool {
+	return a.AuctionType == AuctionType_AUCTION_TYPE_REVERSE
+}
+
+// IsBundle reports whether the auction sells several lots.
+func (a Auction) IsBundle() bool {
+	return a.AuctionType == AuctionType_AUCTION_TYPE_BUNDLE
+}
+
+// FindLot returns the lot of a bundle auction with the given ID.
+func (a Auction) FindLot(lotID string) (*AuctionLot, bool) {
+	for _, lot := range a.Lots {
+		if lot.Id == lotID {
+			return lot, true
+		}
+	}
+	return nil, false
+}
+
+// Winner returns the bid that won a settled single item auction, or nil if it
+// went unsold. On bundle auctions it is the bundle bid that won all lots. Use
+// Winners for multi-unit auctions and lots sold separately.
+func (a Auction) Winner() *Bid {
+	if a.IsMultiUnit() {
+		return nil
+	}
+	if a.DeferredPayment || a.IsBundle() {
+		return a.WinningBid
+	}
+	if len(a.Bids) == 0 {
+		return nil
+	}
+	highestBid := a.Bids[len(a.Bids)-1]
+	if !a.MeetsReserve(*highestBid.BidAmount) {
+		return nil
+	}
+	return highestBid
+}
+
+// MeetsReserve reports whether a bid amount meets the hidden reserve of the
+// auction. No amount meets a reserve that was never revealed, and any amount
+// meets the reserve of auctions without one.
+func (a Auction) MeetsReserve(amount sdk.Coin) bool {
+	if a.ReserveCommitment == "" {
+		return true
+	}
+	return a.Reserve != nil && !amount.IsLT(*a.Reserve)
+}
+
+// Winners returns the bids that won a settled auction.
+func (a Auction) Winners() (winners []*Bid) {
+	if a.IsBundle() && a.WinningBid == nil {
+		for _, lot := range a.Lots {
+			if lot.WinningBid != nil {
+				winners = append(winners, lot.WinningBid)
+			}
+		}
+		return
+	}
+	if !a.IsMultiUnit() {
+		if winner := a.Winner(); winner != nil {
+			winners = append(winners, winner)
+		}
+		return
+	}
+	for _, bid := range a.Bids {
+		if bid.Filled > 0 {
+			winners = append(winners, bid)
+		}
+	}
+	return
+}
+
+// BidEscrow returns the amount escrowed for a bid: the full price of the
+// requested units on multi-unit auctions, the bid amount otherwise.
+func (a Auction) BidEscrow(bid *Bid) sdk.Coin {
+	if a.IsMultiUnit() {
+		return sdk.NewCoin(bid.BidAmount.Denom, bid.BidAmount.Amount.Mul(math.NewIntFromUint64(bid.Quantity)))
+	}
+	return *bid.BidAmount
+}
+
+// PaidAmount returns the amount paid for a winning bid of a settled auction:
+// the price of the filled units on multi-unit auctions, the bid amount
+// otherwise.
+func (a Auction) PaidAmount(bid *Bid) sdk.Coin {
+	if !a.IsMultiUnit() {
+		return *bid.BidAmount
+	}
+	price := *bid.BidAmount
+	if a.PricingRule != PricingRule_PRICING_RULE_PAY_AS_BID && a.ClearingPrice != nil {
+		price = *a.ClearingPrice
+	}
+	return sdk.NewCoin(price.Denom, price.Amount.Mul(math.NewIntFromUint64(bid.Filled)))
+}
+
+// UnitLot returns the share of the lot delivered per unit of a multi-unit
+// auction.
+func (a Auction) UnitLot() sdk.Coin {
+	return sdk.NewCoin(a.Lot.Denom, a.Lot.Amount.Quo(math.NewIntFromUint64(a.Quantity)))
+}
+
+// Validate checks that a relist policy can be applied to an auction with the
+// given starting bid and type. A nil policy is valid.
+func (p *RelistPolicy) Validate(startingBid sdk.Coin, auctionType AuctionType) error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxRelists == 0 {
+		return fmt.Errorf("relist policy must allow at least one relist")
+	}
+	if p.Decrement == nil {
+		return nil
+	}
+	if !p.Decrement.IsValid() || p.Decrement.Denom != startingBid.Denom {
+		return fmt.Errorf("invalid relist decrement %s", p.Decrement)
+	}
+	if auctionType == AuctionType_AUCTION_TYPE_REVERSE {
+		return fmt.Errorf("reverse auctions do not support a relist decrement")
+	}
+	// the starting bid must stay positive in the last round
+	total := p.Decrement.Amount.Mul(math.NewInt(int64(p.MaxRelists)))
+	if total.GTE(startingBid.Amount) {
+		return fmt.Errorf("relist decrement %s over %d rounds exceeds the starting bid %s", p.Decrement, p.MaxRelists, startingBid)
+	}
+	return nil
+}
+
+// LiquidationConfig configures the auction run by a collateral liquidation.
+type LiquidationConfig struct {
+	// AuctionType is either AUCTION_TYPE_ENGLISH, which sells the whole lot to
+	// the highest bidder, or AUCTION_TYPE_MULTI_UNIT, which splits the lot into
+	// Quantity identical units. Like every coin lot of the module, the lot of
+	// an English liquidation is sold as the single unit of a multi-unit
+	// auction.
+	AuctionType AuctionType
+	// Quantity is ignored by English liquidations
+	Quantity    uint64
+	PricingRule PricingRule
+	// MinPrice is the starting bid of the auction, the lowest price accepted
+	// per unit in the denom of the debt target. It is required so that the
+	// collateral is not sold at any price.
+	MinPrice sdk.Coin
+	// Duration falls back to the default duration param when zero
+	Duration uint64
+}
+
+// IsLiquidation returns true if the auction liquidates collateral for another
+// module.
+func (a Auction) IsLiquidation() bool {
+	return a.Liquidation != nil
+}
+
+// MsgCreateAuction returns the message creating an auction of the template on
+// behalf of the given creator.
+func (t AuctionTemplate) MsgCreateAuction(creator string) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Creator:     creator,
+		Item:        t.Item,
+		StartingBid: t.StartingBid,
+		Duration:    t.Duration,
+		AuctionType: t.AuctionType,
+		Quantity:    t.Quantity,
+		Lot:         t.Lot,
+		PricingRule: t.PricingRule,
+	}
+}
+
+// RoundEscrow returns the coins every auction of the template escrows: its
+// lot, or its budget on reverse auctions.
+func (t AuctionTemplate) RoundEscrow() sdk.Coins {
+	escrow := sdk.NewCoins()
+	if t.Lot != nil {
+		escrow = escrow.Add(*t.Lot)
+	}
+	if t.AuctionType == AuctionType_AUCTION_TYPE_REVERSE && t.StartingBid != nil {
+		escrow = escrow.Add(*t.StartingBid)
+	}
+	return escrow
+}